@@ -1,26 +1,50 @@
 package main
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/grpcreflect"
+	adminv1 "github.com/a-s/connect-task-manage/gen/api/admin/v1"
+	"github.com/a-s/connect-task-manage/gen/api/admin/v1/adminv1connect"
+	projectv1 "github.com/a-s/connect-task-manage/gen/api/project/v1"
+	"github.com/a-s/connect-task-manage/gen/api/project/v1/projectv1connect"
 	taskv1 "github.com/a-s/connect-task-manage/gen/api/task/v1"
 	"github.com/a-s/connect-task-manage/gen/api/task/v1/taskv1connect"
 	userv1 "github.com/a-s/connect-task-manage/gen/api/user/v1"
 	"github.com/a-s/connect-task-manage/gen/api/user/v1/userv1connect"
+	workspacev1 "github.com/a-s/connect-task-manage/gen/api/workspace/v1"
+	"github.com/a-s/connect-task-manage/gen/api/workspace/v1/workspacev1connect"
+	"github.com/a-s/connect-task-manage/internal/adapter/event/memory"
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
+	filemailer "github.com/a-s/connect-task-manage/internal/adapter/mailer/file"
+	memorymailer "github.com/a-s/connect-task-manage/internal/adapter/mailer/memory"
+	smtpmailer "github.com/a-s/connect-task-manage/internal/adapter/mailer/smtp"
+	ratelimitmemory "github.com/a-s/connect-task-manage/internal/adapter/ratelimit/memory"
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	memoryrepo "github.com/a-s/connect-task-manage/internal/adapter/repository/memory"
 	"github.com/a-s/connect-task-manage/internal/adapter/repository/mysql"
+	"github.com/a-s/connect-task-manage/internal/adapter/sso"
+	"github.com/a-s/connect-task-manage/internal/adapter/sso/oidc"
 	"github.com/a-s/connect-task-manage/internal/adapter/token/jwt"
 	"github.com/a-s/connect-task-manage/internal/domain/model"
 	"github.com/a-s/connect-task-manage/internal/domain/service"
 	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/encryption"
 	"github.com/a-s/connect-task-manage/internal/infrastructure/logger"
 	"github.com/a-s/connect-task-manage/pkg/authorization"
 	"github.com/a-s/connect-task-manage/pkg/logging"
@@ -29,11 +53,16 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type UserServiceServer struct {
-	userService *service.UserService
+	userService       *service.UserService
+	patService        *service.PersonalAccessTokenService
+	trustProxyHeaders bool // X-Forwarded-For のクライアント IP を信頼する
 }
 
 // CreateUser, Login, UpdateUser, Logout, GetMe メソッドは変更なし (省略)
@@ -57,219 +86,1742 @@ func (s *UserServiceServer) Login(
 	ctx context.Context,
 	req *connect.Request[userv1.LoginRequest],
 ) (*connect.Response[userv1.LoginResponse], error) {
-	token, err := s.userService.Login(ctx, req.Msg.Email, req.Msg.Password)
+	result, err := s.userService.Login(ctx, req.Msg.Email, req.Msg.Password, s.clientInfo(req.Peer(), req.Header()))
 	if err != nil {
+		if errors.Is(err, model.ErrEmailNotVerified) || errors.Is(err, model.ErrUserDisabled) || errors.Is(err, model.ErrTooManyLoginAttempts) {
+			return nil, toConnectError(err)
+		}
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if result.RequiresSecondFactor() {
+		return connect.NewResponse(&userv1.LoginResponse{
+			SecondFactorRequired: true,
+			ChallengeToken:       result.ChallengeToken,
+		}), nil
+	}
+	res := connect.NewResponse(&userv1.LoginResponse{
+		AccessToken:  result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
+	})
+
+	return res, nil
+}
+
+func (s *UserServiceServer) VerifySecondFactor(
+	ctx context.Context,
+	req *connect.Request[userv1.VerifySecondFactorRequest],
+) (*connect.Response[userv1.VerifySecondFactorResponse], error) {
+	pair, err := s.userService.VerifySecondFactor(ctx, req.Msg.ChallengeToken, req.Msg.Code, s.clientInfo(req.Peer(), req.Header()))
+	if err != nil {
+		if errors.Is(err, model.ErrUserDisabled) || errors.Is(err, model.ErrTooManyLoginAttempts) {
+			return nil, toConnectError(err)
+		}
 		return nil, connect.NewError(connect.CodeUnauthenticated, err)
 	}
-	res := connect.NewResponse(&userv1.LoginResponse{
-		AccessToken: token,
-	})
+	res := connect.NewResponse(&userv1.VerifySecondFactorResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	})
+
+	return res, nil
+}
+
+func (s *UserServiceServer) StartOidcLogin(
+	ctx context.Context,
+	req *connect.Request[userv1.StartOidcLoginRequest],
+) (*connect.Response[userv1.StartOidcLoginResponse], error) {
+	authURL, state, err := s.userService.StartSSOLogin(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	res := connect.NewResponse(&userv1.StartOidcLoginResponse{
+		AuthorizationUrl: authURL,
+		State:            state,
+	})
+
+	return res, nil
+}
+
+func (s *UserServiceServer) CompleteOidcLogin(
+	ctx context.Context,
+	req *connect.Request[userv1.CompleteOidcLoginRequest],
+) (*connect.Response[userv1.CompleteOidcLoginResponse], error) {
+	result, err := s.userService.CompleteSSOLogin(ctx, req.Msg.State, req.Msg.Code, s.clientInfo(req.Peer(), req.Header()))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if result.RequiresSecondFactor() {
+		return connect.NewResponse(&userv1.CompleteOidcLoginResponse{
+			SecondFactorRequired: true,
+			ChallengeToken:       result.ChallengeToken,
+		}), nil
+	}
+	res := connect.NewResponse(&userv1.CompleteOidcLoginResponse{
+		AccessToken:  result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
+	})
+
+	return res, nil
+}
+
+func (s *UserServiceServer) RefreshToken(
+	ctx context.Context,
+	req *connect.Request[userv1.RefreshTokenRequest],
+) (*connect.Response[userv1.RefreshTokenResponse], error) {
+	pair, err := s.userService.RefreshToken(ctx, req.Msg.RefreshToken)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	res := connect.NewResponse(&userv1.RefreshTokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	})
+
+	return res, nil
+}
+
+func (s *UserServiceServer) RequestPasswordReset(
+	ctx context.Context,
+	req *connect.Request[userv1.RequestPasswordResetRequest],
+) (*connect.Response[userv1.RequestPasswordResetResponse], error) {
+	if err := s.userService.RequestPasswordReset(ctx, req.Msg.Email, s.clientInfo(req.Peer(), req.Header())); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.RequestPasswordResetResponse{})
+	return res, nil
+}
+
+func (s *UserServiceServer) ResetPassword(
+	ctx context.Context,
+	req *connect.Request[userv1.ResetPasswordRequest],
+) (*connect.Response[userv1.ResetPasswordResponse], error) {
+	if err := s.userService.ResetPassword(ctx, req.Msg.Token, req.Msg.NewPassword); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.ResetPasswordResponse{})
+	return res, nil
+}
+
+func (s *UserServiceServer) VerifyEmail(
+	ctx context.Context,
+	req *connect.Request[userv1.VerifyEmailRequest],
+) (*connect.Response[userv1.VerifyEmailResponse], error) {
+	if err := s.userService.VerifyEmail(ctx, req.Msg.Token); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.VerifyEmailResponse{})
+	return res, nil
+}
+
+func (s *UserServiceServer) ResendVerificationEmail(
+	ctx context.Context,
+	req *connect.Request[userv1.ResendVerificationEmailRequest],
+) (*connect.Response[userv1.ResendVerificationEmailResponse], error) {
+	if err := s.userService.ResendVerificationEmail(ctx, req.Msg.Email, s.clientInfo(req.Peer(), req.Header())); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.ResendVerificationEmailResponse{})
+	return res, nil
+}
+
+func (s *UserServiceServer) UpdateUser(
+	ctx context.Context,
+	req *connect.Request[userv1.UpdateUserRequest],
+) (*connect.Response[userv1.UpdateUserResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	updatedUser, err := s.userService.UpdateUser(ctx, userID, req.Msg.Name, req.Msg.Email, req.Msg.Password)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.UpdateUserResponse{
+		User: &userv1.User{
+			Id:            updatedUser.ID,
+			Name:          updatedUser.Name,
+			Email:         updatedUser.Email,
+			CreatedAt:     updatedUser.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:     updatedUser.UpdatedAt.Format(time.RFC3339),
+			EmailVerified: updatedUser.IsEmailVerified(),
+			Role:          string(updatedUser.Role),
+		},
+	})
+
+	return res, nil
+}
+
+func (s *UserServiceServer) Logout(
+	ctx context.Context,
+	req *connect.Request[userv1.LogoutRequest],
+) (*connect.Response[userv1.LogoutResponse], error) {
+	claims, ok := authorization.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token claims not found in context"))
+	}
+
+	if err := s.userService.Logout(ctx, claims, req.Msg.RefreshToken); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.LogoutResponse{})
+	return res, nil
+}
+
+func (s *UserServiceServer) GetMe(
+	ctx context.Context,
+	req *connect.Request[userv1.GetMeRequest],
+) (*connect.Response[userv1.GetMeResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := connect.NewResponse(&userv1.GetMeResponse{
+		User: &userv1.User{
+			Id:            user.ID,
+			Name:          user.Name,
+			Email:         user.Email,
+			CreatedAt:     user.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:     user.UpdatedAt.Format(time.RFC3339),
+			EmailVerified: user.IsEmailVerified(),
+			Role:          string(user.Role),
+		},
+	})
+
+	return res, nil
+}
+
+func (s *UserServiceServer) CreatePersonalAccessToken(
+	ctx context.Context,
+	req *connect.Request[userv1.CreatePersonalAccessTokenRequest],
+) (*connect.Response[userv1.CreatePersonalAccessTokenResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+	if req.Msg.ExpiresAt == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: expires_at is required", model.ErrInvalidTokenExpiry))
+	}
+
+	pat, token, err := s.patService.CreatePersonalAccessToken(ctx, userID, req.Msg.Name, req.Msg.Scopes, req.Msg.ExpiresAt.AsTime())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.CreatePersonalAccessTokenResponse{
+		PersonalAccessToken: toProtoPersonalAccessToken(pat),
+		Token:               token,
+	})
+	return res, nil
+}
+
+func (s *UserServiceServer) ListPersonalAccessTokens(
+	ctx context.Context,
+	req *connect.Request[userv1.ListPersonalAccessTokensRequest],
+) (*connect.Response[userv1.ListPersonalAccessTokensResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	pats, err := s.patService.ListPersonalAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoPATs := make([]*userv1.PersonalAccessToken, len(pats))
+	for i, pat := range pats {
+		protoPATs[i] = toProtoPersonalAccessToken(pat)
+	}
+	res := connect.NewResponse(&userv1.ListPersonalAccessTokensResponse{
+		PersonalAccessTokens: protoPATs,
+	})
+	return res, nil
+}
+
+func (s *UserServiceServer) RevokePersonalAccessToken(
+	ctx context.Context,
+	req *connect.Request[userv1.RevokePersonalAccessTokenRequest],
+) (*connect.Response[userv1.RevokePersonalAccessTokenResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	if err := s.patService.RevokePersonalAccessToken(ctx, userID, req.Msg.Id); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.RevokePersonalAccessTokenResponse{})
+	return res, nil
+}
+
+func (s *UserServiceServer) EnrollTotp(
+	ctx context.Context,
+	req *connect.Request[userv1.EnrollTotpRequest],
+) (*connect.Response[userv1.EnrollTotpResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	secret, uri, err := s.userService.EnrollTOTP(ctx, userID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.EnrollTotpResponse{
+		Secret:     secret,
+		OtpauthUri: uri,
+	})
+	return res, nil
+}
+
+func (s *UserServiceServer) ConfirmTotp(
+	ctx context.Context,
+	req *connect.Request[userv1.ConfirmTotpRequest],
+) (*connect.Response[userv1.ConfirmTotpResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	codes, err := s.userService.ConfirmTOTP(ctx, userID, req.Msg.Code)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.ConfirmTotpResponse{
+		RecoveryCodes: codes,
+	})
+	return res, nil
+}
+
+func (s *UserServiceServer) RegenerateRecoveryCodes(
+	ctx context.Context,
+	req *connect.Request[userv1.RegenerateRecoveryCodesRequest],
+) (*connect.Response[userv1.RegenerateRecoveryCodesResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	codes, err := s.userService.RegenerateRecoveryCodes(ctx, userID, req.Msg.Code)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.RegenerateRecoveryCodesResponse{
+		RecoveryCodes: codes,
+	})
+	return res, nil
+}
+
+func (s *UserServiceServer) DisableTotp(
+	ctx context.Context,
+	req *connect.Request[userv1.DisableTotpRequest],
+) (*connect.Response[userv1.DisableTotpResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	if err := s.userService.DisableTOTP(ctx, userID, req.Msg.Code); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&userv1.DisableTotpResponse{})
+	return res, nil
+}
+
+func (s *UserServiceServer) ListLoginEvents(
+	ctx context.Context,
+	req *connect.Request[userv1.ListLoginEventsRequest],
+) (*connect.Response[userv1.ListLoginEventsResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	events, err := s.userService.ListLoginEvents(ctx, userID, int(req.Msg.PageSize))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoEvents := make([]*userv1.LoginEvent, len(events))
+	for i, e := range events {
+		protoEvents[i] = &userv1.LoginEvent{
+			Id:        e.ID,
+			Result:    string(e.Result),
+			IpAddress: e.IPAddress,
+			UserAgent: e.UserAgent,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		}
+	}
+	res := connect.NewResponse(&userv1.ListLoginEventsResponse{
+		LoginEvents: protoEvents,
+	})
+	return res, nil
+}
+
+func (s *UserServiceServer) SearchUsers(
+	ctx context.Context,
+	req *connect.Request[userv1.SearchUsersRequest],
+) (*connect.Response[userv1.SearchUsersResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	profiles, err := s.userService.SearchUsers(ctx, userID, req.Msg.Query, int(req.Msg.PageSize), s.clientInfo(req.Peer(), req.Header()))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoProfiles := make([]*userv1.UserProfile, len(profiles))
+	for i, p := range profiles {
+		protoProfiles[i] = &userv1.UserProfile{
+			Id:   p.ID,
+			Name: p.Name,
+		}
+	}
+	res := connect.NewResponse(&userv1.SearchUsersResponse{
+		Users: protoProfiles,
+	})
+	return res, nil
+}
+
+// clientInfo はリクエスト元のクライアント IP とユーザーエージェントを取得する
+// (X-Forwarded-For はリバースプロキシの背後で動かす設定の場合のみ使用する)
+func (s *UserServiceServer) clientInfo(peer connect.Peer, header http.Header) model.ClientInfo {
+	ip := peer.Addr
+	if host, _, err := net.SplitHostPort(peer.Addr); err == nil {
+		ip = host
+	}
+	if forwarded := header.Get("X-Forwarded-For"); s.trustProxyHeaders && forwarded != "" {
+		first, _, _ := strings.Cut(forwarded, ",")
+		ip = strings.TrimSpace(first)
+	}
+	return model.ClientInfo{
+		IPAddress: ip,
+		UserAgent: header.Get("User-Agent"),
+	}
+}
+
+// toProtoPersonalAccessToken は model.PersonalAccessToken を userv1.PersonalAccessToken に変換する
+func toProtoPersonalAccessToken(pat *model.PersonalAccessToken) *userv1.PersonalAccessToken {
+	scopes := make([]string, len(pat.Scopes))
+	for i, scope := range pat.Scopes {
+		scopes[i] = string(scope)
+	}
+	return &userv1.PersonalAccessToken{
+		Id:          pat.ID,
+		Name:        pat.Name,
+		TokenPrefix: pat.TokenPrefix,
+		Scopes:      scopes,
+		ExpiresAt:   timestamppb.New(pat.ExpiresAt),
+		CreatedAt:   timestamppb.New(pat.CreatedAt),
+	}
+}
+
+// TaskServiceServer (TaskService のハンドラー)
+type TaskServiceServer struct {
+	taskService *service.TaskService
+}
+
+// NewTaskServiceServer は TaskServiceServer のコンストラクタ (Fx 用)
+func NewTaskServiceServer(taskService *service.TaskService) *TaskServiceServer {
+	return &TaskServiceServer{taskService: taskService}
+}
+
+// CreateTask (タスク作成)
+func (s *TaskServiceServer) CreateTask(
+	ctx context.Context,
+	req *connect.Request[taskv1.CreateTaskRequest],
+) (*connect.Response[taskv1.CreateTaskResponse], error) {
+
+	// 認証情報からユーザーIDを取得
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	var dueDate *time.Time
+	if req.Msg.DueDate != nil {
+		t := req.Msg.DueDate.AsTime()
+		dueDate = &t
+	}
+
+	var projectID *string
+	if req.Msg.ProjectId != "" {
+		projectID = &req.Msg.ProjectId
+	}
+
+	var workspaceID *string
+	if req.Msg.WorkspaceId != "" {
+		workspaceID = &req.Msg.WorkspaceId
+	}
+
+	var parentID *string
+	if req.Msg.ParentId != "" {
+		parentID = &req.Msg.ParentId
+	}
+
+	err := s.taskService.CreateTask(ctx, req.Msg.Title, req.Msg.Description, userID, req.Msg.Priority, dueDate, projectID, workspaceID, parentID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&taskv1.CreateTaskResponse{}), nil
+}
+
+// GetTask (タスク取得)
+func (s *TaskServiceServer) GetTask(
+	ctx context.Context,
+	req *connect.Request[taskv1.GetTaskRequest],
+) (*connect.Response[taskv1.GetTaskResponse], error) {
+
+	// 認証情報からユーザーIDを取得
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	task, err := s.taskService.GetTask(ctx, userID, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&taskv1.GetTaskResponse{
+		Task: toProtoTask(task),
+	})
+	res.Header().Set("ETag", taskETag(task.Version))
+	return res, nil
+}
+
+// UpdateTask (タスク更新)
+func (s *TaskServiceServer) UpdateTask(
+	ctx context.Context,
+	req *connect.Request[taskv1.UpdateTaskRequest],
+) (*connect.Response[taskv1.UpdateTaskResponse], error) {
+
+	// 認証情報からユーザーIDを取得
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	expectedVersion, err := expectedTaskVersion(req.Header(), req.Msg.ExpectedVersion)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// マスク未指定の場合は既定のフィールドを更新する (project_id と parent_id は含めない。status が空の場合は状態を変更しない)
+	fields := model.DefaultTaskUpdateFields
+	if len(req.Msg.UpdateMask.GetPaths()) > 0 {
+		parsed, err := model.ParseTaskFields(req.Msg.UpdateMask.GetPaths())
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+		fields = parsed
+	} else if req.Msg.Status == "" {
+		fields = slices.DeleteFunc(slices.Clone(fields), func(f model.TaskField) bool { return f == model.TaskFieldStatus })
+	}
+
+	var assigneeID *string // ポインタ型の変数を宣言
+	if req.Msg.AssigneeId != nil {
+		s := req.Msg.AssigneeId.Value // 値を取得
+		assigneeID = &s               // ポインタを代入
+	}
+
+	var dueDate *time.Time // ポインタ型の変数を宣言
+	if req.Msg.DueDate != nil {
+		t := req.Msg.DueDate.AsTime() // 値を取得
+		dueDate = &t                  // ポインタを代入
+	}
+
+	var projectID *string
+	if req.Msg.ProjectId != nil {
+		projectID = &req.Msg.ProjectId.Value
+	}
+
+	var parentID *string
+	if req.Msg.ParentId != nil {
+		parentID = &req.Msg.ParentId.Value
+	}
+
+	updatedTask, err := s.taskService.UpdateTask(ctx, userID, req.Msg.Id, expectedVersion, &model.TaskPatch{
+		Fields:      fields,
+		Title:       req.Msg.Title,
+		Description: req.Msg.Description,
+		Status:      model.TaskStatus(req.Msg.Status),
+		AssigneeID:  assigneeID,
+		Priority:    model.Priority(req.Msg.Priority),
+		DueDate:     dueDate,
+		ProjectID:   projectID,
+		ParentID:    parentID,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&taskv1.UpdateTaskResponse{
+		Task: toProtoTask(updatedTask),
+	})
+	res.Header().Set("ETag", taskETag(updatedTask.Version))
+	return res, nil
+}
+
+// ListTasks (タスク一覧取得)
+func (s *TaskServiceServer) ListTasks(
+	ctx context.Context,
+	req *connect.Request[taskv1.ListTasksRequest],
+) (*connect.Response[taskv1.ListTasksResponse], error) {
+
+	// 認証情報からユーザーIDを取得
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	scope, err := toModelTaskScope(req.Msg.Scope)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	sortKey, err := toModelTaskSortKey(req.Msg.SortKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tasks, nextPageToken, err := s.taskService.ListTasks(ctx, userID, scope, req.Msg.WorkspaceId, toModelTaskFilter(req.Msg), sortKey, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&taskv1.ListTasksResponse{
+		Tasks:         toProtoTasks(tasks), // []*model.Task から []*taskv1.Task への変換
+		NextPageToken: nextPageToken,
+	})
+	return res, nil
+}
+
+// MoveTask (ボードでのタスクの並び替え)
+func (s *TaskServiceServer) MoveTask(
+	ctx context.Context,
+	req *connect.Request[taskv1.MoveTaskRequest],
+) (*connect.Response[taskv1.MoveTaskResponse], error) {
+
+	// 認証情報からユーザーIDを取得
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	expectedVersion, err := expectedTaskVersion(req.Header(), req.Msg.ExpectedVersion)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	movedTask, err := s.taskService.MoveTask(ctx, userID, req.Msg.Id, expectedVersion,
+		model.TaskStatus(req.Msg.Status), req.Msg.GetBeforeTaskId(), req.Msg.GetAfterTaskId())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	res := connect.NewResponse(&taskv1.MoveTaskResponse{
+		Task: toProtoTask(movedTask),
+	})
+	res.Header().Set("ETag", taskETag(movedTask.Version))
+	return res, nil
+}
+
+// ListSubtasks (サブタスク一覧取得)
+func (s *TaskServiceServer) ListSubtasks(
+	ctx context.Context,
+	req *connect.Request[taskv1.ListSubtasksRequest],
+) (*connect.Response[taskv1.ListSubtasksResponse], error) {
+
+	// 認証情報からユーザーIDを取得
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	subtasks, err := s.taskService.ListSubtasks(ctx, userID, req.Msg.ParentId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&taskv1.ListSubtasksResponse{Tasks: toProtoTasks(subtasks)}), nil
+}
+
+// GetTaskTree (タスクの階層の取得)
+func (s *TaskServiceServer) GetTaskTree(
+	ctx context.Context,
+	req *connect.Request[taskv1.GetTaskTreeRequest],
+) (*connect.Response[taskv1.GetTaskTreeResponse], error) {
+
+	// 認証情報からユーザーIDを取得
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	tree, err := s.taskService.GetTaskTree(ctx, userID, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&taskv1.GetTaskTreeResponse{Root: toProtoTaskTreeNode(tree)}), nil
+}
+
+// DeleteTask (タスク削除)
+func (s *TaskServiceServer) DeleteTask(
+	ctx context.Context,
+	req *connect.Request[taskv1.DeleteTaskRequest],
+) (*connect.Response[taskv1.DeleteTaskResponse], error) {
+
+	// 認証情報からユーザーIDを取得
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	expectedVersion, err := expectedTaskVersion(req.Header(), req.Msg.ExpectedVersion)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	disposition, err := toModelSubtaskDisposition(req.Msg.SubtaskDisposition)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	err = s.taskService.DeleteTask(ctx, userID, req.Msg.Id, expectedVersion, disposition)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&taskv1.DeleteTaskResponse{}), nil
+}
+
+// WatchTasks (タスクの変更イベントの購読)
+func (s *TaskServiceServer) WatchTasks(
+	ctx context.Context,
+	req *connect.Request[taskv1.WatchTasksRequest],
+	stream *connect.ServerStream[taskv1.WatchTasksResponse],
+) error {
+
+	// 認証情報からユーザーIDを取得
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	watch, err := s.taskService.WatchTasks(ctx, userID, req.Msg.LastEventId)
+	if err != nil {
+		return toConnectError(err)
+	}
+
+	// 購読を開始できたことをクライアントに伝えるため、最初のイベントを待たずにヘッダーを送信する
+	if err := stream.Send(nil); err != nil {
+		return err
+	}
+
+	for {
+		e, err := watch.Next(ctx)
+		if err != nil {
+			// クライアントが切断した場合は正常終了とする
+			if ctx.Err() != nil {
+				return nil
+			}
+			return toConnectError(err)
+		}
+		if err := stream.Send(&taskv1.WatchTasksResponse{
+			Event: toProtoTaskEvent(e),
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// AdminServiceServer は管理者向けのユーザー管理のハンドラー
+type AdminServiceServer struct {
+	adminService *service.AdminService
+}
+
+// NewAdminServiceServer は AdminServiceServer のコンストラクタ (Fx 用)
+func NewAdminServiceServer(adminService *service.AdminService) *AdminServiceServer {
+	return &AdminServiceServer{adminService: adminService}
+}
+
+// ListUsers (ユーザー一覧)
+func (s *AdminServiceServer) ListUsers(
+	ctx context.Context,
+	req *connect.Request[adminv1.ListUsersRequest],
+) (*connect.Response[adminv1.ListUsersResponse], error) {
+	users, nextPageToken, err := s.adminService.ListUsers(ctx, strings.TrimSpace(req.Msg.Search), int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoUsers := make([]*adminv1.User, len(users))
+	for i, u := range users {
+		protoUsers[i] = toProtoAdminUser(u)
+	}
+	res := connect.NewResponse(&adminv1.ListUsersResponse{
+		Users:         protoUsers,
+		NextPageToken: nextPageToken,
+	})
+	return res, nil
+}
+
+// GetUser (ユーザー取得)
+func (s *AdminServiceServer) GetUser(
+	ctx context.Context,
+	req *connect.Request[adminv1.GetUserRequest],
+) (*connect.Response[adminv1.GetUserResponse], error) {
+	user, err := s.adminService.GetUser(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&adminv1.GetUserResponse{User: toProtoAdminUser(user)}), nil
+}
+
+// DisableUser (ユーザーの無効化)
+func (s *AdminServiceServer) DisableUser(
+	ctx context.Context,
+	req *connect.Request[adminv1.DisableUserRequest],
+) (*connect.Response[adminv1.DisableUserResponse], error) {
+	adminID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	user, err := s.adminService.DisableUser(ctx, adminID, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&adminv1.DisableUserResponse{User: toProtoAdminUser(user)}), nil
+}
+
+// EnableUser (ユーザーの再有効化)
+func (s *AdminServiceServer) EnableUser(
+	ctx context.Context,
+	req *connect.Request[adminv1.EnableUserRequest],
+) (*connect.Response[adminv1.EnableUserResponse], error) {
+	user, err := s.adminService.EnableUser(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&adminv1.EnableUserResponse{User: toProtoAdminUser(user)}), nil
+}
+
+// DeleteUser (ユーザー削除)
+func (s *AdminServiceServer) DeleteUser(
+	ctx context.Context,
+	req *connect.Request[adminv1.DeleteUserRequest],
+) (*connect.Response[adminv1.DeleteUserResponse], error) {
+	adminID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	disposition, err := toModelTaskDisposition(req.Msg.TaskDisposition)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if err := s.adminService.DeleteUser(ctx, adminID, req.Msg.Id, disposition, req.Msg.SuccessorId); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&adminv1.DeleteUserResponse{}), nil
+}
+
+// toProtoAdminUser は *model.User を *adminv1.User に変換するヘルパー関数
+func toProtoAdminUser(user *model.User) *adminv1.User {
+	protoUser := &adminv1.User{
+		Id:            user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		Role:          string(user.Role),
+		EmailVerified: user.IsEmailVerified(),
+		Disabled:      user.IsDisabled(),
+		CreatedAt:     timestamppb.New(user.CreatedAt),
+		UpdatedAt:     timestamppb.New(user.UpdatedAt),
+	}
+	if user.DisabledAt != nil {
+		protoUser.DisabledAt = timestamppb.New(*user.DisabledAt)
+	}
+	return protoUser
+}
+
+// toModelTaskDisposition は adminv1.TaskDisposition を model.TaskDisposition に変換するヘルパー関数
+// 未指定の場合はエラーにします (タスクの扱いは削除ごとに明示的に選ぶ)。
+func toModelTaskDisposition(disposition adminv1.TaskDisposition) (model.TaskDisposition, error) {
+	switch disposition {
+	case adminv1.TaskDisposition_TASK_DISPOSITION_REASSIGN:
+		return model.TaskDispositionReassign, nil
+	case adminv1.TaskDisposition_TASK_DISPOSITION_DELETE:
+		return model.TaskDispositionDelete, nil
+	default:
+		return "", fmt.Errorf("%w: %v", model.ErrInvalidTaskDisposition, disposition)
+	}
+}
+
+// ProjectServiceServer (ProjectService のハンドラー)
+type ProjectServiceServer struct {
+	projectService *service.ProjectService
+}
+
+// NewProjectServiceServer は ProjectServiceServer のコンストラクタ (Fx 用)
+func NewProjectServiceServer(projectService *service.ProjectService) *ProjectServiceServer {
+	return &ProjectServiceServer{projectService: projectService}
+}
+
+// CreateProject (プロジェクト作成)
+func (s *ProjectServiceServer) CreateProject(
+	ctx context.Context,
+	req *connect.Request[projectv1.CreateProjectRequest],
+) (*connect.Response[projectv1.CreateProjectResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	project, err := s.projectService.CreateProject(ctx, userID, req.Msg.Name, req.Msg.Description)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&projectv1.CreateProjectResponse{Project: toProtoProject(project)}), nil
+}
+
+// GetProject (プロジェクト取得)
+func (s *ProjectServiceServer) GetProject(
+	ctx context.Context,
+	req *connect.Request[projectv1.GetProjectRequest],
+) (*connect.Response[projectv1.GetProjectResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	project, err := s.projectService.GetProject(ctx, userID, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&projectv1.GetProjectResponse{Project: toProtoProject(project)}), nil
+}
+
+// ListProjects (プロジェクト一覧)
+func (s *ProjectServiceServer) ListProjects(
+	ctx context.Context,
+	req *connect.Request[projectv1.ListProjectsRequest],
+) (*connect.Response[projectv1.ListProjectsResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	projects, nextPageToken, err := s.projectService.ListProjects(ctx, userID, req.Msg.IncludeArchived, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoProjects := make([]*projectv1.Project, len(projects))
+	for i, p := range projects {
+		protoProjects[i] = toProtoProject(p)
+	}
+	res := connect.NewResponse(&projectv1.ListProjectsResponse{
+		Projects:      protoProjects,
+		NextPageToken: nextPageToken,
+	})
+	return res, nil
+}
+
+// UpdateProject (プロジェクト更新)
+func (s *ProjectServiceServer) UpdateProject(
+	ctx context.Context,
+	req *connect.Request[projectv1.UpdateProjectRequest],
+) (*connect.Response[projectv1.UpdateProjectResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	// マスク未指定の場合は全フィールドを更新する
+	fields := model.MutableProjectFields
+	if len(req.Msg.UpdateMask.GetPaths()) > 0 {
+		parsed, err := model.ParseProjectFields(req.Msg.UpdateMask.GetPaths())
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+		fields = parsed
+	}
+
+	project, err := s.projectService.UpdateProject(ctx, userID, req.Msg.Id, &model.ProjectPatch{
+		Fields:      fields,
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&projectv1.UpdateProjectResponse{Project: toProtoProject(project)}), nil
+}
+
+// ArchiveProject (プロジェクトのアーカイブ)
+func (s *ProjectServiceServer) ArchiveProject(
+	ctx context.Context,
+	req *connect.Request[projectv1.ArchiveProjectRequest],
+) (*connect.Response[projectv1.ArchiveProjectResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	project, err := s.projectService.ArchiveProject(ctx, userID, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&projectv1.ArchiveProjectResponse{Project: toProtoProject(project)}), nil
+}
+
+// UnarchiveProject (プロジェクトのアーカイブ解除)
+func (s *ProjectServiceServer) UnarchiveProject(
+	ctx context.Context,
+	req *connect.Request[projectv1.UnarchiveProjectRequest],
+) (*connect.Response[projectv1.UnarchiveProjectResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	project, err := s.projectService.UnarchiveProject(ctx, userID, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&projectv1.UnarchiveProjectResponse{Project: toProtoProject(project)}), nil
+}
+
+// SetProjectWorkflow (プロジェクトのワークフロー変更)
+func (s *ProjectServiceServer) SetProjectWorkflow(
+	ctx context.Context,
+	req *connect.Request[projectv1.SetProjectWorkflowRequest],
+) (*connect.Response[projectv1.SetProjectWorkflowResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	var workflow *model.Workflow // 未設定の場合は既定のワークフローに戻す
+	if req.Msg.Workflow != nil {
+		w, err := toModelWorkflow(req.Msg.Workflow)
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+		workflow = w
+	}
+
+	project, err := s.projectService.SetProjectWorkflow(ctx, userID, req.Msg.Id, workflow)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&projectv1.SetProjectWorkflowResponse{Project: toProtoProject(project)}), nil
+}
+
+// DeleteProject (プロジェクト削除)
+func (s *ProjectServiceServer) DeleteProject(
+	ctx context.Context,
+	req *connect.Request[projectv1.DeleteProjectRequest],
+) (*connect.Response[projectv1.DeleteProjectResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	disposition, err := toModelProjectTaskDisposition(req.Msg.TaskDisposition)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if err := s.projectService.DeleteProject(ctx, userID, req.Msg.Id, disposition, req.Msg.TargetProjectId); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&projectv1.DeleteProjectResponse{}), nil
+}
+
+// toProtoProject は *model.Project を *projectv1.Project に変換するヘルパー関数
+func toProtoProject(project *model.Project) *projectv1.Project {
+	protoProject := &projectv1.Project{
+		Id:             project.ID,
+		OwnerId:        project.OwnerID,
+		Name:           project.Name,
+		Description:    project.Description,
+		Archived:       project.IsArchived(),
+		CreatedAt:      timestamppb.New(project.CreatedAt),
+		UpdatedAt:      timestamppb.New(project.UpdatedAt),
+		Workflow:       toProtoWorkflow(project.EffectiveWorkflow()),
+		CustomWorkflow: project.Workflow != nil,
+	}
+	if project.ArchivedAt != nil {
+		protoProject.ArchivedAt = timestamppb.New(*project.ArchivedAt)
+	}
+	return protoProject
+}
 
-	return res, nil
+// toProtoWorkflow は *model.Workflow を *projectv1.Workflow に変換するヘルパー関数
+func toProtoWorkflow(workflow *model.Workflow) *projectv1.Workflow {
+	protoWorkflow := &projectv1.Workflow{
+		InitialStatus: string(workflow.InitialStatus),
+		Statuses:      make([]*projectv1.WorkflowStatus, 0, len(workflow.Statuses)),
+		Transitions:   make([]*projectv1.WorkflowTransition, 0, len(workflow.Transitions)),
+	}
+	for _, status := range workflow.Statuses {
+		protoWorkflow.Statuses = append(protoWorkflow.Statuses, &projectv1.WorkflowStatus{
+			Key:  string(status.Key),
+			Name: status.Name,
+			Done: status.Done,
+		})
+	}
+	for _, transition := range workflow.Transitions {
+		protoWorkflow.Transitions = append(protoWorkflow.Transitions, &projectv1.WorkflowTransition{
+			From: string(transition.From),
+			To:   string(transition.To),
+		})
+	}
+	return protoWorkflow
 }
 
-func (s *UserServiceServer) UpdateUser(
+// toModelWorkflow は *projectv1.Workflow を検証して *model.Workflow に変換するヘルパー関数
+func toModelWorkflow(workflow *projectv1.Workflow) (*model.Workflow, error) {
+	statuses := make([]model.WorkflowStatus, 0, len(workflow.Statuses))
+	for _, status := range workflow.Statuses {
+		statuses = append(statuses, model.WorkflowStatus{
+			Key:  model.TaskStatus(status.Key),
+			Name: status.Name,
+			Done: status.Done,
+		})
+	}
+	transitions := make([]model.WorkflowTransition, 0, len(workflow.Transitions))
+	for _, transition := range workflow.Transitions {
+		transitions = append(transitions, model.WorkflowTransition{
+			From: model.TaskStatus(transition.From),
+			To:   model.TaskStatus(transition.To),
+		})
+	}
+	return model.NewWorkflow(model.TaskStatus(workflow.InitialStatus), statuses, transitions)
+}
+
+// toModelProjectTaskDisposition は projectv1.ProjectTaskDisposition を model.ProjectTaskDisposition に変換するヘルパー関数
+// 未指定の場合はエラーにします (タスクの扱いは削除ごとに明示的に選ぶ)。
+func toModelProjectTaskDisposition(disposition projectv1.ProjectTaskDisposition) (model.ProjectTaskDisposition, error) {
+	switch disposition {
+	case projectv1.ProjectTaskDisposition_PROJECT_TASK_DISPOSITION_DETACH:
+		return model.ProjectTaskDispositionDetach, nil
+	case projectv1.ProjectTaskDisposition_PROJECT_TASK_DISPOSITION_MOVE:
+		return model.ProjectTaskDispositionMove, nil
+	case projectv1.ProjectTaskDisposition_PROJECT_TASK_DISPOSITION_DELETE:
+		return model.ProjectTaskDispositionDelete, nil
+	default:
+		return "", fmt.Errorf("%w: %v", model.ErrInvalidProjectTaskDisposition, disposition)
+	}
+}
+
+// WorkspaceServiceServer (WorkspaceService のハンドラー)
+// required_workspace_role を指定したメソッドは、認可インターセプターでワークスペースでの役割を確認済みです。
+type WorkspaceServiceServer struct {
+	workspaceService *service.WorkspaceService
+}
+
+// NewWorkspaceServiceServer は WorkspaceServiceServer のコンストラクタ (Fx 用)
+func NewWorkspaceServiceServer(workspaceService *service.WorkspaceService) *WorkspaceServiceServer {
+	return &WorkspaceServiceServer{workspaceService: workspaceService}
+}
+
+// CreateWorkspace (ワークスペース作成)
+func (s *WorkspaceServiceServer) CreateWorkspace(
 	ctx context.Context,
-	req *connect.Request[userv1.UpdateUserRequest],
-) (*connect.Response[userv1.UpdateUserResponse], error) {
+	req *connect.Request[workspacev1.CreateWorkspaceRequest],
+) (*connect.Response[workspacev1.CreateWorkspaceResponse], error) {
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
 	}
 
-	updatedUser, err := s.userService.UpdateUser(ctx, userID, req.Msg.Name, req.Msg.Email, req.Msg.Password)
+	membership, err := s.workspaceService.CreateWorkspace(ctx, userID, req.Msg.Name)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, toConnectError(err)
 	}
+	return connect.NewResponse(&workspacev1.CreateWorkspaceResponse{Workspace: toProtoWorkspace(membership)}), nil
+}
 
-	res := connect.NewResponse(&userv1.UpdateUserResponse{
-		User: &userv1.User{
-			Id:        updatedUser.ID,
-			Name:      updatedUser.Name,
-			Email:     updatedUser.Email,
-			CreatedAt: updatedUser.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: updatedUser.UpdatedAt.Format(time.RFC3339),
-		},
-	})
+// ListWorkspaces (参加しているワークスペースの一覧)
+func (s *WorkspaceServiceServer) ListWorkspaces(
+	ctx context.Context,
+	req *connect.Request[workspacev1.ListWorkspacesRequest],
+) (*connect.Response[workspacev1.ListWorkspacesResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
 
-	return res, nil
+	memberships, err := s.workspaceService.ListWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	workspaces := make([]*workspacev1.Workspace, len(memberships))
+	for i, m := range memberships {
+		workspaces[i] = toProtoWorkspace(m)
+	}
+	return connect.NewResponse(&workspacev1.ListWorkspacesResponse{Workspaces: workspaces}), nil
 }
 
-func (s *UserServiceServer) Logout(
+// GetWorkspace (ワークスペース取得)
+func (s *WorkspaceServiceServer) GetWorkspace(
 	ctx context.Context,
-	req *connect.Request[userv1.LogoutRequest],
-) (*connect.Response[userv1.LogoutResponse], error) {
-
-	res := connect.NewResponse(&userv1.LogoutResponse{})
-	return res, nil
+	req *connect.Request[workspacev1.GetWorkspaceRequest],
+) (*connect.Response[workspacev1.GetWorkspaceResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
 
+	membership, err := s.workspaceService.GetWorkspace(ctx, userID, req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&workspacev1.GetWorkspaceResponse{Workspace: toProtoWorkspace(membership)}), nil
 }
 
-func (s *UserServiceServer) GetMe(
+// RenameWorkspace (ワークスペース名の変更)
+func (s *WorkspaceServiceServer) RenameWorkspace(
 	ctx context.Context,
-	req *connect.Request[userv1.GetMeRequest],
-) (*connect.Response[userv1.GetMeResponse], error) {
+	req *connect.Request[workspacev1.RenameWorkspaceRequest],
+) (*connect.Response[workspacev1.RenameWorkspaceResponse], error) {
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
 	}
 
-	user, err := s.userService.GetUserByID(ctx, userID)
+	membership, err := s.workspaceService.RenameWorkspace(ctx, userID, req.Msg.WorkspaceId, req.Msg.Name)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, toConnectError(err)
 	}
+	return connect.NewResponse(&workspacev1.RenameWorkspaceResponse{Workspace: toProtoWorkspace(membership)}), nil
+}
 
-	res := connect.NewResponse(&userv1.GetMeResponse{
-		User: &userv1.User{
-			Id:        user.ID,
-			Name:      user.Name,
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
-		},
-	})
+// DeleteWorkspace (ワークスペース削除)
+func (s *WorkspaceServiceServer) DeleteWorkspace(
+	ctx context.Context,
+	req *connect.Request[workspacev1.DeleteWorkspaceRequest],
+) (*connect.Response[workspacev1.DeleteWorkspaceResponse], error) {
+	if err := s.workspaceService.DeleteWorkspace(ctx, req.Msg.WorkspaceId); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&workspacev1.DeleteWorkspaceResponse{}), nil
+}
 
-	return res, nil
+// ListMembers (メンバー一覧)
+func (s *WorkspaceServiceServer) ListMembers(
+	ctx context.Context,
+	req *connect.Request[workspacev1.ListMembersRequest],
+) (*connect.Response[workspacev1.ListMembersResponse], error) {
+	members, err := s.workspaceService.ListMembers(ctx, req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	protoMembers := make([]*workspacev1.Member, len(members))
+	for i, m := range members {
+		protoMembers[i] = toProtoWorkspaceMember(m)
+	}
+	return connect.NewResponse(&workspacev1.ListMembersResponse{Members: protoMembers}), nil
 }
 
-// TaskServiceServer (TaskService のハンドラー)
-type TaskServiceServer struct {
-	taskService *service.TaskService
+// UpdateMemberRole (メンバーの役割の変更)
+func (s *WorkspaceServiceServer) UpdateMemberRole(
+	ctx context.Context,
+	req *connect.Request[workspacev1.UpdateMemberRoleRequest],
+) (*connect.Response[workspacev1.UpdateMemberRoleResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	role, err := model.ParseWorkspaceRole(req.Msg.Role)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	member, err := s.workspaceService.UpdateMemberRole(ctx, userID, req.Msg.WorkspaceId, req.Msg.UserId, role)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&workspacev1.UpdateMemberRoleResponse{Member: toProtoWorkspaceMember(member)}), nil
 }
 
-// NewTaskServiceServer は TaskServiceServer のコンストラクタ (Fx 用)
-func NewTaskServiceServer(taskService *service.TaskService) *TaskServiceServer {
-	return &TaskServiceServer{taskService: taskService}
+// RemoveMember (メンバーをワークスペースから外す)
+func (s *WorkspaceServiceServer) RemoveMember(
+	ctx context.Context,
+	req *connect.Request[workspacev1.RemoveMemberRequest],
+) (*connect.Response[workspacev1.RemoveMemberResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
+
+	if err := s.workspaceService.RemoveMember(ctx, userID, req.Msg.WorkspaceId, req.Msg.UserId); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&workspacev1.RemoveMemberResponse{}), nil
 }
 
-// CreateTask (タスク作成)
-func (s *TaskServiceServer) CreateTask(
+// LeaveWorkspace (ワークスペースから抜ける)
+func (s *WorkspaceServiceServer) LeaveWorkspace(
 	ctx context.Context,
-	req *connect.Request[taskv1.CreateTaskRequest],
-) (*connect.Response[taskv1.CreateTaskResponse], error) {
+	req *connect.Request[workspacev1.LeaveWorkspaceRequest],
+) (*connect.Response[workspacev1.LeaveWorkspaceResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
 
-	// 認証情報からユーザーIDを取得
+	if err := s.workspaceService.LeaveWorkspace(ctx, userID, req.Msg.WorkspaceId); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&workspacev1.LeaveWorkspaceResponse{}), nil
+}
+
+// InviteMember (メールアドレス宛ての招待)
+func (s *WorkspaceServiceServer) InviteMember(
+	ctx context.Context,
+	req *connect.Request[workspacev1.InviteMemberRequest],
+) (*connect.Response[workspacev1.InviteMemberResponse], error) {
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
 	}
 
-	var dueDate *time.Time
-	if req.Msg.DueDate != nil {
-		t := req.Msg.DueDate.AsTime()
-		dueDate = &t
+	role, err := model.ParseWorkspaceRole(req.Msg.Role)
+	if err != nil {
+		return nil, toConnectError(err)
 	}
+	invitation, err := s.workspaceService.InviteMember(ctx, userID, req.Msg.WorkspaceId, req.Msg.Email, role)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&workspacev1.InviteMemberResponse{Invitation: toProtoWorkspaceInvitation(invitation)}), nil
+}
 
-	err := s.taskService.CreateTask(ctx, req.Msg.Title, req.Msg.Description, userID, req.Msg.Priority, dueDate)
+// ListInvitations (ワークスペースの未回答の招待の一覧)
+func (s *WorkspaceServiceServer) ListInvitations(
+	ctx context.Context,
+	req *connect.Request[workspacev1.ListInvitationsRequest],
+) (*connect.Response[workspacev1.ListInvitationsResponse], error) {
+	invitations, err := s.workspaceService.ListInvitations(ctx, req.Msg.WorkspaceId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, toConnectError(err)
 	}
-	return connect.NewResponse(&taskv1.CreateTaskResponse{}), nil
+	return connect.NewResponse(&workspacev1.ListInvitationsResponse{Invitations: toProtoWorkspaceInvitations(invitations)}), nil
 }
 
-// UpdateTask (タスク更新)
-func (s *TaskServiceServer) UpdateTask(
+// RevokeInvitation (招待の取り消し)
+func (s *WorkspaceServiceServer) RevokeInvitation(
 	ctx context.Context,
-	req *connect.Request[taskv1.UpdateTaskRequest],
-) (*connect.Response[taskv1.UpdateTaskResponse], error) {
-	var assigneeID *string // ポインタ型の変数を宣言
-	if req.Msg.AssigneeId != nil {
-		s := req.Msg.AssigneeId.Value // 値を取得
-		assigneeID = &s               // ポインタを代入
+	req *connect.Request[workspacev1.RevokeInvitationRequest],
+) (*connect.Response[workspacev1.RevokeInvitationResponse], error) {
+	if err := s.workspaceService.RevokeInvitation(ctx, req.Msg.WorkspaceId, req.Msg.InvitationId); err != nil {
+		return nil, toConnectError(err)
 	}
+	return connect.NewResponse(&workspacev1.RevokeInvitationResponse{}), nil
+}
 
-	var dueDate *time.Time // ポインタ型の変数を宣言
-	if req.Msg.DueDate != nil {
-		t := req.Msg.DueDate.AsTime() // 値を取得
-		dueDate = &t                  // ポインタを代入
+// ListMyInvitations (自分宛ての招待の一覧)
+func (s *WorkspaceServiceServer) ListMyInvitations(
+	ctx context.Context,
+	req *connect.Request[workspacev1.ListMyInvitationsRequest],
+) (*connect.Response[workspacev1.ListMyInvitationsResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
 	}
 
-	updatedTask, err := s.taskService.UpdateTask(ctx, req.Msg.Id, req.Msg.Title, req.Msg.Description, req.Msg.IsCompleted, assigneeID, req.Msg.Priority, dueDate) //変更
+	invitations, err := s.workspaceService.ListMyInvitations(ctx, userID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, toConnectError(err)
 	}
-
-	res := connect.NewResponse(&taskv1.UpdateTaskResponse{
-		Task: &taskv1.Task{
-			Id:          updatedTask.ID,
-			Title:       updatedTask.Title,
-			Description: updatedTask.Description,
-			IsCompleted: updatedTask.IsCompleted,
-			UserId:      updatedTask.UserID,
-			AssigneeId:  nullString(updatedTask.AssigneeID),
-			Priority:    string(updatedTask.Priority),
-			DueDate:     timestamppb.New(*updatedTask.DueDate),
-			CreatedAt:   timestamppb.New(updatedTask.CreatedAt),
-			UpdatedAt:   timestamppb.New(updatedTask.UpdatedAt),
-		},
-	})
-	return res, nil
+	return connect.NewResponse(&workspacev1.ListMyInvitationsResponse{Invitations: toProtoWorkspaceInvitations(invitations)}), nil
 }
 
-// ListTasks (タスク一覧取得)
-func (s *TaskServiceServer) ListTasks(
+// AcceptInvitation (招待の承諾)
+func (s *WorkspaceServiceServer) AcceptInvitation(
 	ctx context.Context,
-	req *connect.Request[taskv1.ListTasksRequest],
-) (*connect.Response[taskv1.ListTasksResponse], error) {
-
-	// 認証情報からユーザーIDを取得
+	req *connect.Request[workspacev1.AcceptInvitationRequest],
+) (*connect.Response[workspacev1.AcceptInvitationResponse], error) {
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
 	}
 
-	tasks, err := s.taskService.ListTasks(ctx, userID)
+	membership, err := s.workspaceService.AcceptInvitation(ctx, userID, req.Msg.InvitationId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, toConnectError(err)
 	}
-
-	res := connect.NewResponse(&taskv1.ListTasksResponse{
-		Tasks: toProtoTasks(tasks), // []*model.Task から []*taskv1.Task への変換
-	})
-	return res, nil
+	return connect.NewResponse(&workspacev1.AcceptInvitationResponse{Workspace: toProtoWorkspace(membership)}), nil
 }
 
-// DeleteTask (タスク削除)
-func (s *TaskServiceServer) DeleteTask(
+// DeclineInvitation (招待の辞退)
+func (s *WorkspaceServiceServer) DeclineInvitation(
 	ctx context.Context,
-	req *connect.Request[taskv1.DeleteTaskRequest],
-) (*connect.Response[taskv1.DeleteTaskResponse], error) {
+	req *connect.Request[workspacev1.DeclineInvitationRequest],
+) (*connect.Response[workspacev1.DeclineInvitationResponse], error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user id not found in context"))
+	}
 
-	err := s.taskService.DeleteTask(ctx, req.Msg.Id)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	if err := s.workspaceService.DeclineInvitation(ctx, userID, req.Msg.InvitationId); err != nil {
+		return nil, toConnectError(err)
 	}
+	return connect.NewResponse(&workspacev1.DeclineInvitationResponse{}), nil
+}
 
-	return connect.NewResponse(&taskv1.DeleteTaskResponse{}), nil
+// toProtoWorkspace は *model.WorkspaceMembership を *workspacev1.Workspace に変換するヘルパー関数
+func toProtoWorkspace(membership *model.WorkspaceMembership) *workspacev1.Workspace {
+	return &workspacev1.Workspace{
+		Id:        membership.Workspace.ID,
+		Name:      membership.Workspace.Name,
+		Role:      string(membership.Role),
+		CreatedAt: timestamppb.New(membership.Workspace.CreatedAt),
+		UpdatedAt: timestamppb.New(membership.Workspace.UpdatedAt),
+	}
+}
+
+// toProtoWorkspaceMember は *model.WorkspaceMember を *workspacev1.Member に変換するヘルパー関数
+func toProtoWorkspaceMember(member *model.WorkspaceMember) *workspacev1.Member {
+	return &workspacev1.Member{
+		UserId:   member.UserID,
+		Name:     member.Name,
+		Email:    member.Email,
+		Role:     string(member.Role),
+		JoinedAt: timestamppb.New(member.CreatedAt),
+	}
+}
+
+// toProtoWorkspaceInvitations は []*model.WorkspaceInvitation を []*workspacev1.Invitation に変換するヘルパー関数
+func toProtoWorkspaceInvitations(invitations []*model.WorkspaceInvitation) []*workspacev1.Invitation {
+	protoInvitations := make([]*workspacev1.Invitation, len(invitations))
+	for i, invitation := range invitations {
+		protoInvitations[i] = toProtoWorkspaceInvitation(invitation)
+	}
+	return protoInvitations
+}
+
+// toProtoWorkspaceInvitation は *model.WorkspaceInvitation を *workspacev1.Invitation に変換するヘルパー関数
+func toProtoWorkspaceInvitation(invitation *model.WorkspaceInvitation) *workspacev1.Invitation {
+	return &workspacev1.Invitation{
+		Id:            invitation.ID,
+		WorkspaceId:   invitation.WorkspaceID,
+		WorkspaceName: invitation.WorkspaceName,
+		Email:         invitation.Email,
+		Role:          string(invitation.Role),
+		InvitedBy:     invitation.InvitedBy,
+		ExpiresAt:     timestamppb.New(invitation.ExpiresAt),
+		CreatedAt:     timestamppb.New(invitation.CreatedAt),
+	}
+}
+
+// toProtoTaskEvent は *model.TaskEvent を *taskv1.TaskEvent に変換するヘルパー関数
+func toProtoTaskEvent(e *model.TaskEvent) *taskv1.TaskEvent {
+	var eventType taskv1.TaskEventType
+	switch e.Type {
+	case model.TaskEventCreated:
+		eventType = taskv1.TaskEventType_TASK_EVENT_TYPE_CREATED
+	case model.TaskEventUpdated:
+		eventType = taskv1.TaskEventType_TASK_EVENT_TYPE_UPDATED
+	case model.TaskEventDeleted:
+		eventType = taskv1.TaskEventType_TASK_EVENT_TYPE_DELETED
+	}
+	return &taskv1.TaskEvent{
+		Id:         e.ID,
+		Type:       eventType,
+		Task:       toProtoTask(e.Task),
+		OccurredAt: timestamppb.New(e.OccurredAt),
+	}
 }
 
 // toProtoTasks は []*model.Task を []*taskv1.Task に変換するヘルパー関数
 func toProtoTasks(tasks []*model.Task) []*taskv1.Task {
 	protoTasks := make([]*taskv1.Task, len(tasks))
 	for i, task := range tasks {
-		var dueDate *timestamppb.Timestamp
-		if task.DueDate != nil {
-			dueDate = timestamppb.New(*task.DueDate)
-		}
-		protoTasks[i] = &taskv1.Task{
-			Id:          task.ID,
-			Title:       task.Title,
-			Description: task.Description,
-			IsCompleted: task.IsCompleted,
-			UserId:      task.UserID,
-			AssigneeId:  nullString(task.AssigneeID), // ヘルパー関数
-			Priority:    string(task.Priority),       // string に変換
-			DueDate:     dueDate,
-			CreatedAt:   timestamppb.New(task.CreatedAt),
-			UpdatedAt:   timestamppb.New(task.UpdatedAt),
-		}
-		if task.DueDate == nil {
-			protoTasks[i].DueDate = nil
-		}
+		protoTasks[i] = toProtoTask(task)
 	}
 	return protoTasks
 }
 
+// toProtoTask は *model.Task を *taskv1.Task に変換するヘルパー関数
+func toProtoTask(task *model.Task) *taskv1.Task {
+	var dueDate *timestamppb.Timestamp
+	if task.DueDate != nil {
+		dueDate = timestamppb.New(*task.DueDate)
+	}
+	return &taskv1.Task{
+		Id:          task.ID,
+		Title:       task.Title,
+		Description: task.Description,
+		IsCompleted: task.IsCompleted,
+		Status:      string(task.Status),
+		Rank:        task.Rank,
+		ParentId:    nullString(task.ParentID),
+		SubtaskProgress: &taskv1.SubtaskProgress{
+			Completed: int32(task.Subtasks.Completed),
+			Total:     int32(task.Subtasks.Total),
+		},
+		UserId:      task.UserID,
+		AssigneeId:  nullString(task.AssigneeID), // ヘルパー関数
+		Priority:    string(task.Priority),       // string に変換
+		DueDate:     dueDate,
+		CreatedAt:   timestamppb.New(task.CreatedAt),
+		UpdatedAt:   timestamppb.New(task.UpdatedAt),
+		Version:     task.Version,
+		ProjectId:   nullString(task.ProjectID),
+		WorkspaceId: nullString(task.WorkspaceID),
+	}
+}
+
+// toModelTaskFilter は ListTasksRequest の絞り込み条件を model.TaskFilter に変換するヘルパー関数
+func toModelTaskFilter(req *taskv1.ListTasksRequest) model.TaskFilter {
+	var filter model.TaskFilter
+	if req.IsCompleted != nil {
+		isCompleted := req.IsCompleted.Value
+		filter.IsCompleted = &isCompleted
+	}
+	if req.Status != "" {
+		status := model.TaskStatus(req.Status)
+		filter.Status = &status
+	}
+	if req.Priority != "" {
+		priority := model.Priority(req.Priority)
+		filter.Priority = &priority
+	}
+	if req.AssigneeId != nil {
+		assigneeID := req.AssigneeId.Value
+		filter.AssigneeID = &assigneeID
+	}
+	if req.DueFrom != nil {
+		dueFrom := req.DueFrom.AsTime()
+		filter.DueFrom = &dueFrom
+	}
+	if req.DueTo != nil {
+		dueTo := req.DueTo.AsTime()
+		filter.DueTo = &dueTo
+	}
+	if req.ProjectId != nil {
+		projectID := req.ProjectId.Value
+		filter.ProjectID = &projectID
+	}
+	return filter
+}
+
+// toProtoTaskTreeNode は *model.TaskTreeNode を *taskv1.TaskTreeNode に変換するヘルパー関数
+func toProtoTaskTreeNode(node *model.TaskTreeNode) *taskv1.TaskTreeNode {
+	protoNode := &taskv1.TaskTreeNode{
+		Task:     toProtoTask(node.Task),
+		Children: make([]*taskv1.TaskTreeNode, 0, len(node.Children)),
+	}
+	for _, child := range node.Children {
+		protoNode.Children = append(protoNode.Children, toProtoTaskTreeNode(child))
+	}
+	return protoNode
+}
+
+// toModelSubtaskDisposition は taskv1.SubtaskDisposition を model.SubtaskDisposition に変換するヘルパー関数
+func toModelSubtaskDisposition(disposition taskv1.SubtaskDisposition) (model.SubtaskDisposition, error) {
+	switch disposition {
+	case taskv1.SubtaskDisposition_SUBTASK_DISPOSITION_UNSPECIFIED:
+		return model.SubtaskDispositionUnspecified, nil
+	case taskv1.SubtaskDisposition_SUBTASK_DISPOSITION_CASCADE:
+		return model.SubtaskDispositionCascade, nil
+	case taskv1.SubtaskDisposition_SUBTASK_DISPOSITION_REPARENT:
+		return model.SubtaskDispositionReparent, nil
+	default:
+		return "", fmt.Errorf("%w: %v", model.ErrInvalidSubtaskDisposition, disposition)
+	}
+}
+
+// toModelTaskScope は taskv1.TaskScope を model.TaskScope に変換するヘルパー関数
+func toModelTaskScope(scope taskv1.TaskScope) (model.TaskScope, error) {
+	switch scope {
+	case taskv1.TaskScope_TASK_SCOPE_UNSPECIFIED, taskv1.TaskScope_TASK_SCOPE_CREATED_BY_ME:
+		return model.TaskScopeCreated, nil
+	case taskv1.TaskScope_TASK_SCOPE_ASSIGNED_TO_ME:
+		return model.TaskScopeAssigned, nil
+	case taskv1.TaskScope_TASK_SCOPE_CREATED_OR_ASSIGNED:
+		return model.TaskScopeCreatedOrAssigned, nil
+	case taskv1.TaskScope_TASK_SCOPE_WORKSPACE:
+		return model.TaskScopeWorkspace, nil
+	default:
+		return "", fmt.Errorf("%w: %v", model.ErrInvalidTaskScope, scope)
+	}
+}
+
+// toModelTaskSortKey は taskv1.TaskSortKey を model.TaskSortKey に変換するヘルパー関数
+func toModelTaskSortKey(key taskv1.TaskSortKey) (model.TaskSortKey, error) {
+	switch key {
+	case taskv1.TaskSortKey_TASK_SORT_KEY_UNSPECIFIED, taskv1.TaskSortKey_TASK_SORT_KEY_CREATED_AT:
+		return model.TaskSortKeyCreatedAt, nil
+	case taskv1.TaskSortKey_TASK_SORT_KEY_UPDATED_AT:
+		return model.TaskSortKeyUpdatedAt, nil
+	case taskv1.TaskSortKey_TASK_SORT_KEY_DUE_DATE:
+		return model.TaskSortKeyDueDate, nil
+	case taskv1.TaskSortKey_TASK_SORT_KEY_PRIORITY:
+		return model.TaskSortKeyPriority, nil
+	case taskv1.TaskSortKey_TASK_SORT_KEY_RANK:
+		return model.TaskSortKeyRank, nil
+	default:
+		return "", fmt.Errorf("%w: %v", model.ErrInvalidSortKey, key)
+	}
+}
+
+// taskETag はタスクのバージョンを ETag ヘッダーの値に変換するヘルパー関数
+func taskETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// expectedTaskVersion はリクエストのフィールドまたは If-Match ヘッダーから期待するバージョンを取得するヘルパー関数
+// どちらも未指定 (または If-Match: *) の場合は nil を返します。
+func expectedTaskVersion(header http.Header, field *wrapperspb.Int64Value) (*int64, error) {
+	var fromHeader *int64
+	if ifMatch := strings.TrimSpace(header.Get("If-Match")); ifMatch != "" && ifMatch != "*" {
+		v, err := strconv.ParseInt(strings.Trim(ifMatch, `"`), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid If-Match header: %q", ifMatch)
+		}
+		fromHeader = &v
+	}
+
+	if field == nil {
+		return fromHeader, nil
+	}
+	v := field.Value
+	if fromHeader != nil && *fromHeader != v {
+		return nil, fmt.Errorf("expected_version (%d) and If-Match header (%d) do not match", v, *fromHeader)
+	}
+	return &v, nil
+}
+
+// toConnectError はドメイン層のエラーを対応する connect のエラーコードに変換するヘルパー関数
+func toConnectError(err error) error {
+	var (
+		locked  *model.LoginLockedError
+		limited *model.RateLimitedError
+	)
+	switch {
+	case errors.As(err, &locked):
+		return retryAfterError(locked, locked.RetryAfter)
+	case errors.As(err, &limited):
+		return retryAfterError(limited, limited.RetryAfter)
+	case errors.Is(err, model.ErrInvalidRefreshToken),
+		errors.Is(err, model.ErrRefreshTokenReused),
+		errors.Is(err, model.ErrSSOAuthentication):
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, model.ErrTaskNotFound),
+		errors.Is(err, model.ErrUserNotFound),
+		errors.Is(err, model.ErrPersonalAccessTokenNotFound),
+		errors.Is(err, model.ErrProjectNotFound),
+		errors.Is(err, model.ErrWorkspaceNotFound),
+		errors.Is(err, model.ErrWorkspaceMemberNotFound),
+		errors.Is(err, model.ErrInvitationNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, model.ErrPermissionDenied),
+		errors.Is(err, model.ErrSSOSignupDisabled),
+		errors.Is(err, model.ErrUserDisabled):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, model.ErrTaskVersionMismatch),
+		errors.Is(err, model.ErrEmailNotVerified),
+		errors.Is(err, model.ErrAssigneeEmailNotVerified),
+		errors.Is(err, model.ErrTOTPAlreadyEnabled),
+		errors.Is(err, model.ErrTOTPNotEnabled),
+		errors.Is(err, model.ErrSSONotConfigured),
+		errors.Is(err, model.ErrSSOEmailNotVerified),
+		errors.Is(err, model.ErrCannotManageSelf),
+		errors.Is(err, model.ErrProjectArchived),
+		errors.Is(err, model.ErrLastWorkspaceOwner),
+		errors.Is(err, model.ErrInvitationNotPending),
+		errors.Is(err, model.ErrInvalidStatusTransition),
+		errors.Is(err, model.ErrWorkflowStatusInUse),
+		errors.Is(err, model.ErrTaskCycle),
+		errors.Is(err, model.ErrTaskDepthExceeded),
+		errors.Is(err, model.ErrTaskHasSubtasks):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, model.ErrAlreadyWorkspaceMember):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, model.ErrTaskConflict):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, model.ErrTaskEventsExpired):
+		return connect.NewError(connect.CodeOutOfRange, err)
+	case errors.Is(err, model.ErrInvalidPriority),
+		errors.Is(err, model.ErrInvalidPageSize),
+		errors.Is(err, model.ErrInvalidPageToken),
+		errors.Is(err, model.ErrInvalidSortKey),
+		errors.Is(err, model.ErrInvalidTaskScope),
+		errors.Is(err, model.ErrInvalidTaskField),
+		errors.Is(err, model.ErrImmutableTaskField),
+		errors.Is(err, model.ErrInvalidTaskEventID),
+		errors.Is(err, model.ErrInvalidTokenScope),
+		errors.Is(err, model.ErrInvalidTokenExpiry),
+		errors.Is(err, model.ErrTokenNameRequired),
+		errors.Is(err, model.ErrInvalidPasswordResetToken),
+		errors.Is(err, model.ErrPasswordRequired),
+		errors.Is(err, model.ErrInvalidEmail),
+		errors.Is(err, model.ErrInvalidEmailVerificationToken),
+		errors.Is(err, model.ErrInvalidTOTPCode),
+		errors.Is(err, model.ErrInvalidSSOState),
+		errors.Is(err, model.ErrInvalidSearchQuery),
+		errors.Is(err, model.ErrInvalidTaskDisposition),
+		errors.Is(err, model.ErrInvalidTaskSuccessor),
+		errors.Is(err, model.ErrTaskSuccessorNotAllowed),
+		errors.Is(err, model.ErrInvalidProjectName),
+		errors.Is(err, model.ErrInvalidProjectField),
+		errors.Is(err, model.ErrInvalidProjectTaskDisposition),
+		errors.Is(err, model.ErrInvalidTargetProject),
+		errors.Is(err, model.ErrTargetProjectNotAllowed),
+		errors.Is(err, model.ErrInvalidWorkspaceName),
+		errors.Is(err, model.ErrInvalidWorkspaceRole),
+		errors.Is(err, model.ErrAssigneeNotWorkspaceMember),
+		errors.Is(err, model.ErrInvalidTaskStatus),
+		errors.Is(err, model.ErrInvalidWorkflow),
+		errors.Is(err, model.ErrInvalidMoveTarget),
+		errors.Is(err, model.ErrInvalidParentTask),
+		errors.Is(err, model.ErrInvalidSubtaskDisposition):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// retryAfterError はログインのロックや呼び出し回数の制限を ResourceExhausted に変換し、再試行できるまでの時間を
+// RetryInfo の詳細と Retry-After ヘッダーで返すヘルパー関数
+func retryAfterError(err error, retryAfter time.Duration) error {
+	retryAfter = retryAfter.Round(time.Second)
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+
+	connectErr := connect.NewError(connect.CodeResourceExhausted, err)
+	if detail, err := connect.NewErrorDetail(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	connectErr.Meta().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+	return connectErr
+}
+
 // nullString は、*string から string への変換を行うヘルパー関数
 func nullString(s *string) string {
 	if s == nil {
@@ -279,22 +1831,86 @@ func nullString(s *string) string {
 }
 
 // NewUserServiceServer は UserServiceServer のコンストラクタ (Fx 用)
-func NewUserServiceServer(userService *service.UserService) *UserServiceServer {
-	return &UserServiceServer{userService: userService}
+func NewUserServiceServer(userService *service.UserService, patService *service.PersonalAccessTokenService, cfg *config.Config) *UserServiceServer {
+	return &UserServiceServer{userService: userService, patService: patService, trustProxyHeaders: cfg.App.TrustProxyHeaders}
+}
+
+// NewRequestLimiters はユーザーの検索やメールの送信の要求の呼び出し回数を制限する Limiter を提供
+func NewRequestLimiters(cfg *config.Config) *service.RequestLimiters {
+	return &service.RequestLimiters{
+		UserSearch:     ratelimitmemory.NewLimiter(cfg.UserSearch.RatePerMinute, cfg.UserSearch.Burst),
+		UserSearchByIP: ratelimitmemory.NewLimiter(cfg.UserSearch.IPRatePerMinute, cfg.UserSearch.IPBurst),
+		MailByEmail:    ratelimitmemory.NewLimiter(cfg.Mail.RatePerMinute, cfg.Mail.Burst),
+		MailByIP:       ratelimitmemory.NewLimiter(cfg.Mail.IPRatePerMinute, cfg.Mail.IPBurst),
+	}
+}
+
+// NewTokenRevocationRepository は設定に応じたアクセストークンの失効情報の保存先を提供
+func NewTokenRevocationRepository(cfg *config.Config) (repository.TokenRevocationRepository, error) {
+	switch cfg.JWT.RevocationStore {
+	case "mysql":
+		return mysql.NewTokenRevocationRepository(cfg)
+	case "memory":
+		return memoryrepo.NewTokenRevocationRepository(), nil
+	default:
+		return nil, fmt.Errorf("unknown token revocation store: %q", cfg.JWT.RevocationStore)
+	}
+}
+
+// NewLoginThrottleRepository は設定に応じたログインの失敗回数の保存先を提供
+func NewLoginThrottleRepository(cfg *config.Config) (repository.LoginThrottleRepository, error) {
+	switch cfg.LoginThrottle.Store {
+	case "mysql":
+		return mysql.NewLoginThrottleRepository(cfg)
+	case "memory":
+		return memoryrepo.NewLoginThrottleRepository(), nil
+	default:
+		return nil, fmt.Errorf("unknown login throttle store: %q", cfg.LoginThrottle.Store)
+	}
+}
+
+// NewIdentityProvider はシングルサインオンの ID プロバイダーを提供 (OIDC_ISSUER_URL が未設定の場合は nil)
+func NewIdentityProvider(cfg *config.Config) (sso.IdentityProvider, error) {
+	if cfg.OIDC.IssuerURL == "" {
+		return nil, nil
+	}
+	return oidc.NewProvider(cfg)
+}
+
+// NewMailer は設定に応じたメールの送信方法を提供
+func NewMailer(cfg *config.Config) (mailer.Mailer, error) {
+	switch cfg.Mail.Driver {
+	case "smtp":
+		return smtpmailer.NewSMTPMailer(cfg), nil
+	case "file":
+		return filemailer.NewOutbox(cfg)
+	case "memory":
+		return memorymailer.NewOutbox(), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver: %q", cfg.Mail.Driver)
+	}
 }
 
-// InterceptorFuncToInterceptor は connect.UnaryInterceptorFunc を connect.Interceptor に変換
-func InterceptorFuncToInterceptor(fn connect.UnaryInterceptorFunc) connect.Interceptor {
-	return connect.Interceptor(fn)
+// orderedInterceptor は適用順を持つインターセプター (Order が小さいほど外側で実行される)
+type orderedInterceptor interface {
+	Order() int
 }
 
-// NewInterceptors はインターセプターのリストを提供
-func NewInterceptors(interceptors []connect.UnaryInterceptorFunc) []connect.Interceptor {
-	converted := make([]connect.Interceptor, len(interceptors))
-	for i, interceptor := range interceptors {
-		converted[i] = connect.Interceptor(interceptor)
+// NewInterceptors は fx のグループで集めたインターセプターを適用順に並べて提供します。
+// fx のグループは順序を保証しないため、Order を実装していないものは最後に置きます。
+func NewInterceptors(interceptors []connect.Interceptor) []connect.Interceptor {
+	order := func(interceptor connect.Interceptor) int {
+		if o, ok := interceptor.(orderedInterceptor); ok {
+			return o.Order()
+		}
+		return math.MaxInt
 	}
-	return converted
+
+	sorted := slices.Clone(interceptors)
+	slices.SortStableFunc(sorted, func(a, b connect.Interceptor) int {
+		return cmp.Compare(order(a), order(b))
+	})
+	return sorted
 }
 
 // NewHTTPServer は HTTP サーバーのコンストラクタ
@@ -303,10 +1919,14 @@ func NewHTTPServer(
 	cfg *config.Config,
 	userServiceServer *UserServiceServer,
 	taskServiceServer *TaskServiceServer, //追加
+	adminServiceServer *AdminServiceServer,
+	projectServiceServer *ProjectServiceServer,
+	workspaceServiceServer *WorkspaceServiceServer,
 	log *zap.Logger,
 	interceptors []connect.Interceptor,
+	keys *jwt.KeySet,
 ) *http.Server {
-	services := []string{userv1connect.UserServiceName, taskv1connect.TaskServiceName}
+	services := []string{userv1connect.UserServiceName, taskv1connect.TaskServiceName, adminv1connect.AdminServiceName, projectv1connect.ProjectServiceName, workspacev1connect.WorkspaceServiceName}
 	reflector := grpcreflect.NewStaticReflector(services...)
 
 	mux := http.NewServeMux()
@@ -323,17 +1943,42 @@ func NewHTTPServer(
 		connect.WithInterceptors(interceptors...),
 	)
 	mux.Handle(taskPath, taskHandler)
+
+	// admin
+	adminPath, adminHandler := adminv1connect.NewAdminServiceHandler(
+		adminServiceServer,
+		connect.WithInterceptors(interceptors...),
+	)
+	mux.Handle(adminPath, adminHandler)
+
+	// project
+	projectPath, projectHandler := projectv1connect.NewProjectServiceHandler(
+		projectServiceServer,
+		connect.WithInterceptors(interceptors...),
+	)
+	mux.Handle(projectPath, projectHandler)
+
+	// workspace
+	workspacePath, workspaceHandler := workspacev1connect.NewWorkspaceServiceHandler(
+		workspaceServiceServer,
+		connect.WithInterceptors(interceptors...),
+	)
+	mux.Handle(workspacePath, workspaceHandler)
 	mux.Handle(path, handler)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
 
+	// 他のサービスがアクセストークンを検証するための公開鍵
+	mux.Handle(jwt.JWKSPath, keys)
+
 	// CORS ミドルウェアの作成 (設定は必要に応じて変更)
 	c := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"}, // 例: 許可するオリジン
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Authorization", "Content-Type", "Connect-Protocol-Version", "Connect-Timeout-Ms"}, // 許可するヘッダー
-		AllowCredentials: true,                                                                                        // 認証情報 (Cookie など) を許可するか
-		Debug:            true,                                                                                        // デバッグモード (ログ出力)
+		AllowedHeaders:   []string{"Authorization", "Content-Type", "Connect-Protocol-Version", "Connect-Timeout-Ms", "If-Match", "X-Request-ID"}, // 許可するヘッダー
+		ExposedHeaders:   []string{"ETag"},                                                                                                        // ブラウザから参照できるレスポンスヘッダー
+		AllowCredentials: true,                                                                                                                    // 認証情報 (Cookie など) を許可するか
+		Debug:            true,                                                                                                                    // デバッグモード (ログ出力)
 	})
 
 	// CORS ミドルウェアを適用
@@ -356,7 +2001,11 @@ func NewHTTPServer(
 		},
 		OnStop: func(ctx context.Context) error {
 			log.Info("Shutting down server...")
-			return server.Shutdown(ctx)
+			if err := server.Shutdown(ctx); err != nil {
+				return err
+			}
+			// 応答の後に送信しているメールが送り終わるまで待つ
+			return userServiceServer.userService.WaitBackground(ctx)
 		},
 	})
 
@@ -370,11 +2019,50 @@ func main() {
 			logger.NewLogger,
 			mysql.NewUserRepository,
 			mysql.NewTaskRepository, // 追加
+			mysql.NewProjectRepository,
+			mysql.NewWorkspaceRepository,
+			mysql.NewRefreshTokenRepository,
+			mysql.NewPersonalAccessTokenRepository,
+			mysql.NewPasswordResetTokenRepository,
+			mysql.NewEmailVerificationTokenRepository,
+			mysql.NewTOTPRepository,
+			NewMailer,
+			NewTokenRevocationRepository,
+			NewLoginThrottleRepository,
+			NewRequestLimiters,
+			mysql.NewLoginEventRepository,
+			mysql.NewUserIdentityRepository,
+			mysql.NewOIDCAuthRequestRepository,
+			NewIdentityProvider,
+			memory.NewTaskEventBroker,
+			jwt.NewKeySet,
 			jwt.NewJWTManager,
-			service.NewUserService,
+			encryption.NewSecretCipher,
+			service.NewLoginThrottler,
+			fx.Annotate(
+				service.NewUserService,
+				fx.As(fx.Self()),
+				fx.As(new(authorization.ActiveUserChecker)),
+			),
 			service.NewTaskService, // 追加
+			service.NewAdminService,
+			service.NewProjectService,
+			fx.Annotate(
+				service.NewWorkspaceService,
+				fx.As(fx.Self()),
+				fx.As(new(authorization.WorkspaceRoleResolver)),
+			),
+			fx.Annotate(
+				service.NewPersonalAccessTokenService,
+				fx.As(fx.Self()),
+				fx.As(new(authorization.PersonalAccessTokenAuthenticator)),
+			),
 			NewUserServiceServer,
 			NewTaskServiceServer, // 追加
+			NewAdminServiceServer,
+			NewProjectServiceServer,
+			NewWorkspaceServiceServer,
+			authorization.NewPermissionTable,
 			fx.Annotate(
 				authorization.NewAuthInterceptor,
 				fx.ResultTags(`group:"interceptors"`),
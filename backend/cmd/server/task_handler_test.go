@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	taskv1 "github.com/a-s/connect-task-manage/gen/api/task/v1"
+	eventmemory "github.com/a-s/connect-task-manage/internal/adapter/event/memory"
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/domain/service"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+)
+
+const handlerUserID = "alice"
+
+// handlerDB は何もしないトランザクションだけを提供するテスト用の DB です。
+var handlerDB = func() *sql.DB {
+	sql.Register("handler-fake", handlerDriver{})
+	db, err := sql.Open("handler-fake", "")
+	if err != nil {
+		panic(err)
+	}
+	return db
+}()
+
+type handlerDriver struct{}
+
+func (handlerDriver) Open(string) (driver.Conn, error) { return handlerConn{}, nil }
+
+type handlerConn struct{}
+
+func (handlerConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (handlerConn) Close() error                        { return nil }
+func (handlerConn) Begin() (driver.Tx, error)           { return handlerTx{}, nil }
+
+type handlerTx struct{}
+
+func (handlerTx) Commit() error   { return nil }
+func (handlerTx) Rollback() error { return nil }
+
+// handlerTaskRepository は TaskRepository のテスト用のインメモリ実装です。使用しないメソッドは実装していません。
+type handlerTaskRepository struct {
+	repository.TaskRepository
+
+	mu    sync.Mutex
+	tasks map[string]*model.Task
+}
+
+func (r *handlerTaskRepository) GetTaskByID(_ context.Context, id string) (*model.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	task, ok := r.tasks[id]
+	if !ok {
+		return nil, model.ErrTaskNotFound
+	}
+	copied := *task
+	return &copied, nil
+}
+
+func (r *handlerTaskRepository) GetTaskByIDForUpdate(ctx context.Context, id string) (*model.Task, error) {
+	return r.GetTaskByID(ctx, id)
+}
+
+func (r *handlerTaskRepository) ListDescendantsForUpdate(context.Context, string) ([]*model.Task, error) {
+	return nil, nil
+}
+
+func (r *handlerTaskRepository) UpdateTask(_ context.Context, task *model.Task) (*model.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *task
+	copied.Version++
+	r.tasks[task.ID] = &copied
+	updated := copied
+	return &updated, nil
+}
+
+func (r *handlerTaskRepository) LastRankInColumn(context.Context, model.BoardColumn, string) (string, error) {
+	return "", nil
+}
+
+func (r *handlerTaskRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return handlerDB.BeginTx(ctx, nil)
+}
+
+func (r *handlerTaskRepository) WithTx(*sql.Tx) repository.TaskRepository { return r }
+
+// handlerProjectRepository は ProjectRepository のテスト用のインメモリ実装です。
+type handlerProjectRepository struct {
+	repository.ProjectRepository
+	projects map[string]*model.Project
+}
+
+func (r *handlerProjectRepository) GetProjectByID(_ context.Context, id string) (*model.Project, error) {
+	project, ok := r.projects[id]
+	if !ok {
+		return nil, model.ErrProjectNotFound
+	}
+	return project, nil
+}
+
+// newTaskHandlerTest は projectID のプロジェクトに属するタスク "parent" と、その子のタスク "task" を持つ
+// TaskServiceServer を作成します。
+func newTaskHandlerTest(t *testing.T, projectID string) (*TaskServiceServer, *handlerTaskRepository) {
+	t.Helper()
+	tasks := &handlerTaskRepository{tasks: make(map[string]*model.Task)}
+	for _, id := range []string{"parent", "task"} {
+		task, err := model.NewTask(id, "", handlerUserID, model.PriorityMedium, nil)
+		if err != nil {
+			t.Fatalf("NewTask: %v", err)
+		}
+		task.ID = id
+		task.ProjectID = &projectID
+		tasks.tasks[id] = task
+	}
+	parentID := "parent"
+	tasks.tasks["task"].ParentID = &parentID
+
+	projects := &handlerProjectRepository{projects: map[string]*model.Project{
+		projectID: {ID: projectID, OwnerID: handlerUserID, Name: "project"},
+	}}
+	taskService := service.NewTaskService(tasks, nil, projects, nil, eventmemory.NewTaskEventBroker(), &config.Config{})
+	return NewTaskServiceServer(taskService), tasks
+}
+
+func updateTaskAs(t *testing.T, s *TaskServiceServer, msg *taskv1.UpdateTaskRequest) *taskv1.Task {
+	t.Helper()
+	ctx := context.WithValue(context.Background(), "userID", handlerUserID)
+	res, err := s.UpdateTask(ctx, connect.NewRequest(msg))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	return res.Msg.Task
+}
+
+// update_mask を指定しない既存のクライアントが project_id と parent_id を送らなくても、
+// タスクはプロジェクトから外れず、サブタスクのまま残る
+func TestUpdateTaskWithoutMaskKeepsProjectAndParent(t *testing.T) {
+	s, tasks := newTaskHandlerTest(t, "project")
+
+	updated := updateTaskAs(t, s, &taskv1.UpdateTaskRequest{Id: "task", Title: "renamed", Priority: "high"})
+	if updated.Title != "renamed" {
+		t.Errorf("title = %q, want renamed", updated.Title)
+	}
+	if got := tasks.tasks["task"].ProjectID; got == nil || *got != "project" {
+		t.Errorf("project = %v, want project", got)
+	}
+	if got := tasks.tasks["task"].ParentID; got == nil || *got != "parent" {
+		t.Errorf("parent = %v, want parent", got)
+	}
+}
+
+// project_id をマスクに含めた場合は、未設定にするとプロジェクトから外す
+func TestUpdateTaskWithProjectMask(t *testing.T) {
+	s, tasks := newTaskHandlerTest(t, "project")
+
+	updateTaskAs(t, s, &taskv1.UpdateTaskRequest{Id: "task", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"project_id"}}})
+	if got := tasks.tasks["task"].ProjectID; got != nil {
+		t.Errorf("project = %q, want none", *got)
+	}
+}
+
+// parent_id をマスクに含めた場合は、未設定にすると親のないタスクにする
+func TestUpdateTaskWithParentMask(t *testing.T) {
+	s, tasks := newTaskHandlerTest(t, "project")
+
+	updateTaskAs(t, s, &taskv1.UpdateTaskRequest{Id: "task", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"parent_id"}}})
+	if got := tasks.tasks["task"].ParentID; got != nil {
+		t.Errorf("parent = %q, want none", *got)
+	}
+}
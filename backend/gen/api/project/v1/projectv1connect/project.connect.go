@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/project/v1/project.proto
+
+package projectv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/a-s/connect-task-manage/gen/api/project/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProjectServiceName is the fully-qualified name of the ProjectService service.
+	ProjectServiceName = "project.v1.ProjectService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProjectServiceCreateProjectProcedure is the fully-qualified name of the ProjectService's
+	// CreateProject RPC.
+	ProjectServiceCreateProjectProcedure = "/project.v1.ProjectService/CreateProject"
+	// ProjectServiceGetProjectProcedure is the fully-qualified name of the ProjectService's GetProject
+	// RPC.
+	ProjectServiceGetProjectProcedure = "/project.v1.ProjectService/GetProject"
+	// ProjectServiceListProjectsProcedure is the fully-qualified name of the ProjectService's
+	// ListProjects RPC.
+	ProjectServiceListProjectsProcedure = "/project.v1.ProjectService/ListProjects"
+	// ProjectServiceUpdateProjectProcedure is the fully-qualified name of the ProjectService's
+	// UpdateProject RPC.
+	ProjectServiceUpdateProjectProcedure = "/project.v1.ProjectService/UpdateProject"
+	// ProjectServiceArchiveProjectProcedure is the fully-qualified name of the ProjectService's
+	// ArchiveProject RPC.
+	ProjectServiceArchiveProjectProcedure = "/project.v1.ProjectService/ArchiveProject"
+	// ProjectServiceUnarchiveProjectProcedure is the fully-qualified name of the ProjectService's
+	// UnarchiveProject RPC.
+	ProjectServiceUnarchiveProjectProcedure = "/project.v1.ProjectService/UnarchiveProject"
+	// ProjectServiceSetProjectWorkflowProcedure is the fully-qualified name of the ProjectService's
+	// SetProjectWorkflow RPC.
+	ProjectServiceSetProjectWorkflowProcedure = "/project.v1.ProjectService/SetProjectWorkflow"
+	// ProjectServiceDeleteProjectProcedure is the fully-qualified name of the ProjectService's
+	// DeleteProject RPC.
+	ProjectServiceDeleteProjectProcedure = "/project.v1.ProjectService/DeleteProject"
+)
+
+// ProjectServiceClient is a client for the project.v1.ProjectService service.
+type ProjectServiceClient interface {
+	CreateProject(context.Context, *connect.Request[v1.CreateProjectRequest]) (*connect.Response[v1.CreateProjectResponse], error)
+	GetProject(context.Context, *connect.Request[v1.GetProjectRequest]) (*connect.Response[v1.GetProjectResponse], error)
+	ListProjects(context.Context, *connect.Request[v1.ListProjectsRequest]) (*connect.Response[v1.ListProjectsResponse], error)
+	UpdateProject(context.Context, *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error)
+	ArchiveProject(context.Context, *connect.Request[v1.ArchiveProjectRequest]) (*connect.Response[v1.ArchiveProjectResponse], error)
+	UnarchiveProject(context.Context, *connect.Request[v1.UnarchiveProjectRequest]) (*connect.Response[v1.UnarchiveProjectResponse], error)
+	SetProjectWorkflow(context.Context, *connect.Request[v1.SetProjectWorkflowRequest]) (*connect.Response[v1.SetProjectWorkflowResponse], error)
+	DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error)
+}
+
+// NewProjectServiceClient constructs a client for the project.v1.ProjectService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProjectServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	projectServiceMethods := v1.File_api_project_v1_project_proto.Services().ByName("ProjectService").Methods()
+	return &projectServiceClient{
+		createProject: connect.NewClient[v1.CreateProjectRequest, v1.CreateProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceCreateProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("CreateProject")),
+			connect.WithClientOptions(opts...),
+		),
+		getProject: connect.NewClient[v1.GetProjectRequest, v1.GetProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceGetProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("GetProject")),
+			connect.WithClientOptions(opts...),
+		),
+		listProjects: connect.NewClient[v1.ListProjectsRequest, v1.ListProjectsResponse](
+			httpClient,
+			baseURL+ProjectServiceListProjectsProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListProjects")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProject: connect.NewClient[v1.UpdateProjectRequest, v1.UpdateProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceUpdateProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("UpdateProject")),
+			connect.WithClientOptions(opts...),
+		),
+		archiveProject: connect.NewClient[v1.ArchiveProjectRequest, v1.ArchiveProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceArchiveProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ArchiveProject")),
+			connect.WithClientOptions(opts...),
+		),
+		unarchiveProject: connect.NewClient[v1.UnarchiveProjectRequest, v1.UnarchiveProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceUnarchiveProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("UnarchiveProject")),
+			connect.WithClientOptions(opts...),
+		),
+		setProjectWorkflow: connect.NewClient[v1.SetProjectWorkflowRequest, v1.SetProjectWorkflowResponse](
+			httpClient,
+			baseURL+ProjectServiceSetProjectWorkflowProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("SetProjectWorkflow")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteProject: connect.NewClient[v1.DeleteProjectRequest, v1.DeleteProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceDeleteProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("DeleteProject")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// projectServiceClient implements ProjectServiceClient.
+type projectServiceClient struct {
+	createProject      *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
+	getProject         *connect.Client[v1.GetProjectRequest, v1.GetProjectResponse]
+	listProjects       *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
+	updateProject      *connect.Client[v1.UpdateProjectRequest, v1.UpdateProjectResponse]
+	archiveProject     *connect.Client[v1.ArchiveProjectRequest, v1.ArchiveProjectResponse]
+	unarchiveProject   *connect.Client[v1.UnarchiveProjectRequest, v1.UnarchiveProjectResponse]
+	setProjectWorkflow *connect.Client[v1.SetProjectWorkflowRequest, v1.SetProjectWorkflowResponse]
+	deleteProject      *connect.Client[v1.DeleteProjectRequest, v1.DeleteProjectResponse]
+}
+
+// CreateProject calls project.v1.ProjectService.CreateProject.
+func (c *projectServiceClient) CreateProject(ctx context.Context, req *connect.Request[v1.CreateProjectRequest]) (*connect.Response[v1.CreateProjectResponse], error) {
+	return c.createProject.CallUnary(ctx, req)
+}
+
+// GetProject calls project.v1.ProjectService.GetProject.
+func (c *projectServiceClient) GetProject(ctx context.Context, req *connect.Request[v1.GetProjectRequest]) (*connect.Response[v1.GetProjectResponse], error) {
+	return c.getProject.CallUnary(ctx, req)
+}
+
+// ListProjects calls project.v1.ProjectService.ListProjects.
+func (c *projectServiceClient) ListProjects(ctx context.Context, req *connect.Request[v1.ListProjectsRequest]) (*connect.Response[v1.ListProjectsResponse], error) {
+	return c.listProjects.CallUnary(ctx, req)
+}
+
+// UpdateProject calls project.v1.ProjectService.UpdateProject.
+func (c *projectServiceClient) UpdateProject(ctx context.Context, req *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error) {
+	return c.updateProject.CallUnary(ctx, req)
+}
+
+// ArchiveProject calls project.v1.ProjectService.ArchiveProject.
+func (c *projectServiceClient) ArchiveProject(ctx context.Context, req *connect.Request[v1.ArchiveProjectRequest]) (*connect.Response[v1.ArchiveProjectResponse], error) {
+	return c.archiveProject.CallUnary(ctx, req)
+}
+
+// UnarchiveProject calls project.v1.ProjectService.UnarchiveProject.
+func (c *projectServiceClient) UnarchiveProject(ctx context.Context, req *connect.Request[v1.UnarchiveProjectRequest]) (*connect.Response[v1.UnarchiveProjectResponse], error) {
+	return c.unarchiveProject.CallUnary(ctx, req)
+}
+
+// SetProjectWorkflow calls project.v1.ProjectService.SetProjectWorkflow.
+func (c *projectServiceClient) SetProjectWorkflow(ctx context.Context, req *connect.Request[v1.SetProjectWorkflowRequest]) (*connect.Response[v1.SetProjectWorkflowResponse], error) {
+	return c.setProjectWorkflow.CallUnary(ctx, req)
+}
+
+// DeleteProject calls project.v1.ProjectService.DeleteProject.
+func (c *projectServiceClient) DeleteProject(ctx context.Context, req *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error) {
+	return c.deleteProject.CallUnary(ctx, req)
+}
+
+// ProjectServiceHandler is an implementation of the project.v1.ProjectService service.
+type ProjectServiceHandler interface {
+	CreateProject(context.Context, *connect.Request[v1.CreateProjectRequest]) (*connect.Response[v1.CreateProjectResponse], error)
+	GetProject(context.Context, *connect.Request[v1.GetProjectRequest]) (*connect.Response[v1.GetProjectResponse], error)
+	ListProjects(context.Context, *connect.Request[v1.ListProjectsRequest]) (*connect.Response[v1.ListProjectsResponse], error)
+	UpdateProject(context.Context, *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error)
+	ArchiveProject(context.Context, *connect.Request[v1.ArchiveProjectRequest]) (*connect.Response[v1.ArchiveProjectResponse], error)
+	UnarchiveProject(context.Context, *connect.Request[v1.UnarchiveProjectRequest]) (*connect.Response[v1.UnarchiveProjectResponse], error)
+	SetProjectWorkflow(context.Context, *connect.Request[v1.SetProjectWorkflowRequest]) (*connect.Response[v1.SetProjectWorkflowResponse], error)
+	DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error)
+}
+
+// NewProjectServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	projectServiceMethods := v1.File_api_project_v1_project_proto.Services().ByName("ProjectService").Methods()
+	projectServiceCreateProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceCreateProjectProcedure,
+		svc.CreateProject,
+		connect.WithSchema(projectServiceMethods.ByName("CreateProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceGetProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceGetProjectProcedure,
+		svc.GetProject,
+		connect.WithSchema(projectServiceMethods.ByName("GetProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListProjectsHandler := connect.NewUnaryHandler(
+		ProjectServiceListProjectsProcedure,
+		svc.ListProjects,
+		connect.WithSchema(projectServiceMethods.ByName("ListProjects")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceUpdateProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceUpdateProjectProcedure,
+		svc.UpdateProject,
+		connect.WithSchema(projectServiceMethods.ByName("UpdateProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceArchiveProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceArchiveProjectProcedure,
+		svc.ArchiveProject,
+		connect.WithSchema(projectServiceMethods.ByName("ArchiveProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceUnarchiveProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceUnarchiveProjectProcedure,
+		svc.UnarchiveProject,
+		connect.WithSchema(projectServiceMethods.ByName("UnarchiveProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceSetProjectWorkflowHandler := connect.NewUnaryHandler(
+		ProjectServiceSetProjectWorkflowProcedure,
+		svc.SetProjectWorkflow,
+		connect.WithSchema(projectServiceMethods.ByName("SetProjectWorkflow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceDeleteProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceDeleteProjectProcedure,
+		svc.DeleteProject,
+		connect.WithSchema(projectServiceMethods.ByName("DeleteProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/project.v1.ProjectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProjectServiceCreateProjectProcedure:
+			projectServiceCreateProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceGetProjectProcedure:
+			projectServiceGetProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceListProjectsProcedure:
+			projectServiceListProjectsHandler.ServeHTTP(w, r)
+		case ProjectServiceUpdateProjectProcedure:
+			projectServiceUpdateProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceArchiveProjectProcedure:
+			projectServiceArchiveProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceUnarchiveProjectProcedure:
+			projectServiceUnarchiveProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceSetProjectWorkflowProcedure:
+			projectServiceSetProjectWorkflowHandler.ServeHTTP(w, r)
+		case ProjectServiceDeleteProjectProcedure:
+			projectServiceDeleteProjectHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProjectServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProjectServiceHandler struct{}
+
+func (UnimplementedProjectServiceHandler) CreateProject(context.Context, *connect.Request[v1.CreateProjectRequest]) (*connect.Response[v1.CreateProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.CreateProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) GetProject(context.Context, *connect.Request[v1.GetProjectRequest]) (*connect.Response[v1.GetProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.GetProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ListProjects(context.Context, *connect.Request[v1.ListProjectsRequest]) (*connect.Response[v1.ListProjectsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.ListProjects is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) UpdateProject(context.Context, *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.UpdateProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ArchiveProject(context.Context, *connect.Request[v1.ArchiveProjectRequest]) (*connect.Response[v1.ArchiveProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.ArchiveProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) UnarchiveProject(context.Context, *connect.Request[v1.UnarchiveProjectRequest]) (*connect.Response[v1.UnarchiveProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.UnarchiveProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) SetProjectWorkflow(context.Context, *connect.Request[v1.SetProjectWorkflowRequest]) (*connect.Response[v1.SetProjectWorkflowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.SetProjectWorkflow is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.DeleteProject is not implemented"))
+}
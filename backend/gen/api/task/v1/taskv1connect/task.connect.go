@@ -35,20 +35,36 @@ const (
 const (
 	// TaskServiceCreateTaskProcedure is the fully-qualified name of the TaskService's CreateTask RPC.
 	TaskServiceCreateTaskProcedure = "/task.v1.TaskService/CreateTask"
+	// TaskServiceGetTaskProcedure is the fully-qualified name of the TaskService's GetTask RPC.
+	TaskServiceGetTaskProcedure = "/task.v1.TaskService/GetTask"
 	// TaskServiceUpdateTaskProcedure is the fully-qualified name of the TaskService's UpdateTask RPC.
 	TaskServiceUpdateTaskProcedure = "/task.v1.TaskService/UpdateTask"
 	// TaskServiceListTasksProcedure is the fully-qualified name of the TaskService's ListTasks RPC.
 	TaskServiceListTasksProcedure = "/task.v1.TaskService/ListTasks"
+	// TaskServiceMoveTaskProcedure is the fully-qualified name of the TaskService's MoveTask RPC.
+	TaskServiceMoveTaskProcedure = "/task.v1.TaskService/MoveTask"
+	// TaskServiceListSubtasksProcedure is the fully-qualified name of the TaskService's ListSubtasks
+	// RPC.
+	TaskServiceListSubtasksProcedure = "/task.v1.TaskService/ListSubtasks"
+	// TaskServiceGetTaskTreeProcedure is the fully-qualified name of the TaskService's GetTaskTree RPC.
+	TaskServiceGetTaskTreeProcedure = "/task.v1.TaskService/GetTaskTree"
 	// TaskServiceDeleteTaskProcedure is the fully-qualified name of the TaskService's DeleteTask RPC.
 	TaskServiceDeleteTaskProcedure = "/task.v1.TaskService/DeleteTask"
+	// TaskServiceWatchTasksProcedure is the fully-qualified name of the TaskService's WatchTasks RPC.
+	TaskServiceWatchTasksProcedure = "/task.v1.TaskService/WatchTasks"
 )
 
 // TaskServiceClient is a client for the task.v1.TaskService service.
 type TaskServiceClient interface {
 	CreateTask(context.Context, *connect.Request[v1.CreateTaskRequest]) (*connect.Response[v1.CreateTaskResponse], error)
+	GetTask(context.Context, *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error)
 	UpdateTask(context.Context, *connect.Request[v1.UpdateTaskRequest]) (*connect.Response[v1.UpdateTaskResponse], error)
 	ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error)
+	MoveTask(context.Context, *connect.Request[v1.MoveTaskRequest]) (*connect.Response[v1.MoveTaskResponse], error)
+	ListSubtasks(context.Context, *connect.Request[v1.ListSubtasksRequest]) (*connect.Response[v1.ListSubtasksResponse], error)
+	GetTaskTree(context.Context, *connect.Request[v1.GetTaskTreeRequest]) (*connect.Response[v1.GetTaskTreeResponse], error)
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
+	WatchTasks(context.Context, *connect.Request[v1.WatchTasksRequest]) (*connect.ServerStreamForClient[v1.WatchTasksResponse], error)
 }
 
 // NewTaskServiceClient constructs a client for the task.v1.TaskService service. By default, it uses
@@ -68,6 +84,12 @@ func NewTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(taskServiceMethods.ByName("CreateTask")),
 			connect.WithClientOptions(opts...),
 		),
+		getTask: connect.NewClient[v1.GetTaskRequest, v1.GetTaskResponse](
+			httpClient,
+			baseURL+TaskServiceGetTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("GetTask")),
+			connect.WithClientOptions(opts...),
+		),
 		updateTask: connect.NewClient[v1.UpdateTaskRequest, v1.UpdateTaskResponse](
 			httpClient,
 			baseURL+TaskServiceUpdateTaskProcedure,
@@ -80,21 +102,50 @@ func NewTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(taskServiceMethods.ByName("ListTasks")),
 			connect.WithClientOptions(opts...),
 		),
+		moveTask: connect.NewClient[v1.MoveTaskRequest, v1.MoveTaskResponse](
+			httpClient,
+			baseURL+TaskServiceMoveTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("MoveTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listSubtasks: connect.NewClient[v1.ListSubtasksRequest, v1.ListSubtasksResponse](
+			httpClient,
+			baseURL+TaskServiceListSubtasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ListSubtasks")),
+			connect.WithClientOptions(opts...),
+		),
+		getTaskTree: connect.NewClient[v1.GetTaskTreeRequest, v1.GetTaskTreeResponse](
+			httpClient,
+			baseURL+TaskServiceGetTaskTreeProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("GetTaskTree")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteTask: connect.NewClient[v1.DeleteTaskRequest, v1.DeleteTaskResponse](
 			httpClient,
 			baseURL+TaskServiceDeleteTaskProcedure,
 			connect.WithSchema(taskServiceMethods.ByName("DeleteTask")),
 			connect.WithClientOptions(opts...),
 		),
+		watchTasks: connect.NewClient[v1.WatchTasksRequest, v1.WatchTasksResponse](
+			httpClient,
+			baseURL+TaskServiceWatchTasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("WatchTasks")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // taskServiceClient implements TaskServiceClient.
 type taskServiceClient struct {
-	createTask *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
-	updateTask *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
-	listTasks  *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
-	deleteTask *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	createTask   *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	getTask      *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	updateTask   *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
+	listTasks    *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	moveTask     *connect.Client[v1.MoveTaskRequest, v1.MoveTaskResponse]
+	listSubtasks *connect.Client[v1.ListSubtasksRequest, v1.ListSubtasksResponse]
+	getTaskTree  *connect.Client[v1.GetTaskTreeRequest, v1.GetTaskTreeResponse]
+	deleteTask   *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	watchTasks   *connect.Client[v1.WatchTasksRequest, v1.WatchTasksResponse]
 }
 
 // CreateTask calls task.v1.TaskService.CreateTask.
@@ -102,6 +153,11 @@ func (c *taskServiceClient) CreateTask(ctx context.Context, req *connect.Request
 	return c.createTask.CallUnary(ctx, req)
 }
 
+// GetTask calls task.v1.TaskService.GetTask.
+func (c *taskServiceClient) GetTask(ctx context.Context, req *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
+	return c.getTask.CallUnary(ctx, req)
+}
+
 // UpdateTask calls task.v1.TaskService.UpdateTask.
 func (c *taskServiceClient) UpdateTask(ctx context.Context, req *connect.Request[v1.UpdateTaskRequest]) (*connect.Response[v1.UpdateTaskResponse], error) {
 	return c.updateTask.CallUnary(ctx, req)
@@ -112,17 +168,42 @@ func (c *taskServiceClient) ListTasks(ctx context.Context, req *connect.Request[
 	return c.listTasks.CallUnary(ctx, req)
 }
 
+// MoveTask calls task.v1.TaskService.MoveTask.
+func (c *taskServiceClient) MoveTask(ctx context.Context, req *connect.Request[v1.MoveTaskRequest]) (*connect.Response[v1.MoveTaskResponse], error) {
+	return c.moveTask.CallUnary(ctx, req)
+}
+
+// ListSubtasks calls task.v1.TaskService.ListSubtasks.
+func (c *taskServiceClient) ListSubtasks(ctx context.Context, req *connect.Request[v1.ListSubtasksRequest]) (*connect.Response[v1.ListSubtasksResponse], error) {
+	return c.listSubtasks.CallUnary(ctx, req)
+}
+
+// GetTaskTree calls task.v1.TaskService.GetTaskTree.
+func (c *taskServiceClient) GetTaskTree(ctx context.Context, req *connect.Request[v1.GetTaskTreeRequest]) (*connect.Response[v1.GetTaskTreeResponse], error) {
+	return c.getTaskTree.CallUnary(ctx, req)
+}
+
 // DeleteTask calls task.v1.TaskService.DeleteTask.
 func (c *taskServiceClient) DeleteTask(ctx context.Context, req *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error) {
 	return c.deleteTask.CallUnary(ctx, req)
 }
 
+// WatchTasks calls task.v1.TaskService.WatchTasks.
+func (c *taskServiceClient) WatchTasks(ctx context.Context, req *connect.Request[v1.WatchTasksRequest]) (*connect.ServerStreamForClient[v1.WatchTasksResponse], error) {
+	return c.watchTasks.CallServerStream(ctx, req)
+}
+
 // TaskServiceHandler is an implementation of the task.v1.TaskService service.
 type TaskServiceHandler interface {
 	CreateTask(context.Context, *connect.Request[v1.CreateTaskRequest]) (*connect.Response[v1.CreateTaskResponse], error)
+	GetTask(context.Context, *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error)
 	UpdateTask(context.Context, *connect.Request[v1.UpdateTaskRequest]) (*connect.Response[v1.UpdateTaskResponse], error)
 	ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error)
+	MoveTask(context.Context, *connect.Request[v1.MoveTaskRequest]) (*connect.Response[v1.MoveTaskResponse], error)
+	ListSubtasks(context.Context, *connect.Request[v1.ListSubtasksRequest]) (*connect.Response[v1.ListSubtasksResponse], error)
+	GetTaskTree(context.Context, *connect.Request[v1.GetTaskTreeRequest]) (*connect.Response[v1.GetTaskTreeResponse], error)
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
+	WatchTasks(context.Context, *connect.Request[v1.WatchTasksRequest], *connect.ServerStream[v1.WatchTasksResponse]) error
 }
 
 // NewTaskServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -138,6 +219,12 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(taskServiceMethods.ByName("CreateTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	taskServiceGetTaskHandler := connect.NewUnaryHandler(
+		TaskServiceGetTaskProcedure,
+		svc.GetTask,
+		connect.WithSchema(taskServiceMethods.ByName("GetTask")),
+		connect.WithHandlerOptions(opts...),
+	)
 	taskServiceUpdateTaskHandler := connect.NewUnaryHandler(
 		TaskServiceUpdateTaskProcedure,
 		svc.UpdateTask,
@@ -150,22 +237,56 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(taskServiceMethods.ByName("ListTasks")),
 		connect.WithHandlerOptions(opts...),
 	)
+	taskServiceMoveTaskHandler := connect.NewUnaryHandler(
+		TaskServiceMoveTaskProcedure,
+		svc.MoveTask,
+		connect.WithSchema(taskServiceMethods.ByName("MoveTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceListSubtasksHandler := connect.NewUnaryHandler(
+		TaskServiceListSubtasksProcedure,
+		svc.ListSubtasks,
+		connect.WithSchema(taskServiceMethods.ByName("ListSubtasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceGetTaskTreeHandler := connect.NewUnaryHandler(
+		TaskServiceGetTaskTreeProcedure,
+		svc.GetTaskTree,
+		connect.WithSchema(taskServiceMethods.ByName("GetTaskTree")),
+		connect.WithHandlerOptions(opts...),
+	)
 	taskServiceDeleteTaskHandler := connect.NewUnaryHandler(
 		TaskServiceDeleteTaskProcedure,
 		svc.DeleteTask,
 		connect.WithSchema(taskServiceMethods.ByName("DeleteTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	taskServiceWatchTasksHandler := connect.NewServerStreamHandler(
+		TaskServiceWatchTasksProcedure,
+		svc.WatchTasks,
+		connect.WithSchema(taskServiceMethods.ByName("WatchTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/task.v1.TaskService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TaskServiceCreateTaskProcedure:
 			taskServiceCreateTaskHandler.ServeHTTP(w, r)
+		case TaskServiceGetTaskProcedure:
+			taskServiceGetTaskHandler.ServeHTTP(w, r)
 		case TaskServiceUpdateTaskProcedure:
 			taskServiceUpdateTaskHandler.ServeHTTP(w, r)
 		case TaskServiceListTasksProcedure:
 			taskServiceListTasksHandler.ServeHTTP(w, r)
+		case TaskServiceMoveTaskProcedure:
+			taskServiceMoveTaskHandler.ServeHTTP(w, r)
+		case TaskServiceListSubtasksProcedure:
+			taskServiceListSubtasksHandler.ServeHTTP(w, r)
+		case TaskServiceGetTaskTreeProcedure:
+			taskServiceGetTaskTreeHandler.ServeHTTP(w, r)
 		case TaskServiceDeleteTaskProcedure:
 			taskServiceDeleteTaskHandler.ServeHTTP(w, r)
+		case TaskServiceWatchTasksProcedure:
+			taskServiceWatchTasksHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -179,6 +300,10 @@ func (UnimplementedTaskServiceHandler) CreateTask(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.CreateTask is not implemented"))
 }
 
+func (UnimplementedTaskServiceHandler) GetTask(context.Context, *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.GetTask is not implemented"))
+}
+
 func (UnimplementedTaskServiceHandler) UpdateTask(context.Context, *connect.Request[v1.UpdateTaskRequest]) (*connect.Response[v1.UpdateTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.UpdateTask is not implemented"))
 }
@@ -187,6 +312,22 @@ func (UnimplementedTaskServiceHandler) ListTasks(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.ListTasks is not implemented"))
 }
 
+func (UnimplementedTaskServiceHandler) MoveTask(context.Context, *connect.Request[v1.MoveTaskRequest]) (*connect.Response[v1.MoveTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.MoveTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ListSubtasks(context.Context, *connect.Request[v1.ListSubtasksRequest]) (*connect.Response[v1.ListSubtasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.ListSubtasks is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) GetTaskTree(context.Context, *connect.Request[v1.GetTaskTreeRequest]) (*connect.Response[v1.GetTaskTreeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.GetTaskTree is not implemented"))
+}
+
 func (UnimplementedTaskServiceHandler) DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.DeleteTask is not implemented"))
 }
+
+func (UnimplementedTaskServiceHandler) WatchTasks(context.Context, *connect.Request[v1.WatchTasksRequest], *connect.ServerStream[v1.WatchTasksResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.WatchTasks is not implemented"))
+}
@@ -0,0 +1,521 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/workspace/v1/workspace.proto
+
+package workspacev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/a-s/connect-task-manage/gen/api/workspace/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WorkspaceServiceName is the fully-qualified name of the WorkspaceService service.
+	WorkspaceServiceName = "workspace.v1.WorkspaceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WorkspaceServiceCreateWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
+	// CreateWorkspace RPC.
+	WorkspaceServiceCreateWorkspaceProcedure = "/workspace.v1.WorkspaceService/CreateWorkspace"
+	// WorkspaceServiceListWorkspacesProcedure is the fully-qualified name of the WorkspaceService's
+	// ListWorkspaces RPC.
+	WorkspaceServiceListWorkspacesProcedure = "/workspace.v1.WorkspaceService/ListWorkspaces"
+	// WorkspaceServiceGetWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
+	// GetWorkspace RPC.
+	WorkspaceServiceGetWorkspaceProcedure = "/workspace.v1.WorkspaceService/GetWorkspace"
+	// WorkspaceServiceRenameWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
+	// RenameWorkspace RPC.
+	WorkspaceServiceRenameWorkspaceProcedure = "/workspace.v1.WorkspaceService/RenameWorkspace"
+	// WorkspaceServiceDeleteWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
+	// DeleteWorkspace RPC.
+	WorkspaceServiceDeleteWorkspaceProcedure = "/workspace.v1.WorkspaceService/DeleteWorkspace"
+	// WorkspaceServiceListMembersProcedure is the fully-qualified name of the WorkspaceService's
+	// ListMembers RPC.
+	WorkspaceServiceListMembersProcedure = "/workspace.v1.WorkspaceService/ListMembers"
+	// WorkspaceServiceUpdateMemberRoleProcedure is the fully-qualified name of the WorkspaceService's
+	// UpdateMemberRole RPC.
+	WorkspaceServiceUpdateMemberRoleProcedure = "/workspace.v1.WorkspaceService/UpdateMemberRole"
+	// WorkspaceServiceRemoveMemberProcedure is the fully-qualified name of the WorkspaceService's
+	// RemoveMember RPC.
+	WorkspaceServiceRemoveMemberProcedure = "/workspace.v1.WorkspaceService/RemoveMember"
+	// WorkspaceServiceLeaveWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
+	// LeaveWorkspace RPC.
+	WorkspaceServiceLeaveWorkspaceProcedure = "/workspace.v1.WorkspaceService/LeaveWorkspace"
+	// WorkspaceServiceInviteMemberProcedure is the fully-qualified name of the WorkspaceService's
+	// InviteMember RPC.
+	WorkspaceServiceInviteMemberProcedure = "/workspace.v1.WorkspaceService/InviteMember"
+	// WorkspaceServiceListInvitationsProcedure is the fully-qualified name of the WorkspaceService's
+	// ListInvitations RPC.
+	WorkspaceServiceListInvitationsProcedure = "/workspace.v1.WorkspaceService/ListInvitations"
+	// WorkspaceServiceRevokeInvitationProcedure is the fully-qualified name of the WorkspaceService's
+	// RevokeInvitation RPC.
+	WorkspaceServiceRevokeInvitationProcedure = "/workspace.v1.WorkspaceService/RevokeInvitation"
+	// WorkspaceServiceListMyInvitationsProcedure is the fully-qualified name of the WorkspaceService's
+	// ListMyInvitations RPC.
+	WorkspaceServiceListMyInvitationsProcedure = "/workspace.v1.WorkspaceService/ListMyInvitations"
+	// WorkspaceServiceAcceptInvitationProcedure is the fully-qualified name of the WorkspaceService's
+	// AcceptInvitation RPC.
+	WorkspaceServiceAcceptInvitationProcedure = "/workspace.v1.WorkspaceService/AcceptInvitation"
+	// WorkspaceServiceDeclineInvitationProcedure is the fully-qualified name of the WorkspaceService's
+	// DeclineInvitation RPC.
+	WorkspaceServiceDeclineInvitationProcedure = "/workspace.v1.WorkspaceService/DeclineInvitation"
+)
+
+// WorkspaceServiceClient is a client for the workspace.v1.WorkspaceService service.
+type WorkspaceServiceClient interface {
+	CreateWorkspace(context.Context, *connect.Request[v1.CreateWorkspaceRequest]) (*connect.Response[v1.CreateWorkspaceResponse], error)
+	ListWorkspaces(context.Context, *connect.Request[v1.ListWorkspacesRequest]) (*connect.Response[v1.ListWorkspacesResponse], error)
+	GetWorkspace(context.Context, *connect.Request[v1.GetWorkspaceRequest]) (*connect.Response[v1.GetWorkspaceResponse], error)
+	RenameWorkspace(context.Context, *connect.Request[v1.RenameWorkspaceRequest]) (*connect.Response[v1.RenameWorkspaceResponse], error)
+	DeleteWorkspace(context.Context, *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[v1.DeleteWorkspaceResponse], error)
+	// メンバー関連
+	ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error)
+	UpdateMemberRole(context.Context, *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error)
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error)
+	LeaveWorkspace(context.Context, *connect.Request[v1.LeaveWorkspaceRequest]) (*connect.Response[v1.LeaveWorkspaceResponse], error)
+	// 招待関連 (ワークスペースの管理者向け)
+	InviteMember(context.Context, *connect.Request[v1.InviteMemberRequest]) (*connect.Response[v1.InviteMemberResponse], error)
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error)
+	// 招待関連 (招待されたユーザー向け。メールアドレスの確認が必要)
+	ListMyInvitations(context.Context, *connect.Request[v1.ListMyInvitationsRequest]) (*connect.Response[v1.ListMyInvitationsResponse], error)
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error)
+	DeclineInvitation(context.Context, *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error)
+}
+
+// NewWorkspaceServiceClient constructs a client for the workspace.v1.WorkspaceService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWorkspaceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WorkspaceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	workspaceServiceMethods := v1.File_api_workspace_v1_workspace_proto.Services().ByName("WorkspaceService").Methods()
+	return &workspaceServiceClient{
+		createWorkspace: connect.NewClient[v1.CreateWorkspaceRequest, v1.CreateWorkspaceResponse](
+			httpClient,
+			baseURL+WorkspaceServiceCreateWorkspaceProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("CreateWorkspace")),
+			connect.WithClientOptions(opts...),
+		),
+		listWorkspaces: connect.NewClient[v1.ListWorkspacesRequest, v1.ListWorkspacesResponse](
+			httpClient,
+			baseURL+WorkspaceServiceListWorkspacesProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("ListWorkspaces")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkspace: connect.NewClient[v1.GetWorkspaceRequest, v1.GetWorkspaceResponse](
+			httpClient,
+			baseURL+WorkspaceServiceGetWorkspaceProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("GetWorkspace")),
+			connect.WithClientOptions(opts...),
+		),
+		renameWorkspace: connect.NewClient[v1.RenameWorkspaceRequest, v1.RenameWorkspaceResponse](
+			httpClient,
+			baseURL+WorkspaceServiceRenameWorkspaceProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("RenameWorkspace")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWorkspace: connect.NewClient[v1.DeleteWorkspaceRequest, v1.DeleteWorkspaceResponse](
+			httpClient,
+			baseURL+WorkspaceServiceDeleteWorkspaceProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("DeleteWorkspace")),
+			connect.WithClientOptions(opts...),
+		),
+		listMembers: connect.NewClient[v1.ListMembersRequest, v1.ListMembersResponse](
+			httpClient,
+			baseURL+WorkspaceServiceListMembersProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("ListMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMemberRole: connect.NewClient[v1.UpdateMemberRoleRequest, v1.UpdateMemberRoleResponse](
+			httpClient,
+			baseURL+WorkspaceServiceUpdateMemberRoleProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("UpdateMemberRole")),
+			connect.WithClientOptions(opts...),
+		),
+		removeMember: connect.NewClient[v1.RemoveMemberRequest, v1.RemoveMemberResponse](
+			httpClient,
+			baseURL+WorkspaceServiceRemoveMemberProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("RemoveMember")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveWorkspace: connect.NewClient[v1.LeaveWorkspaceRequest, v1.LeaveWorkspaceResponse](
+			httpClient,
+			baseURL+WorkspaceServiceLeaveWorkspaceProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("LeaveWorkspace")),
+			connect.WithClientOptions(opts...),
+		),
+		inviteMember: connect.NewClient[v1.InviteMemberRequest, v1.InviteMemberResponse](
+			httpClient,
+			baseURL+WorkspaceServiceInviteMemberProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("InviteMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvitations: connect.NewClient[v1.ListInvitationsRequest, v1.ListInvitationsResponse](
+			httpClient,
+			baseURL+WorkspaceServiceListInvitationsProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("ListInvitations")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvitation: connect.NewClient[v1.RevokeInvitationRequest, v1.RevokeInvitationResponse](
+			httpClient,
+			baseURL+WorkspaceServiceRevokeInvitationProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("RevokeInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		listMyInvitations: connect.NewClient[v1.ListMyInvitationsRequest, v1.ListMyInvitationsResponse](
+			httpClient,
+			baseURL+WorkspaceServiceListMyInvitationsProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("ListMyInvitations")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvitation: connect.NewClient[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse](
+			httpClient,
+			baseURL+WorkspaceServiceAcceptInvitationProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("AcceptInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		declineInvitation: connect.NewClient[v1.DeclineInvitationRequest, v1.DeclineInvitationResponse](
+			httpClient,
+			baseURL+WorkspaceServiceDeclineInvitationProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("DeclineInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// workspaceServiceClient implements WorkspaceServiceClient.
+type workspaceServiceClient struct {
+	createWorkspace   *connect.Client[v1.CreateWorkspaceRequest, v1.CreateWorkspaceResponse]
+	listWorkspaces    *connect.Client[v1.ListWorkspacesRequest, v1.ListWorkspacesResponse]
+	getWorkspace      *connect.Client[v1.GetWorkspaceRequest, v1.GetWorkspaceResponse]
+	renameWorkspace   *connect.Client[v1.RenameWorkspaceRequest, v1.RenameWorkspaceResponse]
+	deleteWorkspace   *connect.Client[v1.DeleteWorkspaceRequest, v1.DeleteWorkspaceResponse]
+	listMembers       *connect.Client[v1.ListMembersRequest, v1.ListMembersResponse]
+	updateMemberRole  *connect.Client[v1.UpdateMemberRoleRequest, v1.UpdateMemberRoleResponse]
+	removeMember      *connect.Client[v1.RemoveMemberRequest, v1.RemoveMemberResponse]
+	leaveWorkspace    *connect.Client[v1.LeaveWorkspaceRequest, v1.LeaveWorkspaceResponse]
+	inviteMember      *connect.Client[v1.InviteMemberRequest, v1.InviteMemberResponse]
+	listInvitations   *connect.Client[v1.ListInvitationsRequest, v1.ListInvitationsResponse]
+	revokeInvitation  *connect.Client[v1.RevokeInvitationRequest, v1.RevokeInvitationResponse]
+	listMyInvitations *connect.Client[v1.ListMyInvitationsRequest, v1.ListMyInvitationsResponse]
+	acceptInvitation  *connect.Client[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse]
+	declineInvitation *connect.Client[v1.DeclineInvitationRequest, v1.DeclineInvitationResponse]
+}
+
+// CreateWorkspace calls workspace.v1.WorkspaceService.CreateWorkspace.
+func (c *workspaceServiceClient) CreateWorkspace(ctx context.Context, req *connect.Request[v1.CreateWorkspaceRequest]) (*connect.Response[v1.CreateWorkspaceResponse], error) {
+	return c.createWorkspace.CallUnary(ctx, req)
+}
+
+// ListWorkspaces calls workspace.v1.WorkspaceService.ListWorkspaces.
+func (c *workspaceServiceClient) ListWorkspaces(ctx context.Context, req *connect.Request[v1.ListWorkspacesRequest]) (*connect.Response[v1.ListWorkspacesResponse], error) {
+	return c.listWorkspaces.CallUnary(ctx, req)
+}
+
+// GetWorkspace calls workspace.v1.WorkspaceService.GetWorkspace.
+func (c *workspaceServiceClient) GetWorkspace(ctx context.Context, req *connect.Request[v1.GetWorkspaceRequest]) (*connect.Response[v1.GetWorkspaceResponse], error) {
+	return c.getWorkspace.CallUnary(ctx, req)
+}
+
+// RenameWorkspace calls workspace.v1.WorkspaceService.RenameWorkspace.
+func (c *workspaceServiceClient) RenameWorkspace(ctx context.Context, req *connect.Request[v1.RenameWorkspaceRequest]) (*connect.Response[v1.RenameWorkspaceResponse], error) {
+	return c.renameWorkspace.CallUnary(ctx, req)
+}
+
+// DeleteWorkspace calls workspace.v1.WorkspaceService.DeleteWorkspace.
+func (c *workspaceServiceClient) DeleteWorkspace(ctx context.Context, req *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[v1.DeleteWorkspaceResponse], error) {
+	return c.deleteWorkspace.CallUnary(ctx, req)
+}
+
+// ListMembers calls workspace.v1.WorkspaceService.ListMembers.
+func (c *workspaceServiceClient) ListMembers(ctx context.Context, req *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error) {
+	return c.listMembers.CallUnary(ctx, req)
+}
+
+// UpdateMemberRole calls workspace.v1.WorkspaceService.UpdateMemberRole.
+func (c *workspaceServiceClient) UpdateMemberRole(ctx context.Context, req *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error) {
+	return c.updateMemberRole.CallUnary(ctx, req)
+}
+
+// RemoveMember calls workspace.v1.WorkspaceService.RemoveMember.
+func (c *workspaceServiceClient) RemoveMember(ctx context.Context, req *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error) {
+	return c.removeMember.CallUnary(ctx, req)
+}
+
+// LeaveWorkspace calls workspace.v1.WorkspaceService.LeaveWorkspace.
+func (c *workspaceServiceClient) LeaveWorkspace(ctx context.Context, req *connect.Request[v1.LeaveWorkspaceRequest]) (*connect.Response[v1.LeaveWorkspaceResponse], error) {
+	return c.leaveWorkspace.CallUnary(ctx, req)
+}
+
+// InviteMember calls workspace.v1.WorkspaceService.InviteMember.
+func (c *workspaceServiceClient) InviteMember(ctx context.Context, req *connect.Request[v1.InviteMemberRequest]) (*connect.Response[v1.InviteMemberResponse], error) {
+	return c.inviteMember.CallUnary(ctx, req)
+}
+
+// ListInvitations calls workspace.v1.WorkspaceService.ListInvitations.
+func (c *workspaceServiceClient) ListInvitations(ctx context.Context, req *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return c.listInvitations.CallUnary(ctx, req)
+}
+
+// RevokeInvitation calls workspace.v1.WorkspaceService.RevokeInvitation.
+func (c *workspaceServiceClient) RevokeInvitation(ctx context.Context, req *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error) {
+	return c.revokeInvitation.CallUnary(ctx, req)
+}
+
+// ListMyInvitations calls workspace.v1.WorkspaceService.ListMyInvitations.
+func (c *workspaceServiceClient) ListMyInvitations(ctx context.Context, req *connect.Request[v1.ListMyInvitationsRequest]) (*connect.Response[v1.ListMyInvitationsResponse], error) {
+	return c.listMyInvitations.CallUnary(ctx, req)
+}
+
+// AcceptInvitation calls workspace.v1.WorkspaceService.AcceptInvitation.
+func (c *workspaceServiceClient) AcceptInvitation(ctx context.Context, req *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error) {
+	return c.acceptInvitation.CallUnary(ctx, req)
+}
+
+// DeclineInvitation calls workspace.v1.WorkspaceService.DeclineInvitation.
+func (c *workspaceServiceClient) DeclineInvitation(ctx context.Context, req *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error) {
+	return c.declineInvitation.CallUnary(ctx, req)
+}
+
+// WorkspaceServiceHandler is an implementation of the workspace.v1.WorkspaceService service.
+type WorkspaceServiceHandler interface {
+	CreateWorkspace(context.Context, *connect.Request[v1.CreateWorkspaceRequest]) (*connect.Response[v1.CreateWorkspaceResponse], error)
+	ListWorkspaces(context.Context, *connect.Request[v1.ListWorkspacesRequest]) (*connect.Response[v1.ListWorkspacesResponse], error)
+	GetWorkspace(context.Context, *connect.Request[v1.GetWorkspaceRequest]) (*connect.Response[v1.GetWorkspaceResponse], error)
+	RenameWorkspace(context.Context, *connect.Request[v1.RenameWorkspaceRequest]) (*connect.Response[v1.RenameWorkspaceResponse], error)
+	DeleteWorkspace(context.Context, *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[v1.DeleteWorkspaceResponse], error)
+	// メンバー関連
+	ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error)
+	UpdateMemberRole(context.Context, *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error)
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error)
+	LeaveWorkspace(context.Context, *connect.Request[v1.LeaveWorkspaceRequest]) (*connect.Response[v1.LeaveWorkspaceResponse], error)
+	// 招待関連 (ワークスペースの管理者向け)
+	InviteMember(context.Context, *connect.Request[v1.InviteMemberRequest]) (*connect.Response[v1.InviteMemberResponse], error)
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error)
+	// 招待関連 (招待されたユーザー向け。メールアドレスの確認が必要)
+	ListMyInvitations(context.Context, *connect.Request[v1.ListMyInvitationsRequest]) (*connect.Response[v1.ListMyInvitationsResponse], error)
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error)
+	DeclineInvitation(context.Context, *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error)
+}
+
+// NewWorkspaceServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWorkspaceServiceHandler(svc WorkspaceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	workspaceServiceMethods := v1.File_api_workspace_v1_workspace_proto.Services().ByName("WorkspaceService").Methods()
+	workspaceServiceCreateWorkspaceHandler := connect.NewUnaryHandler(
+		WorkspaceServiceCreateWorkspaceProcedure,
+		svc.CreateWorkspace,
+		connect.WithSchema(workspaceServiceMethods.ByName("CreateWorkspace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceListWorkspacesHandler := connect.NewUnaryHandler(
+		WorkspaceServiceListWorkspacesProcedure,
+		svc.ListWorkspaces,
+		connect.WithSchema(workspaceServiceMethods.ByName("ListWorkspaces")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceGetWorkspaceHandler := connect.NewUnaryHandler(
+		WorkspaceServiceGetWorkspaceProcedure,
+		svc.GetWorkspace,
+		connect.WithSchema(workspaceServiceMethods.ByName("GetWorkspace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceRenameWorkspaceHandler := connect.NewUnaryHandler(
+		WorkspaceServiceRenameWorkspaceProcedure,
+		svc.RenameWorkspace,
+		connect.WithSchema(workspaceServiceMethods.ByName("RenameWorkspace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceDeleteWorkspaceHandler := connect.NewUnaryHandler(
+		WorkspaceServiceDeleteWorkspaceProcedure,
+		svc.DeleteWorkspace,
+		connect.WithSchema(workspaceServiceMethods.ByName("DeleteWorkspace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceListMembersHandler := connect.NewUnaryHandler(
+		WorkspaceServiceListMembersProcedure,
+		svc.ListMembers,
+		connect.WithSchema(workspaceServiceMethods.ByName("ListMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceUpdateMemberRoleHandler := connect.NewUnaryHandler(
+		WorkspaceServiceUpdateMemberRoleProcedure,
+		svc.UpdateMemberRole,
+		connect.WithSchema(workspaceServiceMethods.ByName("UpdateMemberRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceRemoveMemberHandler := connect.NewUnaryHandler(
+		WorkspaceServiceRemoveMemberProcedure,
+		svc.RemoveMember,
+		connect.WithSchema(workspaceServiceMethods.ByName("RemoveMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceLeaveWorkspaceHandler := connect.NewUnaryHandler(
+		WorkspaceServiceLeaveWorkspaceProcedure,
+		svc.LeaveWorkspace,
+		connect.WithSchema(workspaceServiceMethods.ByName("LeaveWorkspace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceInviteMemberHandler := connect.NewUnaryHandler(
+		WorkspaceServiceInviteMemberProcedure,
+		svc.InviteMember,
+		connect.WithSchema(workspaceServiceMethods.ByName("InviteMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceListInvitationsHandler := connect.NewUnaryHandler(
+		WorkspaceServiceListInvitationsProcedure,
+		svc.ListInvitations,
+		connect.WithSchema(workspaceServiceMethods.ByName("ListInvitations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceRevokeInvitationHandler := connect.NewUnaryHandler(
+		WorkspaceServiceRevokeInvitationProcedure,
+		svc.RevokeInvitation,
+		connect.WithSchema(workspaceServiceMethods.ByName("RevokeInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceListMyInvitationsHandler := connect.NewUnaryHandler(
+		WorkspaceServiceListMyInvitationsProcedure,
+		svc.ListMyInvitations,
+		connect.WithSchema(workspaceServiceMethods.ByName("ListMyInvitations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceAcceptInvitationHandler := connect.NewUnaryHandler(
+		WorkspaceServiceAcceptInvitationProcedure,
+		svc.AcceptInvitation,
+		connect.WithSchema(workspaceServiceMethods.ByName("AcceptInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceDeclineInvitationHandler := connect.NewUnaryHandler(
+		WorkspaceServiceDeclineInvitationProcedure,
+		svc.DeclineInvitation,
+		connect.WithSchema(workspaceServiceMethods.ByName("DeclineInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/workspace.v1.WorkspaceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WorkspaceServiceCreateWorkspaceProcedure:
+			workspaceServiceCreateWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceListWorkspacesProcedure:
+			workspaceServiceListWorkspacesHandler.ServeHTTP(w, r)
+		case WorkspaceServiceGetWorkspaceProcedure:
+			workspaceServiceGetWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceRenameWorkspaceProcedure:
+			workspaceServiceRenameWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceDeleteWorkspaceProcedure:
+			workspaceServiceDeleteWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceListMembersProcedure:
+			workspaceServiceListMembersHandler.ServeHTTP(w, r)
+		case WorkspaceServiceUpdateMemberRoleProcedure:
+			workspaceServiceUpdateMemberRoleHandler.ServeHTTP(w, r)
+		case WorkspaceServiceRemoveMemberProcedure:
+			workspaceServiceRemoveMemberHandler.ServeHTTP(w, r)
+		case WorkspaceServiceLeaveWorkspaceProcedure:
+			workspaceServiceLeaveWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceInviteMemberProcedure:
+			workspaceServiceInviteMemberHandler.ServeHTTP(w, r)
+		case WorkspaceServiceListInvitationsProcedure:
+			workspaceServiceListInvitationsHandler.ServeHTTP(w, r)
+		case WorkspaceServiceRevokeInvitationProcedure:
+			workspaceServiceRevokeInvitationHandler.ServeHTTP(w, r)
+		case WorkspaceServiceListMyInvitationsProcedure:
+			workspaceServiceListMyInvitationsHandler.ServeHTTP(w, r)
+		case WorkspaceServiceAcceptInvitationProcedure:
+			workspaceServiceAcceptInvitationHandler.ServeHTTP(w, r)
+		case WorkspaceServiceDeclineInvitationProcedure:
+			workspaceServiceDeclineInvitationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWorkspaceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWorkspaceServiceHandler struct{}
+
+func (UnimplementedWorkspaceServiceHandler) CreateWorkspace(context.Context, *connect.Request[v1.CreateWorkspaceRequest]) (*connect.Response[v1.CreateWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.CreateWorkspace is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) ListWorkspaces(context.Context, *connect.Request[v1.ListWorkspacesRequest]) (*connect.Response[v1.ListWorkspacesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.ListWorkspaces is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) GetWorkspace(context.Context, *connect.Request[v1.GetWorkspaceRequest]) (*connect.Response[v1.GetWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.GetWorkspace is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) RenameWorkspace(context.Context, *connect.Request[v1.RenameWorkspaceRequest]) (*connect.Response[v1.RenameWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.RenameWorkspace is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) DeleteWorkspace(context.Context, *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[v1.DeleteWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.DeleteWorkspace is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.ListMembers is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) UpdateMemberRole(context.Context, *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.UpdateMemberRole is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.RemoveMember is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) LeaveWorkspace(context.Context, *connect.Request[v1.LeaveWorkspaceRequest]) (*connect.Response[v1.LeaveWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.LeaveWorkspace is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) InviteMember(context.Context, *connect.Request[v1.InviteMemberRequest]) (*connect.Response[v1.InviteMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.InviteMember is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.ListInvitations is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.RevokeInvitation is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) ListMyInvitations(context.Context, *connect.Request[v1.ListMyInvitationsRequest]) (*connect.Response[v1.ListMyInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.ListMyInvitations is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.AcceptInvitation is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) DeclineInvitation(context.Context, *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.DeclineInvitation is not implemented"))
+}
@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/event"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/google/uuid"
+)
+
+// historySize は再開・遅延した購読者のために保持するイベント数
+const historySize = 1024
+
+// taskEventBroker はプロセス内でイベントを配信する TaskEventBroker の実装です。
+//
+// 直近 historySize 件のイベントをリングバッファに保持し、各購読者は読み込み位置 (シーケンス番号) だけを持ちます。
+// 購読者ごとのバッファを持たないため、処理の遅い購読者がいてもメモリ使用量は一定で、
+// 発行側がブロックされることもありません。リングバッファから外れるほど遅れた購読者は
+// model.ErrTaskEventsExpired を受け取り、一覧を取得し直す必要があります。
+type taskEventBroker struct {
+	mu      sync.Mutex
+	epoch   string             // プロセスごとの識別子 (再起動前のイベント ID を区別する)
+	history []*model.TaskEvent // シーケンス番号 % historySize の位置にイベントを保持する
+	next    uint64             // 次に採番するシーケンス番号 (1 始まり)
+	notify  chan struct{}      // 発行のたびに close して作り直す
+}
+
+// NewTaskEventBroker は新しいプロセス内 TaskEventBroker を作成します。
+func NewTaskEventBroker() event.TaskEventBroker {
+	return &taskEventBroker{
+		epoch:   uuid.NewString()[:8],
+		history: make([]*model.TaskEvent, historySize),
+		next:    1,
+		notify:  make(chan struct{}),
+	}
+}
+
+func (b *taskEventBroker) Publish(e *model.TaskEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	seq := b.next
+	b.next++
+	e.ID = fmt.Sprintf("%s-%d", b.epoch, seq)
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+	b.history[seq%historySize] = e
+
+	// 待機中の購読者を起こす
+	close(b.notify)
+	b.notify = make(chan struct{})
+}
+
+func (b *taskEventBroker) Subscribe(afterID string) (event.TaskEventSubscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if afterID == "" {
+		return &subscription{broker: b, cursor: b.next - 1}, nil
+	}
+
+	epoch, seqStr, ok := strings.Cut(afterID, "-")
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if !ok || err != nil {
+		return nil, fmt.Errorf("%w: %q", model.ErrInvalidTaskEventID, afterID)
+	}
+	if epoch != b.epoch || seq >= b.next {
+		// 再起動前のイベント ID
+		return nil, model.ErrTaskEventsExpired
+	}
+	if seq+1 < b.oldest() {
+		return nil, model.ErrTaskEventsExpired
+	}
+	return &subscription{broker: b, cursor: seq}, nil
+}
+
+// oldest は保持している最も古いイベントのシーケンス番号を返します。mu を保持して呼び出します。
+func (b *taskEventBroker) oldest() uint64 {
+	if b.next <= historySize {
+		return 1
+	}
+	return b.next - historySize
+}
+
+// subscription は taskEventBroker の購読です。
+type subscription struct {
+	broker *taskEventBroker
+	cursor uint64 // 最後に受信したイベントのシーケンス番号
+}
+
+func (s *subscription) Next(ctx context.Context) (*model.TaskEvent, error) {
+	b := s.broker
+	for {
+		b.mu.Lock()
+		if s.cursor+1 < b.oldest() {
+			b.mu.Unlock()
+			return nil, model.ErrTaskEventsExpired
+		}
+		if s.cursor+1 < b.next {
+			s.cursor++
+			e := b.history[s.cursor%historySize]
+			b.mu.Unlock()
+			return e, nil
+		}
+		notify := b.notify
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-notify:
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package event
+
+import (
+	"context"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// TaskEventBroker はタスクの変更イベントの配信を抽象化するインターフェースです。
+type TaskEventBroker interface {
+	// Publish はイベントに ID を採番して購読者に配信します。
+	Publish(event *model.TaskEvent)
+	// Subscribe は afterID の次のイベントから受信する購読を開始します。
+	// afterID が空文字の場合は購読開始以降のイベントのみを受信します。
+	// afterID のイベントがすでに保持されていない場合は model.ErrTaskEventsExpired を返します。
+	Subscribe(afterID string) (TaskEventSubscription, error)
+}
+
+// TaskEventSubscription はイベントの購読を表すインターフェースです。
+type TaskEventSubscription interface {
+	// Next は次のイベントが発行されるまで待機して返します。
+	// 購読者の処理が遅れて未受信のイベントが破棄された場合は model.ErrTaskEventsExpired を返します。
+	Next(ctx context.Context) (*model.TaskEvent, error)
+}
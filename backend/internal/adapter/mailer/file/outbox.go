@@ -0,0 +1,51 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/google/uuid"
+)
+
+// outbox は送信したメールをディレクトリにファイルとして書き出す Mailer の実装です (開発用)。
+type outbox struct {
+	dir  string
+	from string
+}
+
+// NewOutbox は新しいファイルの Outbox を返します。ディレクトリが存在しない場合は作成します。
+func NewOutbox(cfg *config.Config) (mailer.Mailer, error) {
+	if err := os.MkdirAll(cfg.Mail.OutboxDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mail outbox directory: %w", err)
+	}
+	return &outbox{dir: cfg.Mail.OutboxDir, from: cfg.Mail.From}, nil
+}
+
+// Send はメールを <日時>-<ID>.eml という名前のファイルに書き出します。
+func (o *outbox) Send(ctx context.Context, msg *mailer.Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405.000"), uuid.New().String())
+
+	var b strings.Builder
+	b.WriteString("From: " + o.from + "\n")
+	b.WriteString("To: " + msg.To + "\n")
+	b.WriteString("Subject: " + msg.Subject + "\n")
+	b.WriteString("Date: " + now.Format(time.RFC1123Z) + "\n")
+	b.WriteString("\n")
+	b.WriteString(msg.Body)
+
+	if err := os.WriteFile(filepath.Join(o.dir, name), []byte(b.String()), 0o600); err != nil {
+		return fmt.Errorf("failed to write mail to outbox: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// Message は送信するメールを表します。
+type Message struct {
+	To      string
+	Subject string
+	Body    string // プレーンテキスト
+}
+
+// Mailer はメール送信を抽象化するインターフェースです。
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Validate は宛先と件名がヘッダーとして安全に使用できるかを検証します。
+func (m *Message) Validate() error {
+	if _, err := mail.ParseAddress(m.To); err != nil {
+		return fmt.Errorf("invalid recipient %q: %w", m.To, err)
+	}
+	if strings.ContainsAny(m.To+m.Subject, "\r\n") {
+		return fmt.Errorf("mail header must not contain line breaks")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
+)
+
+// Outbox は送信したメールをメモリに保持する Mailer の実装です (テストや開発用)。
+type Outbox struct {
+	mu       sync.Mutex
+	messages []*mailer.Message
+}
+
+// NewOutbox は新しい Outbox を返します。
+func NewOutbox() *Outbox {
+	return &Outbox{}
+}
+
+// Send はメールを送信せずに保持します。
+func (o *Outbox) Send(ctx context.Context, msg *mailer.Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	copied := *msg
+	o.messages = append(o.messages, &copied)
+	return nil
+}
+
+// Messages はこれまでに送信されたメールを古い順に返します。
+func (o *Outbox) Messages() []*mailer.Message {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	messages := make([]*mailer.Message, len(o.messages))
+	copy(messages, o.messages)
+	return messages
+}
+
+// Last は最後に送信されたメールを返します。
+func (o *Outbox) Last() (*mailer.Message, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if len(o.messages) == 0 {
+		return nil, false
+	}
+	return o.messages[len(o.messages)-1], true
+}
@@ -0,0 +1,73 @@
+package smtp
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+)
+
+// smtpMailer は SMTP サーバー経由でメールを送信する Mailer の実装です。
+// サーバーが STARTTLS に対応している場合は自動的に使用します。
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth // 認証しない場合は nil
+	from string
+}
+
+// NewSMTPMailer は新しい SMTP の Mailer を返します。
+func NewSMTPMailer(cfg *config.Config) mailer.Mailer {
+	var auth smtp.Auth
+	if cfg.Mail.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", cfg.Mail.SMTPUsername, cfg.Mail.SMTPPassword, cfg.Mail.SMTPHost)
+	}
+	return &smtpMailer{
+		addr: net.JoinHostPort(cfg.Mail.SMTPHost, cfg.Mail.SMTPPort),
+		auth: auth,
+		from: cfg.Mail.From,
+	}
+}
+
+// Send はメールを送信します。
+// smtp.SendMail はコンテキストに対応していないため、キャンセルされた場合は結果を待たずに戻ります。
+func (m *smtpMailer) Send(ctx context.Context, msg *mailer.Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, m.build(msg))
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to send mail: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// build は RFC 5322 形式のメッセージを組み立てます。
+func (m *smtpMailer) build(msg *mailer.Message) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + m.from + "\r\n")
+	b.WriteString("To: " + msg.To + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", msg.Subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
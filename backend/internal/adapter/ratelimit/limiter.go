@@ -0,0 +1,13 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limiter はキーごとに一定時間あたりの呼び出し回数を制限するインターフェースです。
+type Limiter interface {
+	// Allow はキーの呼び出しを 1 回分消費します。許可した場合は 0 を返します。
+	// 上限に達している場合は消費せず、次に呼び出せるようになるまでの時間を返します。
+	Allow(ctx context.Context, key string) (time.Duration, error)
+}
@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/ratelimit"
+)
+
+// pruneInterval は回復しきったバケットを削除する間隔です。
+const pruneInterval = time.Minute
+
+// bucket はキーごとのトークンバケットです。
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// limiter は Limiter のトークンバケットによるインメモリ実装です。
+// 呼び出し回数はプロセス内にのみ保持されるため、複数のインスタンスで動かす場合の上限はインスタンス数倍になります。
+type limiter struct {
+	interval time.Duration // トークンが 1 つ回復するまでの時間
+	burst    float64       // 連続して呼び出せる回数 (バケットの容量)
+
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	prunedAt time.Time
+}
+
+// NewLimiter は 1 分あたり perMinute 回 (連続して burst 回まで) 呼び出せる Limiter を返します。
+func NewLimiter(perMinute, burst int) ratelimit.Limiter {
+	interval := time.Minute / time.Duration(max(perMinute, 1))
+	return &limiter{
+		interval: interval,
+		burst:    float64(max(burst, 1)),
+		buckets:  make(map[string]*bucket),
+	}
+}
+
+func (l *limiter) Allow(ctx context.Context, key string) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.prune(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, updatedAt: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.updatedAt = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, nil
+	}
+	return time.Duration((1 - b.tokens) * float64(l.interval)), nil
+}
+
+// refill は経過時間に応じて回復したトークンの数を返します (容量を超えない)。
+func (l *limiter) refill(b *bucket, now time.Time) float64 {
+	elapsed := now.Sub(b.updatedAt)
+	return min(l.burst, b.tokens+float64(elapsed)/float64(l.interval))
+}
+
+// prune は容量まで回復したバケットを削除します (初めての呼び出しと同じ状態のため)。
+func (l *limiter) prune(now time.Time) {
+	if now.Sub(l.prunedAt) < pruneInterval {
+		return
+	}
+	l.prunedAt = now
+	for k, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, k)
+		}
+	}
+}
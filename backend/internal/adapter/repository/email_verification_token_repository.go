@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// EmailVerificationTokenRepository はメールアドレスの確認用トークンへのアクセスを抽象化するインターフェースです。
+type EmailVerificationTokenRepository interface {
+	CreateEmailVerificationToken(ctx context.Context, token *model.EmailVerificationToken) error
+	GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error) // 見つからない場合は model.ErrInvalidEmailVerificationToken
+	MarkEmailVerificationTokenUsed(ctx context.Context, id string) error                                          // 使用済みの場合は model.ErrInvalidEmailVerificationToken
+	InvalidateUserEmailVerificationTokens(ctx context.Context, userID string) error                               // ユーザーの未使用のトークンをすべて無効にする
+
+	// トランザクション関連
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	WithTx(tx *sql.Tx) EmailVerificationTokenRepository
+}
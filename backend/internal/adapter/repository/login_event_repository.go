@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// LoginEventRepository はログインの試行の記録へのアクセスを抽象化するインターフェースです。
+type LoginEventRepository interface {
+	CreateLoginEvent(ctx context.Context, event *model.LoginEvent) error
+	ListLoginEventsByUser(ctx context.Context, userID string, limit int) ([]*model.LoginEvent, error) // 新しい順
+}
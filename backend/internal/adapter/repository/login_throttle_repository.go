@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// LoginThrottleRepository はログインの失敗回数とロック状態へのアクセスを抽象化するインターフェースです。
+// キーはアカウント (メールアドレス) またはクライアント IP ごとに分けて記録します。
+type LoginThrottleRepository interface {
+	// RecordLoginFailure は失敗を記録し、続けて失敗した回数を返します。
+	// 加算と加算後の回数の取得は 1 つの操作で行い、同時に記録した失敗には異なる回数を返します。
+	// 前回の失敗 (ロック中の場合はロックの解除) から window が経過している場合は 1 からやり直します。
+	RecordLoginFailure(ctx context.Context, key string, failedAt time.Time, window time.Duration) (int, error)
+	// LockLogin は now の時点でロックされていない場合のみ until までロックし、ロックしたかどうかを返します。
+	LockLogin(ctx context.Context, key string, now, until time.Time) (bool, error)
+	// CancelLoginFailure は RecordLoginFailure で記録した失敗を 1 回分取り消します。unlock が true の場合はロックも解除します。
+	CancelLoginFailure(ctx context.Context, key string, unlock bool) error
+	GetLoginLockedUntil(ctx context.Context, key string) (time.Time, error) // ロックされていない場合はゼロ値を返す
+	ResetLoginFailures(ctx context.Context, key string) error
+}
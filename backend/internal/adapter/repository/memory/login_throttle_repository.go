@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+)
+
+// loginThrottle はキーごとの失敗回数とロック状態です。
+type loginThrottle struct {
+	failures      int
+	lastFailureAt time.Time
+	lockedUntil   time.Time
+}
+
+// loginThrottleRepository は LoginThrottleRepository のインメモリ実装です。
+// 失敗回数はプロセス内にのみ保持されるため、単一インスタンスでの運用や開発用途を想定しています。
+type loginThrottleRepository struct {
+	mu        sync.Mutex
+	throttles map[string]*loginThrottle
+}
+
+// NewLoginThrottleRepository は新しい LoginThrottleRepository のインメモリ実装を返します。
+func NewLoginThrottleRepository() repository.LoginThrottleRepository {
+	return &loginThrottleRepository{
+		throttles: make(map[string]*loginThrottle),
+	}
+}
+
+// RecordLoginFailure は失敗を記録し、続けて失敗した回数を返します。
+// あわせて、失敗回数がリセットされる時間を過ぎたキーを削除します。
+func (r *loginThrottleRepository) RecordLoginFailure(ctx context.Context, key string, failedAt time.Time, window time.Duration) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	windowStart := failedAt.Add(-window)
+	for k, t := range r.throttles {
+		if t.expired(windowStart) {
+			delete(r.throttles, k)
+		}
+	}
+
+	t, ok := r.throttles[key]
+	if !ok {
+		t = &loginThrottle{}
+		r.throttles[key] = t
+	}
+	t.failures++
+	t.lastFailureAt = failedAt
+	return t.failures, nil
+}
+
+func (r *loginThrottleRepository) LockLogin(ctx context.Context, key string, now, until time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, ok := r.throttles[key]
+	if !ok || t.lockedUntil.After(now) {
+		return false, nil
+	}
+	t.lockedUntil = until
+	return true, nil
+}
+
+func (r *loginThrottleRepository) CancelLoginFailure(ctx context.Context, key string, unlock bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if t, ok := r.throttles[key]; ok {
+		t.failures = max(t.failures-1, 0)
+		if unlock {
+			t.lockedUntil = time.Time{}
+		}
+	}
+	return nil
+}
+
+func (r *loginThrottleRepository) GetLoginLockedUntil(ctx context.Context, key string) (time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if t, ok := r.throttles[key]; ok {
+		return t.lockedUntil, nil
+	}
+	return time.Time{}, nil
+}
+
+func (r *loginThrottleRepository) ResetLoginFailures(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.throttles, key)
+	return nil
+}
+
+// expired は最後の失敗 (ロック中の場合はロックの解除) が windowStart より前かどうかを返します。
+func (t *loginThrottle) expired(windowStart time.Time) bool {
+	last := t.lastFailureAt
+	if t.lockedUntil.After(last) {
+		last = t.lockedUntil
+	}
+	return last.Before(windowStart)
+}
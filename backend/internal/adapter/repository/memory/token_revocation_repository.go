@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+)
+
+// tokenRevocationRepository は TokenRevocationRepository のインメモリ実装です。
+// 失効情報はプロセス内にのみ保持されるため、単一インスタンスでの運用や開発用途を想定しています。
+type tokenRevocationRepository struct {
+	mu             sync.RWMutex
+	revokedTokens  map[string]time.Time // jti → トークンの有効期限
+	revokedBefores map[string]time.Time // ユーザー ID → 失効基準時刻
+}
+
+// NewTokenRevocationRepository は新しい TokenRevocationRepository のインメモリ実装を返します。
+func NewTokenRevocationRepository() repository.TokenRevocationRepository {
+	return &tokenRevocationRepository{
+		revokedTokens:  make(map[string]time.Time),
+		revokedBefores: make(map[string]time.Time),
+	}
+}
+
+// RevokeToken はトークンを失効させます。あわせて有効期限を過ぎた失効情報を削除します。
+func (r *tokenRevocationRepository) RevokeToken(ctx context.Context, tokenID, userID string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for id, exp := range r.revokedTokens {
+		if exp.Before(now) {
+			delete(r.revokedTokens, id)
+		}
+	}
+	r.revokedTokens[tokenID] = expiresAt
+	return nil
+}
+
+func (r *tokenRevocationRepository) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.revokedTokens[tokenID]
+	return ok, nil
+}
+
+func (r *tokenRevocationRepository) SetUserTokensRevokedBefore(ctx context.Context, userID string, before time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.revokedBefores[userID] = before
+	return nil
+}
+
+func (r *tokenRevocationRepository) GetUserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.revokedBefores[userID], nil
+}
@@ -0,0 +1,95 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type emailVerificationTokenRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+	tx      *sql.Tx // トランザクションを保持するフィールド
+}
+
+// NewEmailVerificationTokenRepository は新しい EmailVerificationTokenRepository の実装を返します。
+func NewEmailVerificationTokenRepository(cfg *config.Config) (repository.EmailVerificationTokenRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &emailVerificationTokenRepository{
+		db:      db,
+		queries: query.New(db),
+		tx:      nil,
+	}, nil
+}
+
+// トランザクションを開始
+func (r *emailVerificationTokenRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return r.db.BeginTx(ctx, nil)
+}
+
+// トランザクション内での操作用
+func (r *emailVerificationTokenRepository) WithTx(tx *sql.Tx) repository.EmailVerificationTokenRepository {
+	return &emailVerificationTokenRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(tx),
+		tx:      tx,
+	}
+}
+
+func (r *emailVerificationTokenRepository) CreateEmailVerificationToken(ctx context.Context, token *model.EmailVerificationToken) error {
+	return r.queries.CreateEmailVerificationToken(ctx, &query.CreateEmailVerificationTokenParams{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		Email:     token.Email,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+	})
+}
+
+func (r *emailVerificationTokenRepository) GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error) {
+	t, err := r.queries.GetEmailVerificationTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrInvalidEmailVerificationToken
+		}
+		return nil, err
+	}
+	return &model.EmailVerificationToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Email:     t.Email,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    nullTime(t.UsedAt),
+		CreatedAt: t.CreatedAt,
+	}, nil
+}
+
+// MarkEmailVerificationTokenUsed はトークンを使用済みにします。
+// 既に使用済みの場合 (同じトークンが同時に使用された場合を含む) は model.ErrInvalidEmailVerificationToken を返します。
+func (r *emailVerificationTokenRepository) MarkEmailVerificationTokenUsed(ctx context.Context, id string) error {
+	rows, err := r.queries.MarkEmailVerificationTokenUsed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInvalidEmailVerificationToken
+	}
+	return nil
+}
+
+func (r *emailVerificationTokenRepository) InvalidateUserEmailVerificationTokens(ctx context.Context, userID string) error {
+	return r.queries.InvalidateUserEmailVerificationTokens(ctx, userID)
+}
@@ -0,0 +1,65 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type loginEventRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+}
+
+// NewLoginEventRepository は新しい LoginEventRepository の実装を返します。
+func NewLoginEventRepository(cfg *config.Config) (repository.LoginEventRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &loginEventRepository{
+		db:      db,
+		queries: query.New(db),
+	}, nil
+}
+
+func (r *loginEventRepository) CreateLoginEvent(ctx context.Context, event *model.LoginEvent) error {
+	return r.queries.CreateLoginEvent(ctx, &query.CreateLoginEventParams{
+		ID:        event.ID,
+		UserID:    event.UserID,
+		Result:    string(event.Result),
+		IpAddress: event.IPAddress,
+		UserAgent: event.UserAgent,
+	})
+}
+
+func (r *loginEventRepository) ListLoginEventsByUser(ctx context.Context, userID string, limit int) ([]*model.LoginEvent, error) {
+	rows, err := r.queries.ListLoginEventsByUser(ctx, &query.ListLoginEventsByUserParams{
+		UserID: userID,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*model.LoginEvent, len(rows))
+	for i, e := range rows {
+		events[i] = &model.LoginEvent{
+			ID:        e.ID,
+			UserID:    e.UserID,
+			Result:    model.LoginEventResult(e.Result),
+			IPAddress: e.IpAddress,
+			UserAgent: e.UserAgent,
+			CreatedAt: e.CreatedAt,
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,92 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type loginThrottleRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+}
+
+// NewLoginThrottleRepository は新しい LoginThrottleRepository の MySQL 実装を返します。
+// 複数のインスタンスで失敗回数を共有できます。
+func NewLoginThrottleRepository(cfg *config.Config) (repository.LoginThrottleRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &loginThrottleRepository{
+		db:      db,
+		queries: query.New(db),
+	}, nil
+}
+
+// RecordLoginFailure は失敗回数を 1 つの文で加算し、加算後の回数をその文の結果から返します。
+func (r *loginThrottleRepository) RecordLoginFailure(ctx context.Context, key string, failedAt time.Time, window time.Duration) (int, error) {
+	result, err := r.queries.RecordLoginFailure(ctx, &query.RecordLoginFailureParams{
+		ThrottleKey: key,
+		FailedAt:    failedAt,
+		WindowStart: failedAt.Add(-window),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	failures, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(failures), nil
+}
+
+func (r *loginThrottleRepository) LockLogin(ctx context.Context, key string, now, until time.Time) (bool, error) {
+	rows, err := r.queries.LockLogin(ctx, &query.LockLoginParams{
+		LockedUntil: sql.NullTime{Time: until, Valid: true},
+		ThrottleKey: key,
+		Now:         sql.NullTime{Time: now, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *loginThrottleRepository) CancelLoginFailure(ctx context.Context, key string, unlock bool) error {
+	if err := r.queries.CancelLoginFailure(ctx, key); err != nil {
+		return err
+	}
+	if unlock {
+		return r.queries.UnlockLogin(ctx, key)
+	}
+	return nil
+}
+
+func (r *loginThrottleRepository) GetLoginLockedUntil(ctx context.Context, key string) (time.Time, error) {
+	t, err := r.queries.GetLoginThrottle(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	if !t.LockedUntil.Valid {
+		return time.Time{}, nil
+	}
+	return t.LockedUntil.Time, nil
+}
+
+func (r *loginThrottleRepository) ResetLoginFailures(ctx context.Context, key string) error {
+	return r.queries.DeleteLoginThrottle(ctx, key)
+}
@@ -0,0 +1,72 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type oidcAuthRequestRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+}
+
+// NewOIDCAuthRequestRepository は新しい OIDCAuthRequestRepository の実装を返します。
+func NewOIDCAuthRequestRepository(cfg *config.Config) (repository.OIDCAuthRequestRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &oidcAuthRequestRepository{
+		db:      db,
+		queries: query.New(db),
+	}, nil
+}
+
+// CreateOIDCAuthRequest は認可リクエストを保存します。
+// 完了しないまま期限切れになったリクエストが溜まらないよう、あわせて削除します。
+func (r *oidcAuthRequestRepository) CreateOIDCAuthRequest(ctx context.Context, req *model.OIDCAuthRequest) error {
+	if err := r.queries.DeleteExpiredOIDCAuthRequests(ctx, time.Now()); err != nil {
+		return err
+	}
+	return r.queries.CreateOIDCAuthRequest(ctx, &query.CreateOIDCAuthRequestParams{
+		StateHash:    req.StateHash,
+		Nonce:        req.Nonce,
+		CodeVerifier: req.CodeVerifier,
+		ExpiresAt:    req.ExpiresAt,
+	})
+}
+
+func (r *oidcAuthRequestRepository) ConsumeOIDCAuthRequest(ctx context.Context, stateHash string) (*model.OIDCAuthRequest, error) {
+	req, err := r.queries.GetOIDCAuthRequest(ctx, stateHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrInvalidSSOState
+		}
+		return nil, err
+	}
+
+	rows, err := r.queries.DeleteOIDCAuthRequest(ctx, stateHash)
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, model.ErrInvalidSSOState
+	}
+	return &model.OIDCAuthRequest{
+		StateHash:    req.StateHash,
+		Nonce:        req.Nonce,
+		CodeVerifier: req.CodeVerifier,
+		ExpiresAt:    req.ExpiresAt,
+	}, nil
+}
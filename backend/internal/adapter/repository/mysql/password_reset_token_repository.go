@@ -0,0 +1,93 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type passwordResetTokenRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+	tx      *sql.Tx // トランザクションを保持するフィールド
+}
+
+// NewPasswordResetTokenRepository は新しい PasswordResetTokenRepository の実装を返します。
+func NewPasswordResetTokenRepository(cfg *config.Config) (repository.PasswordResetTokenRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &passwordResetTokenRepository{
+		db:      db,
+		queries: query.New(db),
+		tx:      nil,
+	}, nil
+}
+
+// トランザクションを開始
+func (r *passwordResetTokenRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return r.db.BeginTx(ctx, nil)
+}
+
+// トランザクション内での操作用
+func (r *passwordResetTokenRepository) WithTx(tx *sql.Tx) repository.PasswordResetTokenRepository {
+	return &passwordResetTokenRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(tx),
+		tx:      tx,
+	}
+}
+
+func (r *passwordResetTokenRepository) CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error {
+	return r.queries.CreatePasswordResetToken(ctx, &query.CreatePasswordResetTokenParams{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+	})
+}
+
+func (r *passwordResetTokenRepository) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error) {
+	t, err := r.queries.GetPasswordResetTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrInvalidPasswordResetToken
+		}
+		return nil, err
+	}
+	return &model.PasswordResetToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    nullTime(t.UsedAt),
+		CreatedAt: t.CreatedAt,
+	}, nil
+}
+
+// MarkPasswordResetTokenUsed はトークンを使用済みにします。
+// 既に使用済みの場合 (同じトークンが同時に使用された場合を含む) は model.ErrInvalidPasswordResetToken を返します。
+func (r *passwordResetTokenRepository) MarkPasswordResetTokenUsed(ctx context.Context, id string) error {
+	rows, err := r.queries.MarkPasswordResetTokenUsed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInvalidPasswordResetToken
+	}
+	return nil
+}
+
+func (r *passwordResetTokenRepository) InvalidateUserPasswordResetTokens(ctx context.Context, userID string) error {
+	return r.queries.InvalidateUserPasswordResetTokens(ctx, userID)
+}
@@ -0,0 +1,137 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type personalAccessTokenRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+	tx      *sql.Tx // トランザクションを保持するフィールド
+}
+
+// NewPersonalAccessTokenRepository は新しい PersonalAccessTokenRepository の実装を返します。
+func NewPersonalAccessTokenRepository(cfg *config.Config) (repository.PersonalAccessTokenRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &personalAccessTokenRepository{
+		db:      db,
+		queries: query.New(db),
+		tx:      nil,
+	}, nil
+}
+
+// トランザクションを開始
+func (r *personalAccessTokenRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return r.db.BeginTx(ctx, nil)
+}
+
+// トランザクション内での操作用
+func (r *personalAccessTokenRepository) WithTx(tx *sql.Tx) repository.PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(tx),
+		tx:      tx,
+	}
+}
+
+func (r *personalAccessTokenRepository) CreatePersonalAccessToken(ctx context.Context, token *model.PersonalAccessToken) error {
+	return r.queries.CreatePersonalAccessToken(ctx, &query.CreatePersonalAccessTokenParams{
+		ID:          token.ID,
+		UserID:      token.UserID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		TokenHash:   token.TokenHash,
+		Scopes:      joinScopes(token.Scopes),
+		ExpiresAt:   token.ExpiresAt,
+	})
+}
+
+func (r *personalAccessTokenRepository) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*model.PersonalAccessToken, error) {
+	t, err := r.queries.GetPersonalAccessTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrPersonalAccessTokenNotFound
+		}
+		return nil, err
+	}
+	return toModelPersonalAccessToken(t), nil
+}
+
+func (r *personalAccessTokenRepository) ListPersonalAccessTokensByUser(ctx context.Context, userID string) ([]*model.PersonalAccessToken, error) {
+	rows, err := r.queries.ListPersonalAccessTokensByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]*model.PersonalAccessToken, 0, len(rows))
+	for _, t := range rows {
+		tokens = append(tokens, toModelPersonalAccessToken(t))
+	}
+	return tokens, nil
+}
+
+func (r *personalAccessTokenRepository) RevokePersonalAccessToken(ctx context.Context, id, userID string) error {
+	rows, err := r.queries.RevokePersonalAccessToken(ctx, &query.RevokePersonalAccessTokenParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrPersonalAccessTokenNotFound
+	}
+	return nil
+}
+
+func (r *personalAccessTokenRepository) RevokeUserPersonalAccessTokens(ctx context.Context, userID string) error {
+	return r.queries.RevokeUserPersonalAccessTokens(ctx, userID)
+}
+
+// toModelPersonalAccessToken は sqlc の PersonalAccessToken を model.PersonalAccessToken に変換する
+func toModelPersonalAccessToken(t *query.PersonalAccessToken) *model.PersonalAccessToken {
+	return &model.PersonalAccessToken{
+		ID:          t.ID,
+		UserID:      t.UserID,
+		Name:        t.Name,
+		TokenPrefix: t.TokenPrefix,
+		TokenHash:   t.TokenHash,
+		Scopes:      splitScopes(t.Scopes),
+		ExpiresAt:   t.ExpiresAt,
+		RevokedAt:   nullTime(t.RevokedAt),
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+// joinScopes はスコープの一覧を保存用のスペース区切りの文字列に変換する
+func joinScopes(scopes []model.Scope) string {
+	values := make([]string, len(scopes))
+	for i, s := range scopes {
+		values[i] = string(s)
+	}
+	return strings.Join(values, " ")
+}
+
+// splitScopes はスペース区切りの文字列をスコープの一覧に変換する
+func splitScopes(s string) []model.Scope {
+	fields := strings.Fields(s)
+	scopes := make([]model.Scope, len(fields))
+	for i, f := range fields {
+		scopes[i] = model.Scope(f)
+	}
+	return scopes
+}
@@ -0,0 +1,156 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type projectRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+}
+
+// NewProjectRepository は新しい ProjectRepository の実装を返します。
+func NewProjectRepository(cfg *config.Config) (repository.ProjectRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &projectRepository{
+		db:      db,
+		queries: query.New(db),
+	}, nil
+}
+
+func (r *projectRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return r.db.BeginTx(ctx, nil)
+}
+
+func (r *projectRepository) WithTx(tx *sql.Tx) repository.ProjectRepository {
+	return &projectRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(tx),
+	}
+}
+
+func (r *projectRepository) CreateProject(ctx context.Context, project *model.Project) error {
+	return r.queries.CreateProject(ctx, &query.CreateProjectParams{
+		ID:          project.ID,
+		OwnerID:     project.OwnerID,
+		Name:        project.Name,
+		Description: sql.NullString{String: project.Description, Valid: project.Description != ""},
+	})
+}
+
+func (r *projectRepository) GetProjectByID(ctx context.Context, id string) (*model.Project, error) {
+	p, err := r.queries.GetProjectByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrProjectNotFound
+		}
+		return nil, err
+	}
+	return toModelProject(p)
+}
+
+func (r *projectRepository) ListProjects(ctx context.Context, q *model.ProjectListQuery) ([]*model.Project, error) {
+	params := &query.ListProjectsByOwnerParams{
+		OwnerID:         q.OwnerID,
+		IncludeArchived: q.IncludeArchived,
+		Limit:           int32(q.Limit),
+	}
+	if q.After != nil {
+		params.CursorCreatedAt = sql.NullTime{Time: q.After.CreatedAt, Valid: true}
+		params.CursorID = q.After.ID
+	}
+
+	rows, err := r.queries.ListProjectsByOwner(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]*model.Project, 0, len(rows))
+	for _, p := range rows {
+		project, err := toModelProject(p)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+func (r *projectRepository) UpdateProject(ctx context.Context, project *model.Project) (*model.Project, error) {
+	err := r.queries.UpdateProject(ctx, &query.UpdateProjectParams{
+		ID:          project.ID,
+		Name:        project.Name,
+		Description: sql.NullString{String: project.Description, Valid: project.Description != ""},
+		ArchivedAt:  nullTimeFromPtr(project.ArchivedAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetProjectByID(ctx, project.ID)
+}
+
+func (r *projectRepository) UpdateProjectWorkflow(ctx context.Context, id string, workflow *model.Workflow) error {
+	var raw json.RawMessage // nil の場合は NULL になる
+	if workflow != nil {
+		b, err := json.Marshal(workflow)
+		if err != nil {
+			return err
+		}
+		raw = b
+	}
+	return r.queries.UpdateProjectWorkflow(ctx, &query.UpdateProjectWorkflowParams{
+		ID:       id,
+		Workflow: raw,
+	})
+}
+
+func (r *projectRepository) DeleteProject(ctx context.Context, id string) error {
+	return r.queries.DeleteProject(ctx, id)
+}
+
+func (r *projectRepository) ListUserProjectIDs(ctx context.Context, userID string) ([]string, error) {
+	return r.queries.ListProjectIDsOwnedBy(ctx, userID)
+}
+
+func (r *projectRepository) ReassignUserProjects(ctx context.Context, userID, successorID string) error {
+	return r.queries.ReassignProjectsOwnedBy(ctx, &query.ReassignProjectsOwnedByParams{
+		OwnerID:    userID,
+		NewOwnerID: successorID,
+	})
+}
+
+// toModelProject は sqlc の Project を model.Project に変換する
+func toModelProject(p *query.Project) (*model.Project, error) {
+	var workflow *model.Workflow
+	if len(p.Workflow) > 0 {
+		workflow = &model.Workflow{}
+		if err := json.Unmarshal(p.Workflow, workflow); err != nil {
+			return nil, fmt.Errorf("failed to decode workflow of project %s: %w", p.ID, err)
+		}
+	}
+	return &model.Project{
+		ID:          p.ID,
+		OwnerID:     p.OwnerID,
+		Name:        p.Name,
+		Description: p.Description.String,
+		ArchivedAt:  nullTime(p.ArchivedAt),
+		Workflow:    workflow,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type refreshTokenRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+	tx      *sql.Tx // トランザクションを保持するフィールド
+}
+
+// NewRefreshTokenRepository は新しい RefreshTokenRepository の実装を返します。
+func NewRefreshTokenRepository(cfg *config.Config) (repository.RefreshTokenRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &refreshTokenRepository{
+		db:      db,
+		queries: query.New(db),
+		tx:      nil,
+	}, nil
+}
+
+// トランザクションを開始
+func (r *refreshTokenRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return r.db.BeginTx(ctx, nil)
+}
+
+// トランザクション内での操作用
+func (r *refreshTokenRepository) WithTx(tx *sql.Tx) repository.RefreshTokenRepository {
+	return &refreshTokenRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(tx),
+		tx:      tx,
+	}
+}
+
+func (r *refreshTokenRepository) CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error {
+	return r.queries.CreateRefreshToken(ctx, &query.CreateRefreshTokenParams{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		FamilyID:  token.FamilyID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+	})
+}
+
+func (r *refreshTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	t, err := r.queries.GetRefreshTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+	return &model.RefreshToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		FamilyID:  t.FamilyID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    nullTime(t.UsedAt),
+		RevokedAt: nullTime(t.RevokedAt),
+		CreatedAt: t.CreatedAt,
+	}, nil
+}
+
+// MarkRefreshTokenUsed はトークンを使用済みにします。
+// 既に使用済みまたは失効済みの場合 (同じトークンが同時に使用された場合を含む) は model.ErrRefreshTokenReused を返します。
+func (r *refreshTokenRepository) MarkRefreshTokenUsed(ctx context.Context, id string) error {
+	rows, err := r.queries.MarkRefreshTokenUsed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrRefreshTokenReused
+	}
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	return r.queries.RevokeRefreshTokenFamily(ctx, familyID)
+}
+
+func (r *refreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	return r.queries.RevokeUserRefreshTokens(ctx, userID)
+}
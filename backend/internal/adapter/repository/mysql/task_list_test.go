@@ -0,0 +1,219 @@
+package mysql
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/sql/query"
+	"github.com/pressly/goose/v3"
+)
+
+const (
+	tasksQueryFile = "../../../../sql/queries/tasks.sql"
+	migrationsDir  = "../../../../sql/migrations"
+)
+
+// listTasksQueries は並び順ごとの ListTasks のクエリ名です。
+var listTasksQueries = map[model.TaskSortKey]string{
+	model.TaskSortKeyCreatedAt: "ListTasksByCreatedAt",
+	model.TaskSortKeyUpdatedAt: "ListTasksByUpdatedAt",
+	model.TaskSortKeyDueDate:   "ListTasksByDueDate",
+	model.TaskSortKeyPriority:  "ListTasksByPriority",
+	model.TaskSortKeyRank:      "ListTasksByRank",
+}
+
+// readQueries は sqlc のクエリファイルを読み、クエリ名ごとに本文の行 (コメントと空行を除く) を返します。
+func readQueries(t *testing.T, path string) map[string][]string {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open %s: %v", path, err)
+	}
+	defer f.Close()
+
+	queries := make(map[string][]string)
+	var name string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if rest, ok := strings.CutPrefix(line, "-- name: "); ok {
+			name = strings.Fields(rest)[0]
+			continue
+		}
+		if name == "" || line == "" || strings.HasPrefix(line, "--") {
+			continue
+		}
+		queries[name] = append(queries[name], line)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	return queries
+}
+
+// isSortLine は ListTasks のクエリのうち、並び順ごとに異なる行 (カーソルの条件と ORDER BY) かどうかを返します。
+func isSortLine(line string) bool {
+	return strings.Contains(line, "cursor_") || strings.HasPrefix(line, "ORDER BY")
+}
+
+// 並び順ごとの ListTasks のクエリは、範囲・メンバーの確認・絞り込みの条件が同じで、並び順の行だけが異なる
+func TestListTasksQueriesShareScope(t *testing.T) {
+	queries := readQueries(t, tasksQueryFile)
+
+	var base []string
+	baseName := listTasksQueries[model.TaskSortKeyCreatedAt]
+	for _, line := range queries[baseName] {
+		if !isSortLine(line) {
+			base = append(base, line)
+		}
+	}
+	if len(base) == 0 {
+		t.Fatalf("%s not found in %s", baseName, tasksQueryFile)
+	}
+
+	for sortKey, name := range listTasksQueries {
+		var shared, sortLines []string
+		for _, line := range queries[name] {
+			if isSortLine(line) {
+				// 外側の ORDER BY も副問い合わせと同じ並び順であること
+				line = strings.ReplaceAll(line, "scoped.", "tasks.")
+				if !slices.Contains(sortLines, line) {
+					sortLines = append(sortLines, line)
+				}
+				continue
+			}
+			shared = append(shared, line)
+		}
+		if !slices.Equal(shared, base) {
+			t.Errorf("%s (%s) does not share the scope and filter conditions of %s:\n%s", name, sortKey, baseName, strings.Join(shared, "\n"))
+		}
+		// カーソルの条件 3 行と ORDER BY が、範囲ごとの副問い合わせで同じであること
+		if len(sortLines) != 4 {
+			t.Errorf("%s (%s): scopes sort or page differently:\n%s", name, sortKey, strings.Join(sortLines, "\n"))
+		}
+	}
+}
+
+// 同じタスクに対して、どの並び順でもページをたどると範囲ごとに同じタスクが重複なく返る。
+// MySQL が必要なため、TEST_DB_DSN にテスト用のデータベースの DSN を指定した場合のみ実行する (マイグレーションを適用する)。
+func TestListTasksSortKeysReturnSameTasks(t *testing.T) {
+	dsn := os.Getenv("TEST_DB_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DB_DSN is not set")
+	}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := goose.SetDialect("mysql"); err != nil {
+		t.Fatalf("goose.SetDialect: %v", err)
+	}
+	if err := goose.Up(db, migrationsDir); err != nil {
+		t.Fatalf("goose.Up: %v", err)
+	}
+
+	ctx := context.Background()
+	prefix := fmt.Sprintf("lt%d-", time.Now().UnixNano())
+	id := func(name string) string { return prefix + name }
+	exec := func(query string, args ...any) {
+		t.Helper()
+		if _, err := db.ExecContext(ctx, query, args...); err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+	}
+	t.Cleanup(func() {
+		db.ExecContext(ctx, "DELETE FROM tasks WHERE id LIKE ?", prefix+"%")
+		db.ExecContext(ctx, "DELETE FROM workspaces WHERE id LIKE ?", prefix+"%")
+		db.ExecContext(ctx, "DELETE FROM users WHERE id LIKE ?", prefix+"%")
+	})
+
+	for _, name := range []string{"alice", "bob"} {
+		exec("INSERT INTO users (id, name, email, password) VALUES (?, ?, ?, '')", id(name), name, id(name)+"@example.com")
+	}
+	for _, name := range []string{"shared", "other"} {
+		exec("INSERT INTO workspaces (id, name) VALUES (?, ?)", id(name), name)
+	}
+	exec("INSERT INTO workspace_members (workspace_id, user_id, role) VALUES (?, ?, 'member'), (?, ?, 'owner'), (?, ?, 'owner')",
+		id("shared"), id("alice"), id("shared"), id("bob"), id("other"), id("bob"))
+
+	repo := &taskRepository{db: db, queries: query.New(db)}
+	due := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	fixtures := []struct {
+		name, creator, assignee, workspace string
+		priority                           model.Priority
+		dueDate                            *time.Time
+	}{
+		{name: "created", creator: "alice", priority: model.PriorityHigh, dueDate: &due},
+		{name: "created-2", creator: "alice", priority: model.PriorityHigh},
+		{name: "assigned", creator: "bob", assignee: "alice", priority: model.PriorityLow, dueDate: &due},
+		{name: "both", creator: "alice", assignee: "alice", priority: model.PriorityMedium},
+		{name: "in-workspace", creator: "bob", workspace: "shared", priority: model.PriorityMedium, dueDate: &due},
+		{name: "created-in-workspace", creator: "alice", workspace: "shared", priority: model.PriorityLow},
+		{name: "not-member", creator: "bob", assignee: "alice", workspace: "other", priority: model.PriorityHigh},
+		{name: "unrelated", creator: "bob", priority: model.PriorityHigh},
+	}
+	for i, f := range fixtures {
+		task := &model.Task{
+			ID:       id(f.name),
+			Title:    f.name,
+			Status:   model.TaskStatusTodo,
+			UserID:   id(f.creator),
+			Rank:     fmt.Sprintf("m%d", len(fixtures)-i),
+			Priority: f.priority,
+			DueDate:  f.dueDate,
+		}
+		if f.assignee != "" {
+			assigneeID := id(f.assignee)
+			task.AssigneeID = &assigneeID
+		}
+		if f.workspace != "" {
+			workspaceID := id(f.workspace)
+			task.WorkspaceID = &workspaceID
+		}
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask(%s): %v", f.name, err)
+		}
+	}
+
+	scopes := map[model.TaskScope][]string{
+		model.TaskScopeCreated:           {"created", "created-2", "both", "created-in-workspace"},
+		model.TaskScopeAssigned:          {"assigned", "both"},
+		model.TaskScopeCreatedOrAssigned: {"created", "created-2", "assigned", "both", "created-in-workspace"},
+		model.TaskScopeWorkspace:         {"in-workspace", "created-in-workspace"},
+	}
+	for scope, want := range scopes {
+		for sortKey := range listTasksQueries {
+			t.Run(fmt.Sprintf("%s/%s", scope, sortKey), func(t *testing.T) {
+				q := &model.TaskListQuery{UserID: id("alice"), Scope: scope, WorkspaceID: id("shared"), SortKey: sortKey, Limit: 2}
+				var got []string
+				for {
+					tasks, err := repo.ListTasks(ctx, q)
+					if err != nil {
+						t.Fatalf("ListTasks: %v", err)
+					}
+					for _, task := range tasks {
+						got = append(got, strings.TrimPrefix(task.ID, prefix))
+					}
+					if len(tasks) < q.Limit {
+						break
+					}
+					q.After = model.NewTaskCursor(tasks[len(tasks)-1])
+				}
+				slices.Sort(got)
+				wantSorted := slices.Sorted(slices.Values(want))
+				if !slices.Equal(got, wantSorted) {
+					t.Errorf("tasks = %v, want %v", got, wantSorted)
+				}
+			})
+		}
+	}
+}
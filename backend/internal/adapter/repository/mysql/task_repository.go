@@ -4,14 +4,19 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/a-s/connect-task-manage/internal/adapter/repository"
 	"github.com/a-s/connect-task-manage/internal/domain/model"
 	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
 	"github.com/a-s/connect-task-manage/sql/query"
+	mysqldriver "github.com/go-sql-driver/mysql"
 )
 
+// mysqlErrDeadlock は MySQL のデッドロックのエラー番号 (ER_LOCK_DEADLOCK) です。
+const mysqlErrDeadlock = 1213
+
 type taskRepository struct {
 	db      *sql.DB
 	queries *query.Queries
@@ -63,98 +68,549 @@ func (r *taskRepository) CreateTask(ctx context.Context, task *model.Task) error
 		ID:          task.ID,
 		Title:       task.Title,
 		Description: sql.NullString{String: task.Description, Valid: task.Description != ""},
+		Status:      string(task.Status),
 		IsCompleted: task.IsCompleted,
 		UserID:      task.UserID,
+		WorkspaceID: nullString(task.WorkspaceID),
 		AssigneeID:  nullString(task.AssigneeID), //nullString ヘルパー関数
-		Priority:    string(task.Priority),       // string に変換
+		ProjectID:   nullString(task.ProjectID),
+		ParentID:    nullString(task.ParentID),
+		BoardRank:   task.Rank,
+		Priority:    string(task.Priority), // string に変換
 		DueDate:     due_date,
 	})
 }
 
+// UpdateTask は task.Version が DB の値と一致する場合のみタスクを更新します。
+// 一致しない場合 (他の更新が先に行われた場合) は model.ErrTaskConflict を返します。
 func (r *taskRepository) UpdateTask(ctx context.Context, task *model.Task) (*model.Task, error) {
 	var due_date sql.NullTime
 	if task.DueDate != nil {
 		due_date = sql.NullTime{Time: *task.DueDate, Valid: true}
 	}
 
-	err := r.queries.UpdateTask(ctx, &query.UpdateTaskParams{
+	rows, err := r.queries.UpdateTask(ctx, &query.UpdateTaskParams{
 		ID:          task.ID,
 		Title:       task.Title,
 		Description: sql.NullString{String: task.Description, Valid: task.Description != ""},
+		Status:      string(task.Status),
 		IsCompleted: task.IsCompleted,
 		AssigneeID:  nullString(task.AssigneeID),
+		ProjectID:   nullString(task.ProjectID),
+		ParentID:    nullString(task.ParentID),
+		BoardRank:   task.Rank,
 		Priority:    string(task.Priority),
 		DueDate:     due_date,
+		Version:     task.Version,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if rows == 0 {
+		return nil, model.ErrTaskConflict
+	}
 	updatedTask, err := r.queries.GetTaskByID(ctx, task.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &model.Task{ // domain modelに変換
-		ID:          updatedTask.ID,
-		Title:       updatedTask.Title,
-		Description: updatedTask.Description.String, // Stringを取り出す
-		IsCompleted: updatedTask.IsCompleted,
-		UserID:      updatedTask.UserID,
-		AssigneeID:  stringPtr(updatedTask.AssigneeID),    // stringPtr ヘルパー関数
-		Priority:    model.Priority(updatedTask.Priority), // model.Priority に変換
-		DueDate:     nullTime(updatedTask.DueDate),        // nullTime ヘルパー関数
-		CreatedAt:   updatedTask.CreatedAt,
-		UpdatedAt:   updatedTask.UpdatedAt,
-	}, nil
+	updated := toModelTask(updatedTask) // domain modelに変換
+	if err := r.fillSubtaskProgress(ctx, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
 }
 
-func (r *taskRepository) ListTasks(ctx context.Context, userID string) ([]*model.Task, error) {
-	queryTasks, err := r.queries.ListTasks(ctx, userID)
+// dueDateSortMax は期限なしのタスクを末尾に並べるための値 (tasks.due_date_sort と同じ)
+var dueDateSortMax = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+func (r *taskRepository) ListTasks(ctx context.Context, q *model.TaskListQuery) ([]*model.Task, error) {
+	var (
+		queryTasks []*query.Task
+		err        error
+	)
+
+	f := q.Filter
+	isCompleted := sql.NullBool{}
+	if f.IsCompleted != nil {
+		isCompleted = sql.NullBool{Bool: *f.IsCompleted, Valid: true}
+	}
+	status := sql.NullString{}
+	if f.Status != nil {
+		status = sql.NullString{String: string(*f.Status), Valid: true}
+	}
+	priority := sql.NullString{}
+	if f.Priority != nil {
+		priority = sql.NullString{String: string(*f.Priority), Valid: true}
+	}
+	cursorID := ""
+	if q.After != nil {
+		cursorID = q.After.ID
+	}
+
+	// 範囲ごとの副問い合わせと全体に同じ件数を指定する
+	limit := int32(q.Limit)
+
+	// 範囲に含まない側は NULL にする
+	var creatorID, assignedTo, inWorkspace sql.NullString
+	switch q.Scope {
+	case model.TaskScopeWorkspace:
+		inWorkspace = sql.NullString{String: q.WorkspaceID, Valid: true}
+	case model.TaskScopeAssigned:
+		assignedTo = sql.NullString{String: q.UserID, Valid: true}
+	case model.TaskScopeCreatedOrAssigned:
+		creatorID = sql.NullString{String: q.UserID, Valid: true}
+		assignedTo = sql.NullString{String: q.UserID, Valid: true}
+	default: // model.TaskScopeCreated
+		creatorID = sql.NullString{String: q.UserID, Valid: true}
+	}
+
+	switch q.SortKey {
+	case model.TaskSortKeyUpdatedAt:
+		params := &query.ListTasksByUpdatedAtParams{
+			CreatorID:   creatorID,
+			AssignedTo:  assignedTo,
+			InWorkspace: inWorkspace,
+			MemberID:    q.UserID,
+			IsCompleted: isCompleted,
+			Status:      status,
+			Priority:    priority,
+			AssigneeID:  nullString(f.AssigneeID),
+			ProjectID:   nullString(f.ProjectID),
+			DueFrom:     nullTimeFromPtr(f.DueFrom),
+			DueTo:       nullTimeFromPtr(f.DueTo),
+			CursorID:    cursorID,
+			Limit:       limit,
+			Limit_2:     limit,
+			Limit_3:     limit,
+			Limit_4:     limit,
+		}
+		if q.After != nil {
+			params.CursorUpdatedAt = sql.NullTime{Time: q.After.UpdatedAt, Valid: true}
+		}
+		queryTasks, err = r.queries.ListTasksByUpdatedAt(ctx, params)
+	case model.TaskSortKeyDueDate:
+		params := &query.ListTasksByDueDateParams{
+			CreatorID:   creatorID,
+			AssignedTo:  assignedTo,
+			InWorkspace: inWorkspace,
+			MemberID:    q.UserID,
+			IsCompleted: isCompleted,
+			Status:      status,
+			Priority:    priority,
+			AssigneeID:  nullString(f.AssigneeID),
+			ProjectID:   nullString(f.ProjectID),
+			DueFrom:     nullTimeFromPtr(f.DueFrom),
+			DueTo:       nullTimeFromPtr(f.DueTo),
+			CursorID:    cursorID,
+			Limit:       limit,
+			Limit_2:     limit,
+			Limit_3:     limit,
+			Limit_4:     limit,
+		}
+		if q.After != nil {
+			dueDateSort := dueDateSortMax
+			if q.After.DueDate != nil {
+				dueDateSort = *q.After.DueDate
+			}
+			params.CursorDueDateSort = sql.NullTime{Time: dueDateSort, Valid: true}
+		}
+		queryTasks, err = r.queries.ListTasksByDueDate(ctx, params)
+	case model.TaskSortKeyPriority:
+		params := &query.ListTasksByPriorityParams{
+			CreatorID:   creatorID,
+			AssignedTo:  assignedTo,
+			InWorkspace: inWorkspace,
+			MemberID:    q.UserID,
+			IsCompleted: isCompleted,
+			Status:      status,
+			Priority:    priority,
+			AssigneeID:  nullString(f.AssigneeID),
+			ProjectID:   nullString(f.ProjectID),
+			DueFrom:     nullTimeFromPtr(f.DueFrom),
+			DueTo:       nullTimeFromPtr(f.DueTo),
+			CursorID:    cursorID,
+			Limit:       limit,
+			Limit_2:     limit,
+			Limit_3:     limit,
+			Limit_4:     limit,
+		}
+		if q.After != nil {
+			params.CursorPriorityRank = sql.NullInt16{Int16: int16(q.After.Priority.Rank()), Valid: true}
+		}
+		queryTasks, err = r.queries.ListTasksByPriority(ctx, params)
+	case model.TaskSortKeyRank:
+		params := &query.ListTasksByRankParams{
+			CreatorID:   creatorID,
+			AssignedTo:  assignedTo,
+			InWorkspace: inWorkspace,
+			MemberID:    q.UserID,
+			IsCompleted: isCompleted,
+			Status:      status,
+			Priority:    priority,
+			AssigneeID:  nullString(f.AssigneeID),
+			ProjectID:   nullString(f.ProjectID),
+			DueFrom:     nullTimeFromPtr(f.DueFrom),
+			DueTo:       nullTimeFromPtr(f.DueTo),
+			CursorID:    cursorID,
+			Limit:       limit,
+			Limit_2:     limit,
+			Limit_3:     limit,
+			Limit_4:     limit,
+		}
+		if q.After != nil {
+			params.CursorRank = sql.NullString{String: q.After.Rank, Valid: true}
+		}
+		queryTasks, err = r.queries.ListTasksByRank(ctx, params)
+	default: // model.TaskSortKeyCreatedAt
+		params := &query.ListTasksByCreatedAtParams{
+			CreatorID:   creatorID,
+			AssignedTo:  assignedTo,
+			InWorkspace: inWorkspace,
+			MemberID:    q.UserID,
+			IsCompleted: isCompleted,
+			Status:      status,
+			Priority:    priority,
+			AssigneeID:  nullString(f.AssigneeID),
+			ProjectID:   nullString(f.ProjectID),
+			DueFrom:     nullTimeFromPtr(f.DueFrom),
+			DueTo:       nullTimeFromPtr(f.DueTo),
+			CursorID:    cursorID,
+			Limit:       limit,
+			Limit_2:     limit,
+			Limit_3:     limit,
+			Limit_4:     limit,
+		}
+		if q.After != nil {
+			params.CursorCreatedAt = sql.NullTime{Time: q.After.CreatedAt, Valid: true}
+		}
+		queryTasks, err = r.queries.ListTasksByCreatedAt(ctx, params)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	tasks := []*model.Task{}
+	tasks := make([]*model.Task, 0, len(queryTasks))
 	for _, t := range queryTasks { // queryTasks を range でループ
-		tasks = append(tasks, &model.Task{
-			ID:          t.ID,
-			Title:       t.Title,
-			Description: t.Description.String,
-			IsCompleted: t.IsCompleted,
-			UserID:      t.UserID,
-			AssigneeID:  stringPtr(t.AssigneeID),    // stringPtr ヘルパー関数
-			Priority:    model.Priority(t.Priority), // model.Priority に変換
-			DueDate:     nullTime(t.DueDate),
-			CreatedAt:   t.CreatedAt,
-			UpdatedAt:   t.UpdatedAt,
-		})
+		tasks = append(tasks, toModelTask(t))
+	}
+	if err := r.fillSubtaskProgress(ctx, tasks...); err != nil {
+		return nil, err
 	}
 	return tasks, nil
 }
 
-func (r *taskRepository) DeleteTask(ctx context.Context, id string) error {
-	return r.queries.DeleteTask(ctx, id)
+// DeleteTask は version が DB の値と一致する場合のみタスクを削除します。
+// 一致しない場合は model.ErrTaskConflict を返します。
+func (r *taskRepository) DeleteTask(ctx context.Context, id string, version int64) error {
+	rows, err := r.queries.DeleteTask(ctx, &query.DeleteTaskParams{
+		ID:      id,
+		Version: version,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrTaskConflict
+	}
+	return nil
 }
+
 func (r *taskRepository) GetTaskByID(ctx context.Context, id string) (*model.Task, error) {
 	task, err := r.queries.GetTaskByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, model.ErrUserNotFound
+			return nil, model.ErrTaskNotFound
 		}
 		return nil, err
 	}
-	return &model.Task{
-		ID:          task.ID,
-		Title:       task.Title,
-		Description: task.Description.String, // Stringを取り出す
-		IsCompleted: task.IsCompleted,
-		UserID:      task.UserID,
-		AssigneeID:  stringPtr(task.AssigneeID),    // stringPtr ヘルパー関数
-		Priority:    model.Priority(task.Priority), // model.Priority に変換
-		DueDate:     nullTime(task.DueDate),        // nullTime ヘルパー関数
-		CreatedAt:   task.CreatedAt,
-		UpdatedAt:   task.UpdatedAt,
-	}, nil
+	t := toModelTask(task)
+	if err := r.fillSubtaskProgress(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ListSubtasks は parentID のタスクの子を作成日時の古い順に返します。
+func (r *taskRepository) ListSubtasks(ctx context.Context, parentID string) ([]*model.Task, error) {
+	queryTasks, err := r.queries.ListSubtasks(ctx, sql.NullString{String: parentID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*model.Task, 0, len(queryTasks))
+	for _, t := range queryTasks {
+		tasks = append(tasks, toModelTask(t))
+	}
+	if err := r.fillSubtaskProgress(ctx, tasks...); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// ListDescendants は rootID のタスクの子孫すべてを作成日時の古い順に返します。
+func (r *taskRepository) ListDescendants(ctx context.Context, rootID string) ([]*model.Task, error) {
+	rows, err := r.queries.ListTaskDescendants(ctx, sql.NullString{String: rootID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*model.Task, 0, len(rows))
+	for _, row := range rows {
+		t := query.Task(*row) // 列が同じため変換できる
+		tasks = append(tasks, toModelTask(&t))
+	}
+	if err := r.fillSubtaskProgress(ctx, tasks...); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// GetTaskByIDForUpdate はタスクの行をロックして返します。トランザクション内で呼び出してください。
+func (r *taskRepository) GetTaskByIDForUpdate(ctx context.Context, id string) (*model.Task, error) {
+	task, err := r.queries.GetTaskByIDForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrTaskNotFound
+		}
+		return nil, lockError(err)
+	}
+	t := toModelTask(task)
+	if err := r.fillSubtaskProgress(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ListDescendantsForUpdate は rootID のタスクの子孫すべてを、1 段ずつロックしながら階層の浅い順に返します
+// (同じ段のタスクは作成日時の古い順)。子を探すインデックスもロックするため、トランザクションの終了まで
+// 子孫にタスクを追加することもできません。トランザクション内で呼び出してください。
+func (r *taskRepository) ListDescendantsForUpdate(ctx context.Context, rootID string) ([]*model.Task, error) {
+	var tasks []*model.Task
+	parentIDs := []sql.NullString{{String: rootID, Valid: true}}
+	for len(parentIDs) > 0 {
+		rows, err := r.queries.ListSubtasksForUpdate(ctx, parentIDs)
+		if err != nil {
+			return nil, lockError(err)
+		}
+		parentIDs = parentIDs[:0]
+		for _, row := range rows {
+			tasks = append(tasks, toModelTask(row))
+			parentIDs = append(parentIDs, sql.NullString{String: row.ID, Valid: true})
+		}
+	}
+	if err := r.fillSubtaskProgress(ctx, tasks...); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// lockError は行のロックを待つ間にデッドロックで中断された場合に model.ErrTaskConflict を返します。
+// 同時にタスクの階層を変更するリクエストは、互いのロックを待ってデッドロックになることがあります。
+func lockError(err error) error {
+	var mysqlErr *mysqldriver.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDeadlock {
+		return fmt.Errorf("%w: %w", model.ErrTaskConflict, err)
+	}
+	return err
+}
+
+// ReparentSubtasks は parentID のタスクの子の親を newParentID のタスクにします (nil の場合は親なしにする)。
+func (r *taskRepository) ReparentSubtasks(ctx context.Context, parentID string, newParentID *string) error {
+	return r.queries.ReparentSubtasks(ctx, &query.ReparentSubtasksParams{
+		ParentID:    sql.NullString{String: parentID, Valid: true},
+		NewParentID: nullString(newParentID),
+	})
+}
+
+// fillSubtaskProgress はタスクごとに子孫のタスクの数と完了している数を集計して Subtasks に設定します。
+func (r *taskRepository) fillSubtaskProgress(ctx context.Context, tasks ...*model.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	ids := make([]sql.NullString, len(tasks))
+	for i, task := range tasks {
+		ids[i] = sql.NullString{String: task.ID, Valid: true}
+	}
+	rows, err := r.queries.CountSubtaskProgress(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	progress := make(map[string]model.SubtaskProgress, len(rows))
+	for _, row := range rows {
+		progress[row.RootID.String] = model.SubtaskProgress{Completed: int(row.Completed), Total: int(row.Total)}
+	}
+	for _, task := range tasks {
+		task.Subtasks = progress[task.ID]
+	}
+	return nil
+}
+
+// ReassignUserTasks は userID のユーザーが作成したタスクと担当しているタスクを successorID のユーザーに移します。
+// successorID のユーザーがメンバーでないワークスペースのタスクは、作成者をそのワークスペースに残る owner にし、担当者なしにします。
+func (r *taskRepository) ReassignUserTasks(ctx context.Context, userID, successorID string) error {
+	if err := r.queries.ReassignTasksCreatedBy(ctx, &query.ReassignTasksCreatedByParams{
+		UserID:    userID,
+		NewUserID: successorID,
+	}); err != nil {
+		return err
+	}
+	if err := r.queries.ReassignWorkspaceTasksCreatedByToOwner(ctx, &query.ReassignWorkspaceTasksCreatedByToOwnerParams{
+		UserID: userID,
+	}); err != nil {
+		return err
+	}
+	if err := r.queries.ReassignTasksAssignedTo(ctx, &query.ReassignTasksAssignedToParams{
+		AssigneeID:    sql.NullString{String: userID, Valid: true},
+		NewAssigneeID: sql.NullString{String: successorID, Valid: true},
+	}); err != nil {
+		return err
+	}
+	return r.queries.ReassignTasksAssignedTo(ctx, &query.ReassignTasksAssignedToParams{
+		AssigneeID: sql.NullString{String: userID, Valid: true},
+	})
+}
+
+// DeleteUserTasks は userID のユーザーが作成した個人のタスクを削除し、他のユーザーのタスクは担当者なしにします。
+// ワークスペースのタスクは削除せず、作成者をそのワークスペースに残る owner にします。
+func (r *taskRepository) DeleteUserTasks(ctx context.Context, userID string) error {
+	if err := r.queries.ReassignWorkspaceTasksCreatedByToOwner(ctx, &query.ReassignWorkspaceTasksCreatedByToOwnerParams{
+		UserID: userID,
+	}); err != nil {
+		return err
+	}
+	if err := r.queries.DeleteTasksCreatedBy(ctx, userID); err != nil {
+		return err
+	}
+	return r.queries.ReassignTasksAssignedTo(ctx, &query.ReassignTasksAssignedToParams{
+		AssigneeID: sql.NullString{String: userID, Valid: true},
+	})
+}
+
+// MoveProjectTasks はプロジェクトのタスクを targetProjectID のプロジェクトに移します (nil の場合はプロジェクトから外す)。
+func (r *taskRepository) MoveProjectTasks(ctx context.Context, projectID string, targetProjectID *string) error {
+	return r.queries.MoveTasksToProject(ctx, &query.MoveTasksToProjectParams{
+		ProjectID:    sql.NullString{String: projectID, Valid: true},
+		NewProjectID: nullString(targetProjectID),
+	})
+}
+
+// DeleteProjectTasks はプロジェクトのタスクを削除します。
+func (r *taskRepository) DeleteProjectTasks(ctx context.Context, projectID string) error {
+	return r.queries.DeleteTasksInProject(ctx, sql.NullString{String: projectID, Valid: true})
+}
+
+// AdoptProjectWorkflow はプロジェクトのタスクのうち workflow にない状態のものを、
+// 完了していたかどうかに応じて workflow.FallbackStatus の状態にします。
+func (r *taskRepository) AdoptProjectWorkflow(ctx context.Context, projectID string, workflow *model.Workflow) error {
+	return r.queries.FallbackTaskStatusesInProject(ctx, &query.FallbackTaskStatusesInProjectParams{
+		ProjectID:     sql.NullString{String: projectID, Valid: true},
+		Statuses:      statusStrings(workflow.StatusKeys()),
+		DoneStatus:    string(workflow.FallbackStatus(true)),
+		InitialStatus: string(workflow.FallbackStatus(false)),
+	})
+}
+
+// CountProjectTasksOutsideWorkflow はプロジェクトのタスクのうち workflow にない状態のものの数を返します。
+func (r *taskRepository) CountProjectTasksOutsideWorkflow(ctx context.Context, projectID string, workflow *model.Workflow) (int, error) {
+	count, err := r.queries.CountTasksInProjectOutsideStatuses(ctx, &query.CountTasksInProjectOutsideStatusesParams{
+		ProjectID: sql.NullString{String: projectID, Valid: true},
+		Statuses:  statusStrings(workflow.StatusKeys()),
+	})
+	return int(count), err
+}
+
+// SyncProjectTaskCompletion はプロジェクトのタスクの is_completed を、workflow で完了として扱う状態かどうかに合わせます。
+func (r *taskRepository) SyncProjectTaskCompletion(ctx context.Context, projectID string, workflow *model.Workflow) error {
+	return r.queries.SyncTaskCompletionInProject(ctx, &query.SyncTaskCompletionInProjectParams{
+		ProjectID:    sql.NullString{String: projectID, Valid: true},
+		DoneStatuses: statusStrings(workflow.DoneStatuses()),
+	})
+}
+
+// statusStrings は []model.TaskStatus を []string に変換するヘルパー関数
+func statusStrings(statuses []model.TaskStatus) []string {
+	s := make([]string, len(statuses))
+	for i, status := range statuses {
+		s[i] = string(status)
+	}
+	return s
+}
+
+// LastRankInColumn は列の末尾のタスクのキーを返します。列が空の場合は空文字を返します。
+func (r *taskRepository) LastRankInColumn(ctx context.Context, column model.BoardColumn, excludeID string) (string, error) {
+	rank, err := r.queries.GetLastRankInColumn(ctx, &query.GetLastRankInColumnParams{
+		Status:      string(column.Status),
+		ProjectID:   nullString(column.ProjectID),
+		WorkspaceID: nullString(column.WorkspaceID),
+		OwnerID:     nullString(column.OwnerID),
+		ExcludeID:   excludeID,
+	})
+	return rankOrEmpty(rank, err)
+}
+
+// RankBeforeInColumn は列で anchor の直前にあるタスクのキーを返します。anchor が先頭の場合は空文字を返します。
+func (r *taskRepository) RankBeforeInColumn(ctx context.Context, column model.BoardColumn, anchor *model.Task, excludeID string) (string, error) {
+	rank, err := r.queries.GetRankBeforeInColumn(ctx, &query.GetRankBeforeInColumnParams{
+		Status:      string(column.Status),
+		ProjectID:   nullString(column.ProjectID),
+		WorkspaceID: nullString(column.WorkspaceID),
+		OwnerID:     nullString(column.OwnerID),
+		ExcludeID:   excludeID,
+		AnchorRank:  anchor.Rank,
+		AnchorID:    anchor.ID,
+	})
+	return rankOrEmpty(rank, err)
+}
+
+// RankAfterInColumn は列で anchor の直後にあるタスクのキーを返します。anchor が末尾の場合は空文字を返します。
+func (r *taskRepository) RankAfterInColumn(ctx context.Context, column model.BoardColumn, anchor *model.Task, excludeID string) (string, error) {
+	rank, err := r.queries.GetRankAfterInColumn(ctx, &query.GetRankAfterInColumnParams{
+		Status:      string(column.Status),
+		ProjectID:   nullString(column.ProjectID),
+		WorkspaceID: nullString(column.WorkspaceID),
+		OwnerID:     nullString(column.OwnerID),
+		ExcludeID:   excludeID,
+		AnchorRank:  anchor.Rank,
+		AnchorID:    anchor.ID,
+	})
+	return rankOrEmpty(rank, err)
+}
+
+// RebalanceColumn は列のタスクを現在の並び順のまま model.SpreadRanks のキーに振り直します。
+// 列のタスクをロックするため、トランザクション内で呼び出してください。
+func (r *taskRepository) RebalanceColumn(ctx context.Context, column model.BoardColumn, excludeID string) error {
+	ids, err := r.queries.ListTaskIDsInColumn(ctx, &query.ListTaskIDsInColumnParams{
+		Status:      string(column.Status),
+		ProjectID:   nullString(column.ProjectID),
+		WorkspaceID: nullString(column.WorkspaceID),
+		OwnerID:     nullString(column.OwnerID),
+		ExcludeID:   excludeID,
+	})
+	if err != nil {
+		return err
+	}
+	for i, rank := range model.SpreadRanks(len(ids)) {
+		if err := r.queries.UpdateTaskRank(ctx, &query.UpdateTaskRankParams{BoardRank: rank, ID: ids[i]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rankOrEmpty は該当するタスクがない場合 (sql.ErrNoRows) に空文字を返すヘルパー関数
+func rankOrEmpty(rank string, err error) (string, error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return rank, err
+}
+
+// UnassignWorkspaceTasks はワークスペースのタスクのうち userID のユーザーが担当しているものを担当者なしにします。
+func (r *taskRepository) UnassignWorkspaceTasks(ctx context.Context, workspaceID, userID string) error {
+	return r.queries.UnassignWorkspaceTasks(ctx, &query.UnassignWorkspaceTasksParams{
+		WorkspaceID: sql.NullString{String: workspaceID, Valid: true},
+		AssigneeID:  sql.NullString{String: userID, Valid: true},
+	})
 }
 
 // nullString は *string から sql.NullString への変換を行うヘルパー関数
@@ -173,6 +629,14 @@ func stringPtr(ns sql.NullString) *string {
 	return &ns.String
 }
 
+// nullTimeFromPtr は *time.Time から sql.NullTime への変換を行うヘルパー関数
+func nullTimeFromPtr(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{} // Valid = false
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
 // nullTime は sql.NullTime から *time.Time への変換を行うヘルパー関数
 func nullTime(nt sql.NullTime) *time.Time {
 	if !nt.Valid {
@@ -180,3 +644,25 @@ func nullTime(nt sql.NullTime) *time.Time {
 	}
 	return &nt.Time
 }
+
+// toModelTask は sqlc の query.Task をドメインモデルに変換するヘルパー関数
+func toModelTask(t *query.Task) *model.Task {
+	return &model.Task{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: t.Description.String, // Stringを取り出す
+		Status:      model.TaskStatus(t.Status),
+		IsCompleted: t.IsCompleted,
+		UserID:      t.UserID,
+		WorkspaceID: stringPtr(t.WorkspaceID),
+		AssigneeID:  stringPtr(t.AssigneeID), // stringPtr ヘルパー関数
+		ProjectID:   stringPtr(t.ProjectID),
+		ParentID:    stringPtr(t.ParentID),
+		Rank:        t.BoardRank,
+		Priority:    model.Priority(t.Priority), // model.Priority に変換
+		DueDate:     nullTime(t.DueDate),        // nullTime ヘルパー関数
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		Version:     t.Version,
+	}
+}
@@ -0,0 +1,67 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type tokenRevocationRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+}
+
+// NewTokenRevocationRepository は新しい TokenRevocationRepository の MySQL 実装を返します。
+func NewTokenRevocationRepository(cfg *config.Config) (repository.TokenRevocationRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &tokenRevocationRepository{
+		db:      db,
+		queries: query.New(db),
+	}, nil
+}
+
+// RevokeToken はトークンを失効させます。あわせて有効期限を過ぎた失効情報を削除します。
+func (r *tokenRevocationRepository) RevokeToken(ctx context.Context, tokenID, userID string, expiresAt time.Time) error {
+	if err := r.queries.RevokeToken(ctx, &query.RevokeTokenParams{
+		Jti:       tokenID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return err
+	}
+	return r.queries.DeleteExpiredRevokedTokens(ctx, time.Now())
+}
+
+func (r *tokenRevocationRepository) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	return r.queries.IsTokenRevoked(ctx, tokenID)
+}
+
+func (r *tokenRevocationRepository) SetUserTokensRevokedBefore(ctx context.Context, userID string, before time.Time) error {
+	return r.queries.SetUserTokensRevokedBefore(ctx, &query.SetUserTokensRevokedBeforeParams{
+		UserID:        userID,
+		RevokedBefore: before,
+	})
+}
+
+func (r *tokenRevocationRepository) GetUserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	before, err := r.queries.GetUserTokensRevokedBefore(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	return before, nil
+}
@@ -0,0 +1,145 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+	"github.com/google/uuid"
+)
+
+type totpRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+	tx      *sql.Tx // トランザクションを保持するフィールド
+}
+
+// NewTOTPRepository は新しい TOTPRepository の実装を返します。
+func NewTOTPRepository(cfg *config.Config) (repository.TOTPRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &totpRepository{
+		db:      db,
+		queries: query.New(db),
+		tx:      nil,
+	}, nil
+}
+
+// トランザクションを開始
+func (r *totpRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return r.db.BeginTx(ctx, nil)
+}
+
+// トランザクション内での操作用
+func (r *totpRepository) WithTx(tx *sql.Tx) repository.TOTPRepository {
+	return &totpRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(tx),
+		tx:      tx,
+	}
+}
+
+func (r *totpRepository) SaveTOTPCredential(ctx context.Context, credential *model.TOTPCredential) error {
+	return r.queries.SaveTOTPCredential(ctx, &query.SaveTOTPCredentialParams{
+		UserID:           credential.UserID,
+		SecretCiphertext: credential.EncryptedSecret,
+	})
+}
+
+func (r *totpRepository) GetTOTPCredential(ctx context.Context, userID string) (*model.TOTPCredential, error) {
+	c, err := r.queries.GetTOTPCredential(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrTOTPNotEnabled
+		}
+		return nil, err
+	}
+	return &model.TOTPCredential{
+		UserID:          c.UserID,
+		EncryptedSecret: c.SecretCiphertext,
+		ConfirmedAt:     nullTime(c.ConfirmedAt),
+		LastUsedStep:    c.LastUsedStep,
+		CreatedAt:       c.CreatedAt,
+	}, nil
+}
+
+// ConfirmTOTPCredential は登録を確認済みにし、確認に使用したコードのステップを記録します。
+// 既に確認済みの場合 (同時に確認された場合を含む) は model.ErrTOTPAlreadyEnabled を返します。
+func (r *totpRepository) ConfirmTOTPCredential(ctx context.Context, userID string, step int64) error {
+	rows, err := r.queries.ConfirmTOTPCredential(ctx, &query.ConfirmTOTPCredentialParams{
+		LastUsedStep: step,
+		UserID:       userID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrTOTPAlreadyEnabled
+	}
+	return nil
+}
+
+// UseTOTPStep は使用したコードのステップを記録します。
+// 最後に使用したステップ以前の場合 (同じコードが再使用された場合) は model.ErrInvalidTOTPCode を返します。
+func (r *totpRepository) UseTOTPStep(ctx context.Context, userID string, step int64) error {
+	rows, err := r.queries.UseTOTPStep(ctx, &query.UseTOTPStepParams{
+		Step:   step,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInvalidTOTPCode
+	}
+	return nil
+}
+
+func (r *totpRepository) DeleteTOTPCredential(ctx context.Context, userID string) error {
+	if err := r.queries.DeleteUserTOTPRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+	return r.queries.DeleteTOTPCredential(ctx, userID)
+}
+
+func (r *totpRepository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	if err := r.queries.DeleteUserTOTPRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+	for _, codeHash := range codeHashes {
+		if err := r.queries.CreateTOTPRecoveryCode(ctx, &query.CreateTOTPRecoveryCodeParams{
+			ID:       uuid.New().String(),
+			UserID:   userID,
+			CodeHash: codeHash,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UseRecoveryCode はリカバリーコードを使用済みにします。
+// 存在しないか使用済みの場合は model.ErrInvalidTOTPCode を返します。
+func (r *totpRepository) UseRecoveryCode(ctx context.Context, userID string, codeHash string) error {
+	rows, err := r.queries.UseTOTPRecoveryCode(ctx, &query.UseTOTPRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInvalidTOTPCode
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type userIdentityRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+	tx      *sql.Tx // トランザクションを保持するフィールド
+}
+
+// NewUserIdentityRepository は新しい UserIdentityRepository の実装を返します。
+func NewUserIdentityRepository(cfg *config.Config) (repository.UserIdentityRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &userIdentityRepository{
+		db:      db,
+		queries: query.New(db),
+		tx:      nil,
+	}, nil
+}
+
+// トランザクションを開始
+func (r *userIdentityRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return r.db.BeginTx(ctx, nil)
+}
+
+// トランザクション内での操作用
+func (r *userIdentityRepository) WithTx(tx *sql.Tx) repository.UserIdentityRepository {
+	return &userIdentityRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(tx),
+		tx:      tx,
+	}
+}
+
+func (r *userIdentityRepository) CreateUserIdentity(ctx context.Context, identity *model.UserIdentity) error {
+	return r.queries.CreateUserIdentity(ctx, &query.CreateUserIdentityParams{
+		ID:      identity.ID,
+		UserID:  identity.UserID,
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+	})
+}
+
+func (r *userIdentityRepository) GetUserIdentity(ctx context.Context, issuer, subject string) (*model.UserIdentity, error) {
+	i, err := r.queries.GetUserIdentity(ctx, &query.GetUserIdentityParams{
+		Issuer:  issuer,
+		Subject: subject,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrUserIdentityNotFound
+		}
+		return nil, err
+	}
+	return &model.UserIdentity{
+		ID:        i.ID,
+		UserID:    i.UserID,
+		Issuer:    i.Issuer,
+		Subject:   i.Subject,
+		CreatedAt: i.CreatedAt,
+	}, nil
+}
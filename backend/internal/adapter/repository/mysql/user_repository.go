@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/a-s/connect-task-manage/internal/adapter/repository"
 	"github.com/a-s/connect-task-manage/internal/domain/model"
@@ -60,6 +62,7 @@ func (r *userRepository) CreateUser(ctx context.Context, user *model.User) (*mod
 		Name:     user.Name,
 		Email:    user.Email,
 		Password: user.Password,
+		Role:     string(user.Role),
 	})
 	if err != nil {
 		return nil, err
@@ -77,14 +80,7 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 		return nil, err
 	}
 
-	return &model.User{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		Password:  user.Password,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-	}, nil
+	return toModelUser(user), nil
 }
 
 func (r *userRepository) GetUserByID(ctx context.Context, id string) (*model.User, error) {
@@ -95,23 +91,17 @@ func (r *userRepository) GetUserByID(ctx context.Context, id string) (*model.Use
 		}
 		return nil, err
 	}
-	return &model.User{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		Password:  user.Password,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-	}, nil
+	return toModelUser(user), nil
 }
 
 func (r *userRepository) UpdateUser(ctx context.Context, user *model.User) (*model.User, error) {
 
 	err := r.queries.UpdateUser(ctx, &query.UpdateUserParams{
-		ID:       user.ID,
-		Name:     user.Name,
-		Email:    user.Email,
-		Password: user.Password,
+		ID:              user.ID,
+		Name:            user.Name,
+		Email:           user.Email,
+		Password:        user.Password,
+		EmailVerifiedAt: nullTimeFromPtr(user.EmailVerifiedAt),
 	})
 
 	if err != nil {
@@ -122,12 +112,93 @@ func (r *userRepository) UpdateUser(ctx context.Context, user *model.User) (*mod
 	if err != nil {
 		return nil, err
 	}
+	return toModelUser(updatedUser), nil
+}
+
+// MarkEmailVerified はメールアドレスが email のままであればユーザーを確認済みにします。
+// メールアドレスが変更されていた場合は model.ErrInvalidEmailVerificationToken を返します。
+func (r *userRepository) MarkEmailVerified(ctx context.Context, id, email string) error {
+	rows, err := r.queries.MarkUserEmailVerified(ctx, &query.MarkUserEmailVerifiedParams{
+		ID:    id,
+		Email: email,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInvalidEmailVerificationToken
+	}
+	return nil
+}
+
+// likeEscaper は LIKE のパターンで特別な意味を持つ文字をエスケープする
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ListUsers は名前またはメールアドレスに q.Search を含むユーザーを作成日時の新しい順に返します。
+func (r *userRepository) ListUsers(ctx context.Context, q *model.UserListQuery) ([]*model.User, error) {
+	params := &query.ListUsersParams{
+		Limit: int32(q.Limit),
+	}
+	if q.Search != "" {
+		params.Pattern = sql.NullString{String: "%" + likeEscaper.Replace(q.Search) + "%", Valid: true}
+	}
+	if q.After != nil {
+		params.CursorCreatedAt = sql.NullTime{Time: q.After.CreatedAt, Valid: true}
+		params.CursorID = q.After.ID
+	}
+
+	rows, err := r.queries.ListUsers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*model.User, 0, len(rows))
+	for _, u := range rows {
+		users = append(users, toModelUser(u))
+	}
+	return users, nil
+}
+
+// SearchUsers は callerID のユーザーと同じワークスペースのメンバーのうち、
+// 名前が q で始まるか、メールアドレスが q と一致する有効なユーザーを名前順に返します。
+func (r *userRepository) SearchUsers(ctx context.Context, callerID, q string, limit int) ([]*model.User, error) {
+	rows, err := r.queries.SearchUsers(ctx, &query.SearchUsersParams{
+		CallerID: callerID,
+		Prefix:   likeEscaper.Replace(q) + "%",
+		Email:    q,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*model.User, 0, len(rows))
+	for _, u := range rows {
+		users = append(users, toModelUser(u))
+	}
+	return users, nil
+}
+
+func (r *userRepository) SetUserDisabledAt(ctx context.Context, id string, disabledAt *time.Time) error {
+	return r.queries.SetUserDisabledAt(ctx, &query.SetUserDisabledAtParams{
+		ID:         id,
+		DisabledAt: nullTimeFromPtr(disabledAt),
+	})
+}
+
+func (r *userRepository) DeleteUser(ctx context.Context, id string) error {
+	return r.queries.DeleteUser(ctx, id)
+}
+
+// toModelUser は sqlc の User を model.User に変換する
+func toModelUser(u *query.User) *model.User {
 	return &model.User{
-		ID:        updatedUser.ID,
-		Name:      updatedUser.Name,
-		Email:     updatedUser.Email,
-		Password:  updatedUser.Password,
-		CreatedAt: updatedUser.CreatedAt,
-		UpdatedAt: updatedUser.UpdatedAt,
-	}, nil
+		ID:              u.ID,
+		Name:            u.Name,
+		Email:           u.Email,
+		Password:        u.Password,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+		EmailVerifiedAt: nullTime(u.EmailVerifiedAt),
+		Role:            model.Role(u.Role),
+		DisabledAt:      nullTime(u.DisabledAt),
+	}
 }
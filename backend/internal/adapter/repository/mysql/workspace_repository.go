@@ -0,0 +1,280 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/sql/query"
+)
+
+type workspaceRepository struct {
+	db      *sql.DB
+	queries *query.Queries
+}
+
+// NewWorkspaceRepository は新しい WorkspaceRepository の実装を返します。
+func NewWorkspaceRepository(cfg *config.Config) (repository.WorkspaceRepository, error) {
+	db, err := sql.Open("mysql", cfg.DB.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &workspaceRepository{
+		db:      db,
+		queries: query.New(db),
+	}, nil
+}
+
+func (r *workspaceRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return r.db.BeginTx(ctx, nil)
+}
+
+func (r *workspaceRepository) WithTx(tx *sql.Tx) repository.WorkspaceRepository {
+	return &workspaceRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(tx),
+	}
+}
+
+func (r *workspaceRepository) CreateWorkspace(ctx context.Context, workspace *model.Workspace) error {
+	return r.queries.CreateWorkspace(ctx, &query.CreateWorkspaceParams{
+		ID:   workspace.ID,
+		Name: workspace.Name,
+	})
+}
+
+func (r *workspaceRepository) GetWorkspaceByID(ctx context.Context, id string) (*model.Workspace, error) {
+	w, err := r.queries.GetWorkspaceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrWorkspaceNotFound
+		}
+		return nil, err
+	}
+	return toModelWorkspace(w), nil
+}
+
+func (r *workspaceRepository) UpdateWorkspace(ctx context.Context, workspace *model.Workspace) (*model.Workspace, error) {
+	err := r.queries.UpdateWorkspace(ctx, &query.UpdateWorkspaceParams{
+		ID:   workspace.ID,
+		Name: workspace.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetWorkspaceByID(ctx, workspace.ID)
+}
+
+func (r *workspaceRepository) DeleteWorkspace(ctx context.Context, id string) error {
+	return r.queries.DeleteWorkspace(ctx, id)
+}
+
+func (r *workspaceRepository) ListUserWorkspaces(ctx context.Context, userID string) ([]*model.WorkspaceMembership, error) {
+	rows, err := r.queries.ListWorkspacesByMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	memberships := make([]*model.WorkspaceMembership, 0, len(rows))
+	for _, row := range rows {
+		memberships = append(memberships, &model.WorkspaceMembership{
+			Workspace: &model.Workspace{
+				ID:        row.ID,
+				Name:      row.Name,
+				CreatedAt: row.CreatedAt,
+				UpdatedAt: row.UpdatedAt,
+			},
+			Role: model.WorkspaceRole(row.Role),
+		})
+	}
+	return memberships, nil
+}
+
+func (r *workspaceRepository) CreateWorkspaceMember(ctx context.Context, member *model.WorkspaceMember) error {
+	return r.queries.CreateWorkspaceMember(ctx, &query.CreateWorkspaceMemberParams{
+		WorkspaceID: member.WorkspaceID,
+		UserID:      member.UserID,
+		Role:        string(member.Role),
+	})
+}
+
+func (r *workspaceRepository) GetWorkspaceMember(ctx context.Context, workspaceID, userID string) (*model.WorkspaceMember, error) {
+	m, err := r.queries.GetWorkspaceMember(ctx, &query.GetWorkspaceMemberParams{
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrWorkspaceMemberNotFound
+		}
+		return nil, err
+	}
+	return &model.WorkspaceMember{
+		WorkspaceID: m.WorkspaceID,
+		UserID:      m.UserID,
+		Role:        model.WorkspaceRole(m.Role),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}, nil
+}
+
+func (r *workspaceRepository) ListWorkspaceMembers(ctx context.Context, workspaceID string) ([]*model.WorkspaceMember, error) {
+	rows, err := r.queries.ListWorkspaceMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]*model.WorkspaceMember, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, &model.WorkspaceMember{
+			WorkspaceID: row.WorkspaceID,
+			UserID:      row.UserID,
+			Role:        model.WorkspaceRole(row.Role),
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			Name:        row.Name,
+			Email:       row.Email,
+		})
+	}
+	return members, nil
+}
+
+func (r *workspaceRepository) UpdateWorkspaceMemberRole(ctx context.Context, workspaceID, userID string, role model.WorkspaceRole) error {
+	return r.queries.UpdateWorkspaceMemberRole(ctx, &query.UpdateWorkspaceMemberRoleParams{
+		Role:        string(role),
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+	})
+}
+
+func (r *workspaceRepository) DeleteWorkspaceMember(ctx context.Context, workspaceID, userID string) error {
+	return r.queries.DeleteWorkspaceMember(ctx, &query.DeleteWorkspaceMemberParams{
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+	})
+}
+
+func (r *workspaceRepository) CountWorkspaceOwners(ctx context.Context, workspaceID string) (int, error) {
+	n, err := r.queries.CountWorkspaceOwners(ctx, workspaceID)
+	return int(n), err
+}
+
+func (r *workspaceRepository) ListWorkspacesSolelyOwnedBy(ctx context.Context, userID string) ([]string, error) {
+	return r.queries.ListWorkspacesSolelyOwnedBy(ctx, userID)
+}
+
+func (r *workspaceRepository) AddOwnerToUserWorkspaces(ctx context.Context, userID, newOwnerID string) error {
+	return r.queries.AddOwnerToWorkspacesOwnedBy(ctx, &query.AddOwnerToWorkspacesOwnedByParams{
+		NewOwnerID: newOwnerID,
+		OwnerID:    userID,
+	})
+}
+
+func (r *workspaceRepository) CreateWorkspaceInvitation(ctx context.Context, invitation *model.WorkspaceInvitation) error {
+	return r.queries.CreateWorkspaceInvitation(ctx, &query.CreateWorkspaceInvitationParams{
+		ID:          invitation.ID,
+		WorkspaceID: invitation.WorkspaceID,
+		Email:       invitation.Email,
+		Role:        string(invitation.Role),
+		InvitedBy:   invitation.InvitedBy,
+		ExpiresAt:   invitation.ExpiresAt,
+	})
+}
+
+func (r *workspaceRepository) GetWorkspaceInvitationByID(ctx context.Context, id string) (*model.WorkspaceInvitation, error) {
+	i, err := r.queries.GetWorkspaceInvitationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrInvitationNotFound
+		}
+		return nil, err
+	}
+	return toModelWorkspaceInvitation(i), nil
+}
+
+func (r *workspaceRepository) ListPendingWorkspaceInvitations(ctx context.Context, workspaceID string) ([]*model.WorkspaceInvitation, error) {
+	rows, err := r.queries.ListPendingWorkspaceInvitations(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	invitations := make([]*model.WorkspaceInvitation, 0, len(rows))
+	for _, row := range rows {
+		invitations = append(invitations, toModelWorkspaceInvitation(row))
+	}
+	return invitations, nil
+}
+
+func (r *workspaceRepository) ListPendingInvitationsByEmail(ctx context.Context, email string) ([]*model.WorkspaceInvitation, error) {
+	rows, err := r.queries.ListPendingWorkspaceInvitationsByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	invitations := make([]*model.WorkspaceInvitation, 0, len(rows))
+	for _, row := range rows {
+		invitation := toModelWorkspaceInvitation(&query.WorkspaceInvitation{
+			ID:          row.ID,
+			WorkspaceID: row.WorkspaceID,
+			Email:       row.Email,
+			Role:        row.Role,
+			InvitedBy:   row.InvitedBy,
+			Status:      row.Status,
+			ExpiresAt:   row.ExpiresAt,
+			RespondedAt: row.RespondedAt,
+			CreatedAt:   row.CreatedAt,
+		})
+		invitation.WorkspaceName = row.WorkspaceName
+		invitations = append(invitations, invitation)
+	}
+	return invitations, nil
+}
+
+func (r *workspaceRepository) RespondWorkspaceInvitation(ctx context.Context, id string, status model.InvitationStatus) error {
+	n, err := r.queries.RespondWorkspaceInvitation(ctx, &query.RespondWorkspaceInvitationParams{
+		Status: string(status),
+		ID:     id,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return model.ErrInvitationNotPending
+	}
+	return nil
+}
+
+func (r *workspaceRepository) RevokePendingWorkspaceInvitations(ctx context.Context, workspaceID, email string) error {
+	return r.queries.RevokePendingWorkspaceInvitations(ctx, &query.RevokePendingWorkspaceInvitationsParams{
+		WorkspaceID: workspaceID,
+		Email:       email,
+	})
+}
+
+// toModelWorkspace は sqlc の Workspace を model.Workspace に変換する
+func toModelWorkspace(w *query.Workspace) *model.Workspace {
+	return &model.Workspace{
+		ID:        w.ID,
+		Name:      w.Name,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+// toModelWorkspaceInvitation は sqlc の WorkspaceInvitation を model.WorkspaceInvitation に変換する
+func toModelWorkspaceInvitation(i *query.WorkspaceInvitation) *model.WorkspaceInvitation {
+	return &model.WorkspaceInvitation{
+		ID:          i.ID,
+		WorkspaceID: i.WorkspaceID,
+		Email:       i.Email,
+		Role:        model.WorkspaceRole(i.Role),
+		InvitedBy:   i.InvitedBy,
+		Status:      model.InvitationStatus(i.Status),
+		ExpiresAt:   i.ExpiresAt,
+		RespondedAt: nullTime(i.RespondedAt),
+		CreatedAt:   i.CreatedAt,
+	}
+}
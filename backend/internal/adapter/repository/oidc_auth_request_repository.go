@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// OIDCAuthRequestRepository は ID プロバイダーへの認可リクエストへのアクセスを抽象化するインターフェースです。
+type OIDCAuthRequestRepository interface {
+	CreateOIDCAuthRequest(ctx context.Context, req *model.OIDCAuthRequest) error
+	// ConsumeOIDCAuthRequest は認可リクエストを取得して削除します (一度だけ使用できる)。
+	// 見つからない場合 (同じ state が同時に使用された場合を含む) は model.ErrInvalidSSOState を返します。
+	ConsumeOIDCAuthRequest(ctx context.Context, stateHash string) (*model.OIDCAuthRequest, error)
+}
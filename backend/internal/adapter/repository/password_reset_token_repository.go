@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// PasswordResetTokenRepository はパスワード再設定用トークンへのアクセスを抽象化するインターフェースです。
+type PasswordResetTokenRepository interface {
+	CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error
+	GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error) // 見つからない場合は model.ErrInvalidPasswordResetToken
+	MarkPasswordResetTokenUsed(ctx context.Context, id string) error                                      // 使用済みの場合は model.ErrInvalidPasswordResetToken
+	InvalidateUserPasswordResetTokens(ctx context.Context, userID string) error                           // ユーザーの未使用のトークンをすべて無効にする
+
+	// トランザクション関連
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	WithTx(tx *sql.Tx) PasswordResetTokenRepository
+}
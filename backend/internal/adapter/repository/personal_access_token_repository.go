@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// PersonalAccessTokenRepository はパーソナルアクセストークンへのアクセスを抽象化するインターフェースです。
+type PersonalAccessTokenRepository interface {
+	CreatePersonalAccessToken(ctx context.Context, token *model.PersonalAccessToken) error
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*model.PersonalAccessToken, error)  // 見つからない場合は model.ErrPersonalAccessTokenNotFound
+	ListPersonalAccessTokensByUser(ctx context.Context, userID string) ([]*model.PersonalAccessToken, error) // 失効済みのトークンは含めない
+	RevokePersonalAccessToken(ctx context.Context, id, userID string) error                                  // 見つからない場合は model.ErrPersonalAccessTokenNotFound
+	RevokeUserPersonalAccessTokens(ctx context.Context, userID string) error                                 // ユーザーのトークンをすべて失効させる
+
+	// トランザクション関連
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	WithTx(tx *sql.Tx) PersonalAccessTokenRepository
+}
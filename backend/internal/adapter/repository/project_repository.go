@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// ProjectRepository はプロジェクトへのアクセスを抽象化するインターフェースです。
+type ProjectRepository interface {
+	CreateProject(ctx context.Context, project *model.Project) error
+	GetProjectByID(ctx context.Context, id string) (*model.Project, error)                 // 見つからない場合は model.ErrProjectNotFound
+	ListProjects(ctx context.Context, q *model.ProjectListQuery) ([]*model.Project, error) // 作成日時の新しい順に最大 q.Limit 件を返す
+	UpdateProject(ctx context.Context, project *model.Project) (*model.Project, error)
+	UpdateProjectWorkflow(ctx context.Context, id string, workflow *model.Workflow) error // workflow が nil の場合は既定のワークフローに戻す
+	DeleteProject(ctx context.Context, id string) error
+	ListUserProjectIDs(ctx context.Context, userID string) ([]string, error)    // ユーザーの削除時に、所有するプロジェクトの ID を返す
+	ReassignUserProjects(ctx context.Context, userID, successorID string) error // ユーザーの削除時に、所有するプロジェクトを successorID のユーザーに移す
+
+	// トランザクション関連
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	WithTx(tx *sql.Tx) ProjectRepository
+}
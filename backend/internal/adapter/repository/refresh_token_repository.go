@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// RefreshTokenRepository はリフレッシュトークンへのアクセスを抽象化するインターフェースです。
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) // 見つからない場合は model.ErrInvalidRefreshToken
+	MarkRefreshTokenUsed(ctx context.Context, id string) error                                // 使用済みまたは失効済みの場合は model.ErrRefreshTokenReused
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+
+	// トランザクション関連
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	WithTx(tx *sql.Tx) RefreshTokenRepository
+}
@@ -10,11 +10,42 @@ import (
 
 type TaskRepository interface {
 	CreateTask(ctx context.Context, task *model.Task) error
-	UpdateTask(ctx context.Context, task *model.Task) (*model.Task, error)
-	ListTasks(ctx context.Context, userID string) ([]*model.Task, error)
-	DeleteTask(ctx context.Context, id string) error
+	UpdateTask(ctx context.Context, task *model.Task) (*model.Task, error)        // task.Version が一致しない場合は model.ErrTaskConflict
+	ListTasks(ctx context.Context, q *model.TaskListQuery) ([]*model.Task, error) // q.SortKey の順で最大 q.Limit 件を返す
+	DeleteTask(ctx context.Context, id string, version int64) error               // version が一致しない場合は model.ErrTaskConflict。子孫のタスクも削除される
 	GetTaskByID(ctx context.Context, id string) (*model.Task, error)
 
+	// サブタスク (返すタスクには子孫の進捗 Subtasks を含める)
+	ListSubtasks(ctx context.Context, parentID string) ([]*model.Task, error)         // 子のタスクを作成日時の古い順に返す
+	ListDescendants(ctx context.Context, rootID string) ([]*model.Task, error)        // 子孫のタスクすべてを作成日時の古い順に返す
+	ReparentSubtasks(ctx context.Context, parentID string, newParentID *string) error // 子のタスクの親を newParentID にする (nil の場合は親なし)
+
+	// タスクの階層を変更する間のロック (トランザクション内で呼び出す。デッドロックで中断された場合は model.ErrTaskConflict)
+	GetTaskByIDForUpdate(ctx context.Context, id string) (*model.Task, error)           // タスクの行をロックして返す
+	ListDescendantsForUpdate(ctx context.Context, rootID string) ([]*model.Task, error) // 子孫のタスクすべてをロックして、階層の浅い順に返す
+
+	// ユーザーの削除時に、そのユーザーを参照しているタスクを整理する
+	ReassignUserTasks(ctx context.Context, userID, successorID string) error // 作成したタスクと担当しているタスクを successorID のユーザーに移す (successorID がメンバーでないワークスペースのタスクは作成者を残る owner にし、担当者なしにする)
+	DeleteUserTasks(ctx context.Context, userID string) error                // 作成した個人のタスクを削除し (ワークスペースのタスクの作成者は残る owner にする)、担当しているタスクは担当者なしにする
+
+	// プロジェクトの削除時に、そのプロジェクトのタスクを整理する
+	MoveProjectTasks(ctx context.Context, projectID string, targetProjectID *string) error // targetProjectID が nil の場合はプロジェクトから外す
+	DeleteProjectTasks(ctx context.Context, projectID string) error
+
+	// プロジェクトのタスクを別のワークフローに従わせるときに、タスクの状態を整理する
+	AdoptProjectWorkflow(ctx context.Context, projectID string, workflow *model.Workflow) error                    // ワークフローにない状態のタスクを FallbackStatus にする
+	CountProjectTasksOutsideWorkflow(ctx context.Context, projectID string, workflow *model.Workflow) (int, error) // ワークフローにない状態のタスクの数
+	SyncProjectTaskCompletion(ctx context.Context, projectID string, workflow *model.Workflow) error               // is_completed をワークフローの完了として扱う状態に合わせる
+
+	// ボードの列の並び順 (excludeID のタスクは列に含めない)
+	LastRankInColumn(ctx context.Context, column model.BoardColumn, excludeID string) (string, error)                       // 列の末尾のキー (列が空の場合は空文字)
+	RankBeforeInColumn(ctx context.Context, column model.BoardColumn, anchor *model.Task, excludeID string) (string, error) // anchor の直前のタスクのキー (先頭の場合は空文字)
+	RankAfterInColumn(ctx context.Context, column model.BoardColumn, anchor *model.Task, excludeID string) (string, error)  // anchor の直後のタスクのキー (末尾の場合は空文字)
+	RebalanceColumn(ctx context.Context, column model.BoardColumn, excludeID string) error                                  // 列のキーを model.SpreadRanks で振り直す (トランザクション内で呼び出す)
+
+	// メンバーがワークスペースから外れたときに、そのメンバーが担当しているワークスペースのタスクを担当者なしにする
+	UnassignWorkspaceTasks(ctx context.Context, workspaceID, userID string) error
+
 	// トランザクション関連 (UserRepository からコピー)
 	BeginTx(ctx context.Context) (*sql.Tx, error)
 	WithTx(tx *sql.Tx) TaskRepository
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// TokenRevocationRepository はアクセストークンの失効情報へのアクセスを抽象化するインターフェースです。
+//
+// トークンは jti による個別の失効と、ユーザーごとの失効基準時刻 (この時刻以前に発行されたトークンはすべて無効) の
+// 2 通りの方法で失効させます。
+type TokenRevocationRepository interface {
+	RevokeToken(ctx context.Context, tokenID, userID string, expiresAt time.Time) error // expiresAt を過ぎた失効情報は破棄してよい
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+	SetUserTokensRevokedBefore(ctx context.Context, userID string, before time.Time) error
+	GetUserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) // 設定されていない場合はゼロ値を返す
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// TOTPRepository は二要素認証 (TOTP) の登録情報とリカバリーコードへのアクセスを抽象化するインターフェースです。
+type TOTPRepository interface {
+	SaveTOTPCredential(ctx context.Context, credential *model.TOTPCredential) error      // 未確認の登録がある場合は置き換える
+	GetTOTPCredential(ctx context.Context, userID string) (*model.TOTPCredential, error) // 見つからない場合は model.ErrTOTPNotEnabled
+	ConfirmTOTPCredential(ctx context.Context, userID string, step int64) error          // 確認済みの場合は model.ErrTOTPAlreadyEnabled
+	UseTOTPStep(ctx context.Context, userID string, step int64) error                    // 使用済みのステップの場合は model.ErrInvalidTOTPCode
+	DeleteTOTPCredential(ctx context.Context, userID string) error                       // リカバリーコードも削除する
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error  // 以前のリカバリーコードは使用できなくなる
+	UseRecoveryCode(ctx context.Context, userID string, codeHash string) error           // 存在しないか使用済みの場合は model.ErrInvalidTOTPCode
+
+	// トランザクション関連
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	WithTx(tx *sql.Tx) TOTPRepository
+}
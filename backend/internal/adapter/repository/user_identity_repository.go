@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// UserIdentityRepository はユーザーと ID プロバイダーのアカウントの紐付けへのアクセスを抽象化するインターフェースです。
+type UserIdentityRepository interface {
+	CreateUserIdentity(ctx context.Context, identity *model.UserIdentity) error
+	GetUserIdentity(ctx context.Context, issuer, subject string) (*model.UserIdentity, error) // 見つからない場合は model.ErrUserIdentityNotFound
+
+	// トランザクション関連
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	WithTx(tx *sql.Tx) UserIdentityRepository
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/a-s/connect-task-manage/internal/domain/model"
 	// sqlc の生成コード
@@ -14,6 +15,11 @@ type UserRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
 	GetUserByID(ctx context.Context, id string) (*model.User, error)
 	UpdateUser(ctx context.Context, user *model.User) (*model.User, error)
+	MarkEmailVerified(ctx context.Context, id, email string) error                         // メールアドレスが email から変更されている場合は model.ErrInvalidEmailVerificationToken
+	ListUsers(ctx context.Context, q *model.UserListQuery) ([]*model.User, error)          // 作成日時の新しい順に最大 q.Limit 件を返す
+	SearchUsers(ctx context.Context, callerID, q string, limit int) ([]*model.User, error) // callerID と同じワークスペースのメンバーのうち、名前が q で始まるかメールアドレスが q と一致する有効なユーザーを名前順に返す
+	SetUserDisabledAt(ctx context.Context, id string, disabledAt *time.Time) error         // nil の場合は有効に戻す
+	DeleteUser(ctx context.Context, id string) error
 
 	// トランザクション関連のメソッド
 	BeginTx(ctx context.Context) (*sql.Tx, error) // トランザクション開始
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// WorkspaceRepository はワークスペースとそのメンバー・招待へのアクセスを抽象化するインターフェースです。
+type WorkspaceRepository interface {
+	CreateWorkspace(ctx context.Context, workspace *model.Workspace) error
+	GetWorkspaceByID(ctx context.Context, id string) (*model.Workspace, error) // 見つからない場合は model.ErrWorkspaceNotFound
+	UpdateWorkspace(ctx context.Context, workspace *model.Workspace) (*model.Workspace, error)
+	DeleteWorkspace(ctx context.Context, id string) error
+	ListUserWorkspaces(ctx context.Context, userID string) ([]*model.WorkspaceMembership, error) // ユーザーが参加しているワークスペースを名前順に返す
+
+	// メンバー関連
+	CreateWorkspaceMember(ctx context.Context, member *model.WorkspaceMember) error
+	GetWorkspaceMember(ctx context.Context, workspaceID, userID string) (*model.WorkspaceMember, error) // メンバーでない場合は model.ErrWorkspaceMemberNotFound
+	ListWorkspaceMembers(ctx context.Context, workspaceID string) ([]*model.WorkspaceMember, error)     // 参加した順に、ユーザーの名前とメールアドレスを含めて返す
+	UpdateWorkspaceMemberRole(ctx context.Context, workspaceID, userID string, role model.WorkspaceRole) error
+	DeleteWorkspaceMember(ctx context.Context, workspaceID, userID string) error
+	CountWorkspaceOwners(ctx context.Context, workspaceID string) (int, error)
+
+	// ユーザーの削除時に、ユーザーが所有者であるワークスペースを整理する
+	ListWorkspacesSolelyOwnedBy(ctx context.Context, userID string) ([]string, error) // ユーザーが唯一の所有者であるワークスペースの ID
+	AddOwnerToUserWorkspaces(ctx context.Context, userID, newOwnerID string) error    // ユーザーが所有者であるワークスペースに newOwnerID のユーザーを所有者として加える
+
+	// 招待関連
+	CreateWorkspaceInvitation(ctx context.Context, invitation *model.WorkspaceInvitation) error
+	GetWorkspaceInvitationByID(ctx context.Context, id string) (*model.WorkspaceInvitation, error)                 // 見つからない場合は model.ErrInvitationNotFound
+	ListPendingWorkspaceInvitations(ctx context.Context, workspaceID string) ([]*model.WorkspaceInvitation, error) // 有効期限内の未回答の招待を新しい順に返す
+	ListPendingInvitationsByEmail(ctx context.Context, email string) ([]*model.WorkspaceInvitation, error)         // ワークスペースの名前を含めて返す
+	RespondWorkspaceInvitation(ctx context.Context, id string, status model.InvitationStatus) error                // 未回答でない場合は model.ErrInvitationNotPending
+	RevokePendingWorkspaceInvitations(ctx context.Context, workspaceID, email string) error
+
+	// トランザクション関連
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	WithTx(tx *sql.Tx) WorkspaceRepository
+}
@@ -0,0 +1,288 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/sso"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DiscoveryPath は issuer からプロバイダーの設定 (OpenID Provider Metadata) を取得するパスです。
+const DiscoveryPath = "/.well-known/openid-configuration"
+
+const (
+	httpTimeout = 10 * time.Second
+	clockSkew   = time.Minute // ID トークンの有効期限などを検証するときに許容する時刻のずれ
+	maxBodySize = 1 << 20     // ID プロバイダーからのレスポンスの上限
+)
+
+// supportedAlgorithms は ID トークンの署名として受け付けるアルゴリズムです (共通鍵の HS256 や none は受け付けない)。
+var supportedAlgorithms = []string{"RS256", "ES256", "EdDSA"}
+
+// metadata はディスカバリーで取得するプロバイダーの設定のうち、使用する項目です。
+type metadata struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	CodeChallengeMethods  []string `json:"code_challenge_methods_supported"`
+}
+
+// Provider は OpenID Connect の ID プロバイダーの認可コードフロー (PKCE) を扱います。
+// プロバイダーの設定と公開鍵は最初に使用するときに取得するため、ID プロバイダーが停止していても起動は妨げません。
+type Provider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	httpClient   *http.Client
+	keys         *keySet
+
+	mu       sync.Mutex
+	metadata *metadata // 取得に成功するまでは nil
+}
+
+// NewProvider は設定から OpenID Connect の ID プロバイダーを作成します。
+func NewProvider(cfg *config.Config) (sso.IdentityProvider, error) {
+	if cfg.OIDC.ClientID == "" {
+		return nil, fmt.Errorf("OIDC_CLIENT_ID is required when OIDC_ISSUER_URL is set")
+	}
+	if cfg.OIDC.RedirectURL == "" {
+		return nil, fmt.Errorf("OIDC_REDIRECT_URL is required when OIDC_ISSUER_URL is set")
+	}
+	if u, err := url.Parse(cfg.OIDC.IssuerURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid OIDC_ISSUER_URL: %q", cfg.OIDC.IssuerURL)
+	}
+
+	scopes := slices.Clone(cfg.OIDC.Scopes)
+	if !slices.Contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+
+	httpClient := &http.Client{Timeout: httpTimeout}
+	return &Provider{
+		issuer:       cfg.OIDC.IssuerURL,
+		clientID:     cfg.OIDC.ClientID,
+		clientSecret: cfg.OIDC.ClientSecret,
+		redirectURL:  cfg.OIDC.RedirectURL,
+		scopes:       scopes,
+		httpClient:   httpClient,
+		keys:         newKeySet(httpClient),
+	}, nil
+}
+
+// AuthCodeURL はユーザーをリダイレクトする認可エンドポイントの URL を返します。
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid authorization endpoint: %w", err)
+	}
+	params := u.Query()
+	params.Set("response_type", "code")
+	params.Set("client_id", p.clientID)
+	params.Set("redirect_uri", p.redirectURL)
+	params.Set("scope", strings.Join(p.scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", codeChallenge)
+	params.Set("code_challenge_method", "S256")
+	u.RawQuery = params.Encode()
+	return u.String(), nil
+}
+
+// Exchange は認可コードを ID トークンと交換して検証し、ID トークンが示すユーザーの情報を返します。
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*model.ExternalIdentity, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rawIDToken, err := p.exchangeCode(ctx, md, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := p.verifyIDToken(ctx, md, rawIDToken, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", model.ErrSSOAuthentication, err)
+	}
+
+	return &model.ExternalIdentity{
+		Issuer:        md.Issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+	}, nil
+}
+
+// discover はプロバイダーの設定を取得します。取得に成功した設定は以後も使用します。
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	var md metadata
+	if err := getJSON(ctx, p.httpClient, strings.TrimRight(p.issuer, "/")+DiscoveryPath, &md); err != nil {
+		return nil, fmt.Errorf("failed to discover openid provider: %w", err)
+	}
+	// 他の issuer の設定に差し替えられていないことを確認する (OpenID Connect Discovery 4.3)
+	if md.Issuer != p.issuer {
+		return nil, fmt.Errorf("openid provider issuer mismatch: got %q, want %q", md.Issuer, p.issuer)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, fmt.Errorf("openid provider metadata is missing required endpoints")
+	}
+	if len(md.CodeChallengeMethods) > 0 && !slices.Contains(md.CodeChallengeMethods, "S256") {
+		return nil, fmt.Errorf("openid provider does not support PKCE (S256)")
+	}
+
+	p.metadata = &md
+	return p.metadata, nil
+}
+
+// tokenResponse はトークンエンドポイントのレスポンスです。
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// exchangeCode はトークンエンドポイントで認可コードを交換し、ID トークンを返します。
+// クライアントシークレットが設定されている場合は client_secret_basic で認証します。
+func (p *Provider) exchangeCode(ctx context.Context, md *metadata, code, codeVerifier string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.redirectURL)
+	form.Set("code_verifier", codeVerifier)
+	if p.clientSecret == "" {
+		form.Set("client_id", p.clientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.clientID), url.QueryEscape(p.clientSecret))
+	}
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	defer res.Body.Close()
+
+	var body tokenResponse
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxBodySize)).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode token response (status %d): %w", res.StatusCode, err)
+	}
+	if res.StatusCode != http.StatusOK || body.Error != "" {
+		// 誤った・使用済みの認可コードなど (invalid_grant)
+		return "", fmt.Errorf("%w: token endpoint returned %q: %s", model.ErrSSOAuthentication, body.Error, body.ErrorDescription)
+	}
+	if body.IDToken == "" {
+		return "", fmt.Errorf("%w: token response has no id_token", model.ErrSSOAuthentication)
+	}
+	return body.IDToken, nil
+}
+
+// idTokenClaims は ID トークンのクレームのうち、使用する項目です。
+type idTokenClaims struct {
+	Nonce           string       `json:"nonce"`
+	AuthorizedParty string       `json:"azp"`
+	Email           string       `json:"email"`
+	EmailVerified   flexibleBool `json:"email_verified"`
+	Name            string       `json:"name"`
+	jwt.RegisteredClaims
+}
+
+// verifyIDToken は ID トークンの署名・issuer・audience・有効期限・nonce を検証します (OpenID Connect Core 3.1.3.7)。
+func (p *Provider) verifyIDToken(ctx context.Context, md *metadata, rawIDToken, nonce string) (*idTokenClaims, error) {
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims,
+		func(t *jwt.Token) (interface{}, error) {
+			return p.keys.key(ctx, md.JWKSURI, t)
+		},
+		jwt.WithValidMethods(supportedAlgorithms),
+		jwt.WithIssuer(md.Issuer),
+		jwt.WithAudience(p.clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("id token has no subject")
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("id token nonce mismatch")
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.clientID {
+		return nil, fmt.Errorf("id token authorized party mismatch")
+	}
+	return claims, nil
+}
+
+// flexibleBool は真偽値を文字列 ("true") で返す ID プロバイダーにも対応した真偽値です。
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case bool:
+		*b = flexibleBool(v)
+	case string:
+		*b = flexibleBool(v == "true")
+	default:
+		*b = false
+	}
+	return nil
+}
+
+// getJSON は URL から JSON を取得します。
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", res.StatusCode, url)
+	}
+	return json.NewDecoder(io.LimitReader(res.Body, maxBodySize)).Decode(v)
+}
@@ -0,0 +1,16 @@
+package sso
+
+import (
+	"context"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// IdentityProvider は外部の ID プロバイダーによるシングルサインオン (認可コードフロー + PKCE) を抽象化するインターフェースです。
+type IdentityProvider interface {
+	// AuthCodeURL はユーザーをリダイレクトする ID プロバイダーの認可エンドポイントの URL を返します。
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange は認可コードを ID トークンと交換して検証し、ID トークンが示すユーザーの情報を返します。
+	// 交換・検証に失敗した場合は model.ErrSSOAuthentication をラップしたエラーを返します。
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*model.ExternalIdentity, error)
+}
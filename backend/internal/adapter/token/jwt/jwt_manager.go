@@ -1,65 +1,156 @@
 package jwt
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
 	"github.com/a-s/connect-task-manage/internal/adapter/token"
 	"github.com/a-s/connect-task-manage/internal/domain/model"
 	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
+// challengeDuration は二要素認証の確認待ちトークンの有効期間です。
+const challengeDuration = 5 * time.Minute
+
+// purposeChallenge は二要素認証の確認待ちトークンの用途です。
+const purposeChallenge = "2fa_challenge"
+
 type JWTManager struct {
-	secretKey     string
+	keys          *KeySet
 	tokenDuration time.Duration
+	revocations   repository.TokenRevocationRepository
 }
 
 // NewJWTManager は新しい JWTManager インスタンスを作成します。
-func NewJWTManager(cfg *config.Config) token.TokenManager {
+func NewJWTManager(cfg *config.Config, keys *KeySet, revocations repository.TokenRevocationRepository) token.TokenManager {
 	return &JWTManager{
-		secretKey:     cfg.JWT.Secret,
+		keys:          keys,
 		tokenDuration: time.Duration(cfg.JWT.DurationMinutes) * time.Minute,
+		revocations:   revocations,
 	}
 }
 
 // Claims は JWT のカスタムクレームを表します。
 type Claims struct {
-	UserID string `json:"user_id"`
+	UserID  string `json:"user_id"`
+	Purpose string `json:"purpose,omitempty"` // アクセストークン以外の用途 (アクセストークンの場合は空)
+	Role    string `json:"role,omitempty"`
+	// IssuedAtMicros は発行日時 (UNIX 時間のマイクロ秒) です。iat は秒単位のため、失効基準時刻との比較にはこちらを使います。
+	IssuedAtMicros int64 `json:"iat_us,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // Generate はユーザー情報に基づいて JWT トークンを生成します。
 func (m *JWTManager) Generate(user *model.User) (string, error) {
+	return m.generate(user, "", m.tokenDuration)
+}
+
+// GenerateChallenge はパスワードの確認後、二要素認証のコードと交換するためのトークンを生成します。
+func (m *JWTManager) GenerateChallenge(user *model.User) (string, error) {
+	return m.generate(user, purposeChallenge, challengeDuration)
+}
+
+// generate は用途と有効期間を指定して JWT トークンを生成します。
+func (m *JWTManager) generate(user *model.User, purpose string, duration time.Duration) (string, error) {
+	now := time.Now()
 	claims := Claims{
-		UserID: user.ID,
+		UserID:         user.ID,
+		Purpose:        purpose,
+		Role:           string(user.Role),
+		IssuedAtMicros: now.UnixMicro(),
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.tokenDuration)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			ID:        uuid.New().String(), // jti (個別に失効させるための識別子)
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "ddd-auth-app",
 			Subject:   user.Email, // サブジェクトは email とする
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.secretKey))
+	return m.keys.sign(claims)
+}
+
+// Verify は JWT トークンを検証し、失効していなければクレームを返します。
+// 二要素認証の確認待ちトークンはアクセストークンとして受け付けません。
+func (m *JWTManager) Verify(ctx context.Context, tokenString string) (*token.Claims, error) {
+	return m.verify(ctx, tokenString, "")
 }
 
-// Verify は JWT トークンを検証し、ユーザー ID を返します。
-func (m *JWTManager) Verify(tokenString string) (string, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(m.secretKey), nil
-	})
+// VerifyChallenge は二要素認証の確認待ちトークンを検証し、失効していなければクレームを返します。
+func (m *JWTManager) VerifyChallenge(ctx context.Context, tokenString string) (*token.Claims, error) {
+	claims, err := m.verify(ctx, tokenString, purposeChallenge)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidChallengeToken, err)
+	}
+	return claims, nil
+}
+
+// verify は JWT トークンを検証し、用途が一致して失効していなければクレームを返します。
+func (m *JWTManager) verify(ctx context.Context, tokenString, purpose string) (*token.Claims, error) {
+	parsed, err := jwt.ParseWithClaims(tokenString, &Claims{}, m.keys.keyFunc,
+		jwt.WithValidMethods(m.keys.validMethods()), jwt.WithIssuedAt(), jwt.WithExpirationRequired())
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	c, ok := parsed.Claims.(*Claims)
+	if !ok || !parsed.Valid || c.ID == "" || c.IssuedAt == nil || c.Purpose != purpose {
+		return nil, model.ErrInvalidToken
+	}
+	claims := &token.Claims{
+		UserID:    c.UserID,
+		TokenID:   c.ID,
+		Role:      model.Role(c.Role),
+		IssuedAt:  c.IssuedAt.Time,
+		ExpiresAt: c.ExpiresAt.Time,
+	}
+	if c.IssuedAtMicros > 0 {
+		claims.IssuedAt = time.UnixMicro(c.IssuedAtMicros)
+	}
+	if claims.Role == "" {
+		claims.Role = model.RoleMember // 役割を導入する前に発行されたトークン
+	}
+
+	if err := m.checkRevocation(ctx, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
 
+// checkRevocation はトークンが個別に失効されていないか、ユーザーの失効基準時刻以前に発行されていないかを確認します。
+func (m *JWTManager) checkRevocation(ctx context.Context, claims *token.Claims) error {
+	revoked, err := m.revocations.IsTokenRevoked(ctx, claims.TokenID)
 	if err != nil {
-		return "", fmt.Errorf("invalid token: %w", err)
+		return fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	if revoked {
+		return model.ErrTokenRevoked
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims.UserID, nil
+	revokedBefore, err := m.revocations.GetUserTokensRevokedBefore(ctx, claims.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to check token revocation: %w", err)
 	}
-	return "", model.ErrInvalidToken
+	// 基準時刻ちょうどに発行されたトークンも無効にする (iat_us のない古いトークンは秒単位の iat で比較するため、
+	// 基準時刻と同じ秒に発行されたものはすべて無効になる)
+	if !claims.IssuedAt.After(revokedBefore) {
+		return model.ErrTokenRevoked
+	}
+	return nil
+}
+
+// Revoke はトークンを個別に失効させます。
+func (m *JWTManager) Revoke(ctx context.Context, claims *token.Claims) error {
+	return m.revocations.RevokeToken(ctx, claims.TokenID, claims.UserID, claims.ExpiresAt)
+}
+
+// RevokeAll は現在までに発行したユーザーのトークンをすべて失効させます。
+func (m *JWTManager) RevokeAll(ctx context.Context, userID string) error {
+	// 基準時刻はマイクロ秒単位で保存し、iat_us と比較する (この直後に発行したトークンは有効になる)
+	return m.revocations.SetUserTokensRevokedBefore(ctx, userID, time.Now().Truncate(time.Microsecond))
 }
@@ -0,0 +1,82 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository/memory"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+)
+
+func newTestManager(t *testing.T) *JWTManager {
+	t.Helper()
+	cfg := &config.Config{JWT: config.JWTConfig{Secret: "test-secret", DurationMinutes: 15}}
+	keys, err := NewKeySet(cfg)
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+	return NewJWTManager(cfg, keys, memory.NewTokenRevocationRepository()).(*JWTManager)
+}
+
+// 失効させた秒の中でも、失効の前に発行したトークンは無効になり、後に発行したトークンは有効なままになる
+func TestRevokeAllWithinSameSecond(t *testing.T) {
+	ctx := context.Background()
+	m := newTestManager(t)
+	user := &model.User{ID: "user-1", Email: "user@example.com", Role: model.RoleMember}
+
+	before, err := m.Generate(user)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if err := m.RevokeAll(ctx, user.ID); err != nil {
+		t.Fatalf("RevokeAll: %v", err)
+	}
+	time.Sleep(time.Millisecond) // 発行日時はマイクロ秒単位のため、失効と同じマイクロ秒に発行しないようにする
+	after, err := m.Generate(user)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	if _, err := m.Verify(ctx, before); !errors.Is(err, model.ErrTokenRevoked) {
+		t.Errorf("Verify(token issued before RevokeAll) err = %v, want ErrTokenRevoked", err)
+	}
+	if _, err := m.Verify(ctx, after); err != nil {
+		t.Errorf("Verify(token issued after RevokeAll) err = %v, want nil", err)
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	ctx := context.Background()
+	m := newTestManager(t)
+	user := &model.User{ID: "user-1", Email: "user@example.com", Role: model.RoleMember}
+
+	revoked, _ := m.Generate(user)
+	other, _ := m.Generate(user)
+	claims, err := m.Verify(ctx, revoked)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if err := m.Revoke(ctx, claims); err != nil {
+		t.Fatalf("Revoke: %v", err)
+	}
+
+	if _, err := m.Verify(ctx, revoked); !errors.Is(err, model.ErrTokenRevoked) {
+		t.Errorf("Verify(revoked token) err = %v, want ErrTokenRevoked", err)
+	}
+	if _, err := m.Verify(ctx, other); err != nil {
+		t.Errorf("Verify(other token) err = %v, want nil", err)
+	}
+}
+
+// 署名鍵も JWT_SECRET も設定されていない場合は KeySet を作成できない (サーバーが起動しない)
+func TestNewKeySetRequiresKey(t *testing.T) {
+	for _, secret := range []string{"", exampleSecret} {
+		cfg := &config.Config{JWT: config.JWTConfig{Secret: secret}}
+		if _, err := NewKeySet(cfg); err == nil {
+			t.Errorf("NewKeySet(secret=%q) err = nil, want error", secret)
+		}
+	}
+}
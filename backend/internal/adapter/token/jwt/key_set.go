@@ -0,0 +1,218 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"sort"
+
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWKSPath は公開鍵の一覧 (JWK Set) を公開するパスです。
+const JWKSPath = "/.well-known/jwks.json"
+
+// exampleSecret は以前の既定値・設定例の JWT_SECRET です。公開されている値のため、署名には使用しません。
+const exampleSecret = "your-secret-key"
+
+// signingKey は kid で識別される鍵です。
+// 公開鍵しか持たない鍵は検証専用で、ローテーション後も一定期間は古いトークンを検証できるように残しておくために使います。
+type signingKey struct {
+	id        string
+	method    jwt.SigningMethod
+	signKey   interface{} // 署名用の鍵 (検証専用の場合は nil)
+	verifyKey interface{} // 検証用の鍵
+}
+
+// KeySet は署名と検証に使う鍵の集合です。
+//
+// JWT_KEYS が設定されている場合は RS256 / EdDSA の鍵を kid で切り替えて使用し、
+// 設定されていない場合は JWT_SECRET による HS256 (kid なし) にフォールバックします。
+// どちらも設定されていない場合 (JWT_SECRET が空か設定例の値の場合) は作成できず、サーバーは起動しません。
+type KeySet struct {
+	keys   map[string]*signingKey
+	active *signingKey
+}
+
+// NewKeySet は設定から KeySet を作成します。
+func NewKeySet(cfg *config.Config) (*KeySet, error) {
+	if len(cfg.JWT.Keys) == 0 {
+		if cfg.JWT.Secret == "" || cfg.JWT.Secret == exampleSecret {
+			return nil, fmt.Errorf("no signing key configured: set JWT_KEYS, or JWT_SECRET to a random value")
+		}
+		secret := &signingKey{
+			method:    jwt.SigningMethodHS256,
+			signKey:   []byte(cfg.JWT.Secret),
+			verifyKey: []byte(cfg.JWT.Secret),
+		}
+		return &KeySet{keys: map[string]*signingKey{"": secret}, active: secret}, nil
+	}
+
+	ks := &KeySet{keys: make(map[string]*signingKey, len(cfg.JWT.Keys))}
+	for id, path := range cfg.JWT.Keys {
+		key, err := loadSigningKey(id, path)
+		if err != nil {
+			return nil, err
+		}
+		ks.keys[id] = key
+	}
+
+	activeID := cfg.JWT.ActiveKeyID
+	if activeID == "" && len(ks.keys) == 1 {
+		for id := range ks.keys {
+			activeID = id
+		}
+	}
+	active, ok := ks.keys[activeID]
+	if !ok {
+		return nil, fmt.Errorf("active signing key %q not found in JWT_KEYS", activeID)
+	}
+	if active.signKey == nil {
+		return nil, fmt.Errorf("active signing key %q has no private key", activeID)
+	}
+	ks.active = active
+	return ks, nil
+}
+
+// loadSigningKey は PEM 形式の鍵ファイルを読み込みます。
+func loadSigningKey(id, path string) (*signingKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key %q: %w", id, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM for signing key %q", id)
+	}
+
+	var parsed interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q for signing key %q", block.Type, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key %q: %w", id, err)
+	}
+
+	key := &signingKey{id: id}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.method, key.signKey, key.verifyKey = jwt.SigningMethodRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.method, key.verifyKey = jwt.SigningMethodRS256, k
+	case ed25519.PrivateKey:
+		key.method, key.signKey, key.verifyKey = jwt.SigningMethodEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.method, key.verifyKey = jwt.SigningMethodEdDSA, k
+	default:
+		return nil, fmt.Errorf("unsupported key type %T for signing key %q (RSA or Ed25519 required)", parsed, id)
+	}
+	return key, nil
+}
+
+// sign はアクティブな鍵でクレームに署名します。
+func (ks *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.active.method, claims)
+	if ks.active.id != "" {
+		token.Header["kid"] = ks.active.id
+	}
+	return token.SignedString(ks.active.signKey)
+}
+
+// keyFunc はトークンヘッダーの kid と alg に対応する検証用の鍵を返します。
+func (ks *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key: %q", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return key.verifyKey, nil
+}
+
+// validMethods は検証時に受け付ける署名アルゴリズムの一覧を返します。
+func (ks *KeySet) validMethods() []string {
+	seen := make(map[string]struct{})
+	var methods []string
+	for _, key := range ks.keys {
+		if _, ok := seen[key.method.Alg()]; !ok {
+			seen[key.method.Alg()] = struct{}{}
+			methods = append(methods, key.method.Alg())
+		}
+	}
+	return methods
+}
+
+// jwk は JSON Web Key (RFC 7517) の公開鍵を表します。
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`   // RSA
+	E   string `json:"e,omitempty"`   // RSA
+	Crv string `json:"crv,omitempty"` // OKP
+	X   string `json:"x,omitempty"`   // OKP
+}
+
+// JWKS は公開鍵の一覧を JWK Set として返します。HS256 の共通鍵は含めません。
+func (ks *KeySet) JWKS() ([]byte, error) {
+	keys := make([]jwk, 0, len(ks.keys))
+	for _, key := range ks.keys {
+		k := jwk{Kid: key.id, Use: "sig", Alg: key.method.Alg()}
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			k.Kty = "RSA"
+			k.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			k.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			k.Kty = "OKP"
+			k.Crv = "Ed25519"
+			k.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Kid < keys[j].Kid })
+
+	return json.Marshal(struct {
+		Keys []jwk `json:"keys"`
+	}{Keys: keys})
+}
+
+// ServeHTTP は JWKSPath で公開鍵の一覧を返します。
+func (ks *KeySet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ks.JWKS()
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300") // ローテーション時は新しい鍵を先に追加しておく
+	_, _ = w.Write(body)
+}
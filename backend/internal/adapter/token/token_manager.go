@@ -1,9 +1,29 @@
 package token
 
-import "github.com/a-s/connect-task-manage/internal/domain/model"
+import (
+	"context"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
 
 // TokenManager はトークンの生成と検証を抽象化するインターフェースです。
 type TokenManager interface {
 	Generate(user *model.User) (string, error)
-	Verify(token string) (string, error) // (userID, error) を返す
+	Verify(ctx context.Context, token string) (*Claims, error) // 失効済みのトークンは model.ErrTokenRevoked
+	Revoke(ctx context.Context, claims *Claims) error          // トークンを個別に失効させる
+	RevokeAll(ctx context.Context, userID string) error        // これまでに発行したユーザーのトークンをすべて失効させる
+
+	// 二要素認証の確認待ちを表す短期間のトークン (アクセストークンとしては使用できない)
+	GenerateChallenge(user *model.User) (string, error)
+	VerifyChallenge(ctx context.Context, token string) (*Claims, error) // 無効・期限切れ・使用済みのトークンは model.ErrInvalidChallengeToken
+}
+
+// Claims は検証済みのトークンから取り出した情報です。
+type Claims struct {
+	UserID    string
+	TokenID   string // jti
+	Role      model.Role
+	IssuedAt  time.Time
+	ExpiresAt time.Time
 }
@@ -0,0 +1,174 @@
+package model
+
+import "strings"
+
+// ボードの並び順のキー
+//
+// ボードの列の中のタスクの並び順は、辞書順で比較する文字列のキー (Task.Rank) で表します。
+// キーは 0-9a-z の 36 進数の小数 (先頭に "0." を補った値) と見なし、2 つのキーの間には常に別のキーを作れるため、
+// タスクを移動するときは移動するタスクのキーだけを変更すれば済みます。
+// 末尾の "0" は値を変えないため、キーの末尾は "0" 以外にします (同じ値の異なるキーを作らないため)。
+// 間への挿入を繰り返してキーが MaxRankLength より長くなる場合は、列のキーを SpreadRanks で振り直します。
+const (
+	rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	rankBase   = int64(len(rankDigits))
+
+	// MaxRankLength はキーの最大の長さです。これより長いキーが必要な場合は列のキーを振り直します。
+	MaxRankLength = 24
+
+	rankWidth = 8                    // 振り直したキーと、末尾・先頭への追加で使う桁数
+	rankSpace = int64(2821109907456) // 36^rankWidth
+	rankStep  = int64(1679616)       // 末尾・先頭に追加するときのキーの間隔 (36^4)
+)
+
+// RankBetween は prev と next の間に並ぶキーを返します。prev が空の場合は next の前 (列の先頭)、
+// next が空の場合は prev の後ろ (列の末尾) のキーです。
+// prev が next 以上の場合や、キーが MaxRankLength より長くなる場合は false を返します (列のキーを振り直す必要があります)。
+func RankBetween(prev, next string) (string, bool) {
+	var rank string
+	switch {
+	case prev == "" && next == "":
+		rank = encodeRank(rankSpace / 2)
+	case prev == "":
+		rank = rankBefore(next)
+	case next == "":
+		rank = rankAfter(prev)
+	default:
+		if prev >= next {
+			return "", false
+		}
+		rank = rankMidpoint(prev, next)
+	}
+	if len(rank) > MaxRankLength {
+		return "", false
+	}
+	return rank, true
+}
+
+// SpreadRanks は n 個のタスクに振り直すキーを昇順で返します。
+// キーは rankWidth 桁の範囲の中央に等間隔で並べ、前後にタスクを追加する余地を残します。
+func SpreadRanks(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	step := rankStep
+	if limit := rankSpace / int64(n+1); limit < step {
+		step = limit
+	}
+	start := (rankSpace - step*int64(n-1)) / 2
+
+	ranks := make([]string, n)
+	for i := range ranks {
+		ranks[i] = encodeRank(start + step*int64(i))
+	}
+	return ranks
+}
+
+// rankAfter は rank より後ろのキーを返します。上位 rankWidth 桁に rankStep を加えられる場合はその値にし、
+// 加えられない場合は rank と末尾 (1.0) の中間にします。
+func rankAfter(rank string) string {
+	if v := decodeRank(rank) + rankStep; v < rankSpace {
+		return encodeRank(v)
+	}
+	return rankMidpoint(rank, "")
+}
+
+// rankBefore は rank より前のキーを返します。上位 rankWidth 桁から rankStep を引ける場合はその値にし、
+// 引けない場合は先頭 (0.0) と rank の中間にします。
+func rankBefore(rank string) string {
+	if v := decodeRank(rank) - rankStep; v > 0 {
+		return encodeRank(v)
+	}
+	return rankMidpoint("", rank)
+}
+
+// rankMidpoint は a < b を満たす a と b の中間のキーを返します。b が空の場合は 1.0 として扱います。
+func rankMidpoint(a, b string) string {
+	if b != "" {
+		// 共通の先頭部分はそのまま使う (a の足りない桁は "0" と見なす)
+		n := 0
+		for n < len(b) && rankDigitAt(a, n) == rankDigitAt(b, n) {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + rankMidpoint(trimRank(a, n), b[n:])
+		}
+	}
+
+	digitA := rankDigitAt(a, 0)
+	digitB := rankBase
+	if b != "" {
+		digitB = rankDigitAt(b, 0)
+	}
+	if digitB-digitA > 1 {
+		return string(rankDigits[(digitA+digitB+1)/2])
+	}
+	// 先頭の桁が隣り合っている場合
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(rankDigits[digitA]) + rankMidpoint(trimRank(a, 1), "")
+}
+
+// rankDigitAt はキーの i 桁目の値を返します (桁が足りない場合は 0)。
+func rankDigitAt(rank string, i int) int64 {
+	if i >= len(rank) {
+		return 0
+	}
+	return int64(strings.IndexByte(rankDigits, rank[i]))
+}
+
+// trimRank はキーの先頭 n 桁を取り除きます。
+func trimRank(rank string, n int) string {
+	if n >= len(rank) {
+		return ""
+	}
+	return rank[n:]
+}
+
+// decodeRank はキーの上位 rankWidth 桁を整数に変換します。
+func decodeRank(rank string) int64 {
+	var v int64
+	for i := 0; i < rankWidth; i++ {
+		v = v*rankBase + rankDigitAt(rank, i)
+	}
+	return v
+}
+
+// encodeRank は整数を rankWidth 桁のキーに変換し、末尾の "0" を取り除きます。
+func encodeRank(v int64) string {
+	var b [rankWidth]byte
+	for i := rankWidth - 1; i >= 0; i-- {
+		b[i] = rankDigits[v%rankBase]
+		v /= rankBase
+	}
+	return strings.TrimRight(string(b[:]), "0")
+}
+
+// BoardColumn はボードの列 (並び順を共有するタスクの集まり) です。
+// プロジェクトのタスクはプロジェクトと状態ごと、プロジェクトなしのタスクはワークスペース
+// (個人のタスクの場合は作成者) と状態ごとに 1 つの列になります。
+type BoardColumn struct {
+	Status      TaskStatus
+	ProjectID   *string
+	WorkspaceID *string
+	OwnerID     *string // プロジェクトなしの個人のタスクの場合のみ、タスクの作成者
+}
+
+// BoardColumn はタスクが並ぶボードの列を返します。
+func (t *Task) BoardColumn() BoardColumn {
+	column := BoardColumn{Status: t.Status, ProjectID: t.ProjectID, WorkspaceID: t.WorkspaceID}
+	if t.ProjectID == nil && t.WorkspaceID == nil {
+		ownerID := t.UserID
+		column.OwnerID = &ownerID
+	}
+	return column
+}
+
+// Equal は 2 つの列が同じ列かどうかを返します。
+func (c BoardColumn) Equal(other BoardColumn) bool {
+	return c.Status == other.Status &&
+		equalStringPtr(c.ProjectID, other.ProjectID) &&
+		equalStringPtr(c.WorkspaceID, other.WorkspaceID) &&
+		equalStringPtr(c.OwnerID, other.OwnerID)
+}
@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+// EmailVerificationToken はメールアドレスの確認用の一度だけ使用できるトークンを表します。
+// トークン本体は保存せず、SHA-256 ハッシュのみを保持します。
+type EmailVerificationToken struct {
+	ID        string
+	UserID    string
+	Email     string // 確認するメールアドレス
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// NewEmailVerificationToken は新しい EmailVerificationToken エンティティと、メールで送るトークン本体を作成します。
+func NewEmailVerificationToken(id string, user *User, ttl time.Duration) (*EmailVerificationToken, string, error) {
+	rawToken, err := generateTokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &EmailVerificationToken{
+		ID:        id,
+		UserID:    user.ID,
+		Email:     user.Email,
+		TokenHash: HashEmailVerificationToken(rawToken),
+		ExpiresAt: time.Now().Add(ttl),
+	}, rawToken, nil
+}
+
+// HashEmailVerificationToken はトークン本体から保存用のハッシュを計算します。
+func HashEmailVerificationToken(rawToken string) string {
+	return hashTokenSecret(rawToken)
+}
+
+// IsUsableFor はトークンが未使用かつ有効期限内で、ユーザーの現在のメールアドレスに対して発行されたものかどうかを返します。
+func (t *EmailVerificationToken) IsUsableFor(user *User, now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt) && t.UserID == user.ID && t.Email == user.Email
+}
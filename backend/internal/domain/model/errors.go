@@ -7,5 +7,114 @@ var (
 	ErrUserAlreadyExists = errors.New("user already exists")
 	ErrAuthentication    = errors.New("authentication failed")
 	ErrInvalidToken      = errors.New("invalid token")
+	ErrTokenRevoked      = errors.New("token has been revoked")
 	ErrUnauthorized      = errors.New("unauthorized")
+	ErrPermissionDenied  = errors.New("permission denied")
+	ErrInvalidRole       = errors.New("invalid role")
+
+	// リフレッシュトークン関連
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token was already used") // ローテーション済みのトークンが再度使用された
+
+	// パーソナルアクセストークン関連
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+	ErrInvalidPersonalAccessToken  = errors.New("invalid personal access token") // 存在しない・期限切れ・失効済み
+	ErrInsufficientScope           = errors.New("insufficient token scope")
+	ErrInvalidTokenScope           = errors.New("invalid token scope")
+	ErrInvalidTokenExpiry          = errors.New("invalid token expiry")
+	ErrTokenNameRequired           = errors.New("token name is required")
+
+	// パスワード再設定関連
+	ErrInvalidPasswordResetToken = errors.New("invalid or expired password reset token") // 存在しない・期限切れ・使用済み
+	ErrPasswordRequired          = errors.New("password is required")
+
+	// メールアドレスの確認関連
+	ErrInvalidEmail                  = errors.New("invalid email address")
+	ErrInvalidEmailVerificationToken = errors.New("invalid or expired email verification token") // 存在しない・期限切れ・使用済み・メールアドレスが変更された
+	ErrEmailNotVerified              = errors.New("email address is not verified")
+	ErrAssigneeEmailNotVerified      = errors.New("assignee's email address is not verified")
+
+	// 二要素認証関連
+	ErrTOTPAlreadyEnabled    = errors.New("two-factor authentication is already enabled")
+	ErrTOTPNotEnabled        = errors.New("two-factor authentication is not enabled")
+	ErrInvalidTOTPCode       = errors.New("invalid authentication code") // 誤り・使用済みの認証コードまたはリカバリーコード
+	ErrInvalidChallengeToken = errors.New("invalid or expired second factor challenge")
+
+	// ログインの総当たり攻撃への対策関連
+	ErrTooManyLoginAttempts = errors.New("too many failed login attempts") // 詳細は LoginLockedError
+
+	// シングルサインオン関連
+	ErrSSONotConfigured     = errors.New("single sign-on is not configured")
+	ErrInvalidSSOState      = errors.New("invalid or expired single sign-on state") // 存在しない・期限切れ・使用済み
+	ErrSSOAuthentication    = errors.New("single sign-on authentication failed")    // 認可コードの交換・ID トークンの検証に失敗した
+	ErrSSOEmailNotVerified  = errors.New("email address is not verified by the identity provider")
+	ErrSSOSignupDisabled    = errors.New("sign-up via single sign-on is disabled")
+	ErrUserIdentityNotFound = errors.New("user identity not found")
+
+	// ユーザーの管理関連
+	ErrUserDisabled            = errors.New("user account is disabled")
+	ErrCannotManageSelf        = errors.New("administrators cannot disable or delete their own account")
+	ErrInvalidTaskDisposition  = errors.New("invalid task disposition")
+	ErrInvalidTaskSuccessor    = errors.New("invalid task successor") // 引き継ぎ先が存在しない・削除するユーザー自身・無効
+	ErrTaskSuccessorNotAllowed = errors.New("task successor can only be specified when reassigning tasks")
+
+	// ユーザーの検索関連
+	ErrInvalidSearchQuery = errors.New("invalid search query")
+	ErrRateLimited        = errors.New("rate limit exceeded") // 詳細は RateLimitedError
+
+	// プロジェクト関連
+	ErrProjectNotFound               = errors.New("project not found")
+	ErrInvalidProjectName            = errors.New("invalid project name")
+	ErrInvalidProjectField           = errors.New("invalid project field")
+	ErrProjectArchived               = errors.New("project is archived")
+	ErrInvalidProjectTaskDisposition = errors.New("invalid project task disposition")
+	ErrInvalidTargetProject          = errors.New("invalid target project") // 移動先が存在しない・所有していない・削除するプロジェクト自身・アーカイブ済み
+	ErrTargetProjectNotAllowed       = errors.New("target project can only be specified when moving tasks")
+
+	// ワークスペース関連
+	ErrWorkspaceNotFound          = errors.New("workspace not found") // メンバーでないワークスペースも存在を明かさないためこのエラーになる
+	ErrInvalidWorkspaceName       = errors.New("invalid workspace name")
+	ErrInvalidWorkspaceRole       = errors.New("invalid workspace role")
+	ErrWorkspaceMemberNotFound    = errors.New("workspace member not found")
+	ErrAlreadyWorkspaceMember     = errors.New("user is already a member of the workspace")
+	ErrLastWorkspaceOwner         = errors.New("workspace must have at least one owner")
+	ErrInvitationNotFound         = errors.New("workspace invitation not found")
+	ErrInvitationNotPending       = errors.New("workspace invitation has already been answered, revoked or expired")
+	ErrAssigneeNotWorkspaceMember = errors.New("assignee is not a member of the workspace who can edit tasks")
+
+	// タスク関連
+	ErrTaskNotFound       = errors.New("task not found")
+	ErrInvalidPriority    = errors.New("invalid priority")
+	ErrInvalidTaskField   = errors.New("invalid task field")
+	ErrImmutableTaskField = errors.New("task field is immutable")
+
+	// タスクの状態とワークフロー関連
+	ErrInvalidTaskStatus       = errors.New("invalid task status") // ワークフローに定義されていない状態
+	ErrInvalidStatusTransition = errors.New("task status transition is not allowed by the workflow")
+	ErrInvalidWorkflow         = errors.New("invalid workflow")
+	ErrWorkflowStatusInUse     = errors.New("workflow status is still used by tasks")
+
+	// サブタスク関連
+	ErrInvalidParentTask         = errors.New("invalid parent task") // 存在しない・閲覧できない・所有者 (ワークスペースまたは作成者) が異なるタスク
+	ErrTaskCycle                 = errors.New("task hierarchy cannot contain a cycle")
+	ErrTaskDepthExceeded         = errors.New("task hierarchy is too deep")
+	ErrTaskHasSubtasks           = errors.New("task has subtasks; choose cascade or reparent")
+	ErrInvalidSubtaskDisposition = errors.New("invalid subtask disposition")
+
+	// ボード関連
+	ErrInvalidMoveTarget = errors.New("invalid move target") // 移動の基準のタスクが自分自身や別の列のタスク
+
+	// 楽観的排他制御関連
+	ErrTaskVersionMismatch = errors.New("task version mismatch")          // クライアントが指定したバージョンが古い
+	ErrTaskConflict        = errors.New("task was modified concurrently") // 読み込みから書き込みの間に他の更新があった
+
+	// 変更イベント関連
+	ErrInvalidTaskEventID = errors.New("invalid task event id")
+	ErrTaskEventsExpired  = errors.New("task events are no longer available; resync with ListTasks")
+
+	// 一覧取得関連
+	ErrInvalidPageSize  = errors.New("invalid page size")
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidSortKey   = errors.New("invalid sort key")
+	ErrInvalidTaskScope = errors.New("invalid task scope")
 )
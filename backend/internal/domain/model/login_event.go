@@ -0,0 +1,51 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// LoginEventResult はログインの試行結果です。
+type LoginEventResult string
+
+const (
+	LoginEventSuccess             LoginEventResult = "success"
+	LoginEventInvalidPassword     LoginEventResult = "invalid_password"
+	LoginEventInvalidSecondFactor LoginEventResult = "invalid_second_factor"
+	LoginEventLockedOut           LoginEventResult = "locked_out" // ロック中のためパスワードを確認せずに拒否した
+	LoginEventDisabled            LoginEventResult = "disabled"   // 管理者が無効にしたユーザーのため拒否した
+)
+
+// ClientInfo はリクエスト元のクライアントの情報です。
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+// LoginEvent はユーザーが最近のログインを確認するための、ログインの試行の記録を表します。
+type LoginEvent struct {
+	ID        string
+	UserID    string
+	Result    LoginEventResult
+	IPAddress string
+	UserAgent string
+	CreatedAt time.Time
+}
+
+// userAgentMaxLength は記録するユーザーエージェントの最大の長さです。
+const userAgentMaxLength = 255
+
+// NewLoginEvent は新しい LoginEvent エンティティを作成します。
+func NewLoginEvent(id, userID string, result LoginEventResult, client ClientInfo) *LoginEvent {
+	userAgent := client.UserAgent
+	if len(userAgent) > userAgentMaxLength {
+		userAgent = strings.ToValidUTF8(userAgent[:userAgentMaxLength], "") // 途中で切れたマルチバイト文字を取り除く
+	}
+	return &LoginEvent{
+		ID:        id,
+		UserID:    userID,
+		Result:    result,
+		IPAddress: client.IPAddress,
+		UserAgent: userAgent,
+	}
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LoginThrottlePolicy はログインの失敗回数に応じたロックの方針です。
+// 失敗回数がしきい値に達するとロックし、以後の失敗ごとにロック時間を倍にします。
+type LoginThrottlePolicy struct {
+	MaxFailures int           // ロックするまでに許容する失敗回数
+	BaseLockout time.Duration // しきい値に達したときのロック時間
+	MaxLockout  time.Duration // ロック時間の上限
+}
+
+// LockoutDuration は失敗回数に対するロック時間を返します。しきい値に達していない場合は 0 を返します。
+func (p LoginThrottlePolicy) LockoutDuration(failures int) time.Duration {
+	if p.MaxFailures <= 0 || failures < p.MaxFailures {
+		return 0
+	}
+
+	lockout := p.BaseLockout
+	for i := p.MaxFailures; i < failures && lockout < p.MaxLockout; i++ {
+		lockout *= 2
+	}
+	return min(lockout, p.MaxLockout)
+}
+
+// AccountThrottleKey はアカウントごとの失敗回数を記録するキーを返します。
+// 登録されていないメールアドレスも同じように扱い、登録の有無を推測されないようにします。
+func AccountThrottleKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// IPThrottleKey はクライアント IP ごとの失敗回数を記録するキーを返します。
+func IPThrottleKey(ipAddress string) string {
+	return "ip:" + ipAddress
+}
+
+// LoginLockedError は失敗が続いたためにログインが一時的にロックされている場合のエラーです。
+// errors.Is(err, ErrTooManyLoginAttempts) で判定できます。
+type LoginLockedError struct {
+	RetryAfter time.Duration // ロックが解除されるまでの時間
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrTooManyLoginAttempts, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrTooManyLoginAttempts
+}
@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// PasswordResetToken はパスワード再設定用の一度だけ使用できるトークンを表します。
+// トークン本体は保存せず、SHA-256 ハッシュのみを保持します。
+type PasswordResetToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// NewPasswordResetToken は新しい PasswordResetToken エンティティと、メールで送るトークン本体を作成します。
+func NewPasswordResetToken(id, userID string, ttl time.Duration) (*PasswordResetToken, string, error) {
+	rawToken, err := generateTokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &PasswordResetToken{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: HashPasswordResetToken(rawToken),
+		ExpiresAt: time.Now().Add(ttl),
+	}, rawToken, nil
+}
+
+// HashPasswordResetToken はトークン本体から保存用のハッシュを計算します。
+func HashPasswordResetToken(rawToken string) string {
+	return hashTokenSecret(rawToken)
+}
+
+// IsUsable はトークンが未使用かつ有効期限内かどうかを返します。
+func (t *PasswordResetToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
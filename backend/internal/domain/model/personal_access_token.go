@@ -0,0 +1,113 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// PersonalAccessTokenPrefix はパーソナルアクセストークンの先頭に付ける文字列です。
+// JWT と区別するため、またシークレットスキャナーで検出しやすくするために使います。
+const PersonalAccessTokenPrefix = "ctm_pat_"
+
+// personalAccessTokenDisplayLength は一覧で表示するトークンの先頭部分の長さです。
+const personalAccessTokenDisplayLength = len(PersonalAccessTokenPrefix) + 4
+
+// Scope はパーソナルアクセストークンに許可する操作の範囲です。
+type Scope string
+
+const (
+	ScopeTasksRead  Scope = "tasks:read"  // タスクの取得・一覧・変更イベントの購読
+	ScopeTasksWrite Scope = "tasks:write" // タスクの作成・更新・削除
+	ScopeUserRead   Scope = "user:read"   // 自分のユーザー情報の取得
+
+	ScopeProjectsRead  Scope = "projects:read"  // プロジェクトの取得・一覧
+	ScopeProjectsWrite Scope = "projects:write" // プロジェクトの作成・更新・アーカイブ・削除
+
+	ScopeWorkspacesRead  Scope = "workspaces:read"  // ワークスペース・メンバー・招待の取得・一覧
+	ScopeWorkspacesWrite Scope = "workspaces:write" // ワークスペースの作成・変更・削除、メンバーの管理、招待の作成・回答
+)
+
+// validScopes は指定可能なスコープの一覧です。
+var validScopes = []Scope{ScopeTasksRead, ScopeTasksWrite, ScopeUserRead, ScopeProjectsRead, ScopeProjectsWrite, ScopeWorkspacesRead, ScopeWorkspacesWrite}
+
+// ParseScopes は文字列のスコープ一覧を検証して Scope に変換します (重複は取り除きます)。
+func ParseScopes(values []string) ([]Scope, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", ErrInvalidTokenScope)
+	}
+
+	scopes := make([]Scope, 0, len(values))
+	for _, v := range values {
+		scope := Scope(v)
+		if !slices.Contains(validScopes, scope) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTokenScope, v)
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+// PersonalAccessToken はスクリプトや CI から API を呼び出すための長期間有効なトークンを表します。
+// トークン本体は保存せず、SHA-256 ハッシュと表示用の先頭部分のみを保持します。
+type PersonalAccessToken struct {
+	ID          string
+	UserID      string
+	Name        string
+	TokenPrefix string // 一覧で識別するためのトークンの先頭部分
+	TokenHash   string
+	Scopes      []Scope
+	ExpiresAt   time.Time
+	RevokedAt   *time.Time
+	CreatedAt   time.Time
+}
+
+// NewPersonalAccessToken は新しい PersonalAccessToken エンティティと、クライアントに返すトークン本体を作成します。
+func NewPersonalAccessToken(id, userID, name string, scopes []Scope, expiresAt time.Time) (*PersonalAccessToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", ErrTokenNameRequired
+	}
+	if !expiresAt.After(time.Now()) {
+		return nil, "", fmt.Errorf("%w: must be in the future", ErrInvalidTokenExpiry)
+	}
+
+	secret, err := generateTokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	rawToken := PersonalAccessTokenPrefix + secret
+
+	return &PersonalAccessToken{
+		ID:          id,
+		UserID:      userID,
+		Name:        name,
+		TokenPrefix: rawToken[:personalAccessTokenDisplayLength],
+		TokenHash:   HashPersonalAccessToken(rawToken),
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+	}, rawToken, nil
+}
+
+// IsPersonalAccessToken はトークン文字列がパーソナルアクセストークンの形式かどうかを返します。
+func IsPersonalAccessToken(rawToken string) bool {
+	return strings.HasPrefix(rawToken, PersonalAccessTokenPrefix)
+}
+
+// HashPersonalAccessToken はトークン本体から保存用のハッシュを計算します。
+func HashPersonalAccessToken(rawToken string) string {
+	return hashTokenSecret(rawToken)
+}
+
+// IsActive はトークンが失効しておらず、有効期限内かどうかを返します。
+func (t *PersonalAccessToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+// HasScope はトークンに指定したスコープが許可されているかどうかを返します。
+func (t *PersonalAccessToken) HasScope(scope Scope) bool {
+	return slices.Contains(t.Scopes, scope)
+}
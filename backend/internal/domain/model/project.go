@@ -0,0 +1,152 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// maxProjectNameLength はプロジェクト名の最大の長さ (文字数) です。
+const maxProjectNameLength = 255
+
+// Project はタスクをまとめるプロジェクトです。作成したユーザー (所有者) のみが閲覧・変更できます。
+type Project struct {
+	ID          string
+	OwnerID     string
+	Name        string
+	Description string
+	ArchivedAt  *time.Time // アーカイブした日時 (アーカイブしていない場合は nil)
+	Workflow    *Workflow  // プロジェクトのタスクが従うワークフロー (nil の場合は既定のワークフロー)
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewProject は新しい Project エンティティを作成します。
+func NewProject(ownerID, name, description string) (*Project, error) {
+	name = strings.TrimSpace(name)
+	if err := validateProjectName(name); err != nil {
+		return nil, err
+	}
+	return &Project{
+		ID:          uuid.NewString(),
+		OwnerID:     ownerID,
+		Name:        name,
+		Description: description,
+	}, nil
+}
+
+// validateProjectName はプロジェクト名が空でなく、長すぎないことを確認します。
+func validateProjectName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidProjectName)
+	}
+	if utf8.RuneCountInString(name) > maxProjectNameLength {
+		return fmt.Errorf("%w: name must be at most %d characters", ErrInvalidProjectName, maxProjectNameLength)
+	}
+	return nil
+}
+
+// IsOwnedBy は指定したユーザーがプロジェクトの所有者かどうかを返します。
+func (p *Project) IsOwnedBy(userID string) bool {
+	return p.OwnerID == userID
+}
+
+// IsArchived はプロジェクトがアーカイブされているかどうかを返します。
+func (p *Project) IsArchived() bool {
+	return p.ArchivedAt != nil
+}
+
+// EffectiveWorkflow はプロジェクトのタスクが従うワークフローを返します。
+func (p *Project) EffectiveWorkflow() *Workflow {
+	if p.Workflow == nil {
+		return DefaultWorkflow()
+	}
+	return p.Workflow
+}
+
+// ProjectField はプロジェクトの更新対象となるフィールドを表す型
+type ProjectField string
+
+// 更新対象フィールドの定数
+const (
+	ProjectFieldName        ProjectField = "name"
+	ProjectFieldDescription ProjectField = "description"
+)
+
+// MutableProjectFields は更新可能なフィールドの一覧 (アーカイブは ArchiveProject / UnarchiveProject で行う)
+var MutableProjectFields = []ProjectField{
+	ProjectFieldName,
+	ProjectFieldDescription,
+}
+
+// ParseProjectFields はフィールドマスクのパスを ProjectField に変換します。
+func ParseProjectFields(paths []string) ([]ProjectField, error) {
+	fields := make([]ProjectField, 0, len(paths))
+	for _, path := range paths {
+		field := ProjectField(path)
+		if !slices.Contains(MutableProjectFields, field) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidProjectField, path)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// ProjectPatch はプロジェクトの部分更新を表します。Fields に含まれるフィールドだけが適用されます。
+type ProjectPatch struct {
+	Fields      []ProjectField
+	Name        string
+	Description string
+}
+
+// Apply は patch.Fields に含まれるフィールドだけを更新します。
+func (p *Project) Apply(patch *ProjectPatch) error {
+	for _, field := range patch.Fields {
+		switch field {
+		case ProjectFieldName:
+			name := strings.TrimSpace(patch.Name)
+			if err := validateProjectName(name); err != nil {
+				return err
+			}
+			p.Name = name
+		case ProjectFieldDescription:
+			p.Description = patch.Description
+		default:
+			return fmt.Errorf("%w: %s", ErrInvalidProjectField, field)
+		}
+	}
+	return nil
+}
+
+// ProjectCursor はプロジェクト一覧のキーセットページネーションの位置を表します (作成日時の降順)。
+type ProjectCursor struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewProjectCursor はプロジェクトの位置を表すカーソルを作成します。
+func NewProjectCursor(project *Project) *ProjectCursor {
+	return &ProjectCursor{ID: project.ID, CreatedAt: project.CreatedAt}
+}
+
+// ProjectListQuery はプロジェクト一覧取得の条件を表します。
+type ProjectListQuery struct {
+	OwnerID         string
+	IncludeArchived bool
+	Limit           int
+	After           *ProjectCursor // nil の場合は先頭から取得する
+}
+
+// ProjectTaskDisposition はプロジェクトを削除するときに、そのプロジェクトのタスクをどう扱うかを表す型
+type ProjectTaskDisposition string
+
+// タスクの扱いの定数
+const (
+	ProjectTaskDispositionDetach ProjectTaskDisposition = "detach" // タスクは残し、プロジェクトなしにする
+	ProjectTaskDispositionMove   ProjectTaskDisposition = "move"   // タスクを別のプロジェクトに移す
+	ProjectTaskDispositionDelete ProjectTaskDisposition = "delete" // タスクも削除する
+)
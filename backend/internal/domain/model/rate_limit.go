@@ -0,0 +1,20 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// RateLimitedError は呼び出し回数の上限に達した場合のエラーです。
+// errors.Is(err, ErrRateLimited) で判定できます。
+type RateLimitedError struct {
+	RetryAfter time.Duration // 次に呼び出せるようになるまでの時間
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrRateLimited, e.RetryAfter.Round(time.Second))
+}
+
+func (e *RateLimitedError) Unwrap() error {
+	return ErrRateLimited
+}
@@ -0,0 +1,70 @@
+package model
+
+import "time"
+
+// RefreshToken はアクセストークンの再発行に使うリフレッシュトークンを表します。
+// トークン本体は保存せず、SHA-256 ハッシュのみを保持します。
+type RefreshToken struct {
+	ID        string
+	UserID    string
+	FamilyID  string // ログインごとに発行され、ローテーション後も引き継がれる
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time // ローテーション済みの場合に設定される
+	RevokedAt *time.Time // ログアウトまたは再利用の検知で失効した場合に設定される
+	CreatedAt time.Time
+}
+
+// TokenPair はログインやトークンの再発行で返すアクセストークンとリフレッシュトークンの組です。
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// LoginResult はログインの結果です。
+// 二要素認証が有効なユーザーの場合は Tokens の代わりに ChallengeToken を設定し、認証コードと交換させます。
+type LoginResult struct {
+	Tokens         *TokenPair
+	ChallengeToken string
+}
+
+// RequiresSecondFactor は二要素認証のコードの入力が必要かどうかを返します。
+func (r *LoginResult) RequiresSecondFactor() bool {
+	return r.ChallengeToken != ""
+}
+
+// NewRefreshToken は新しい RefreshToken エンティティと、クライアントに返すトークン本体を作成します。
+func NewRefreshToken(id, userID, familyID string, ttl time.Duration) (*RefreshToken, string, error) {
+	rawToken, err := generateTokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &RefreshToken{
+		ID:        id,
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: HashRefreshToken(rawToken),
+		ExpiresAt: time.Now().Add(ttl),
+	}, rawToken, nil
+}
+
+// HashRefreshToken はトークン本体から保存用のハッシュを計算します。
+func HashRefreshToken(rawToken string) string {
+	return hashTokenSecret(rawToken)
+}
+
+// IsExpired はトークンが有効期限切れかどうかを返します。
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// IsUsed はトークンがローテーション済みかどうかを返します。
+func (t *RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsRevoked はトークンが失効済みかどうかを返します。
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
@@ -0,0 +1,32 @@
+package model
+
+import "fmt"
+
+// Role はユーザーの役割です。上位の役割は下位の役割の権限をすべて持ちます。
+type Role string
+
+const (
+	RoleMember Role = "member" // 一般のユーザー
+	RoleAdmin  Role = "admin"  // ユーザーの管理などを行う管理者
+)
+
+// roleRanks は役割の上下関係です (大きいほど上位)。
+var roleRanks = map[Role]int{
+	RoleMember: 1,
+	RoleAdmin:  2,
+}
+
+// ParseRole は文字列の役割を検証して Role に変換します。
+func ParseRole(value string) (Role, error) {
+	role := Role(value)
+	if _, ok := roleRanks[role]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRole, value)
+	}
+	return role, nil
+}
+
+// Includes は役割が required の権限を持つかどうかを返します。
+func (r Role) Includes(required Role) bool {
+	rank, ok := roleRanks[r]
+	return ok && rank >= roleRanks[required]
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"time"
+)
+
+// ExternalIdentity は ID プロバイダーが検証済みの ID トークンで示したユーザーの情報です。
+type ExternalIdentity struct {
+	Issuer        string
+	Subject       string // ID プロバイダーでのユーザーの識別子 (sub)
+	Email         string
+	EmailVerified bool // ID プロバイダーがメールアドレスを確認済みかどうか
+	Name          string
+}
+
+// DisplayName はユーザーを作成するときの名前を返します。名前がない場合はメールアドレスの @ より前を使います。
+func (i *ExternalIdentity) DisplayName() string {
+	if name := strings.TrimSpace(i.Name); name != "" {
+		return name
+	}
+	local, _, _ := strings.Cut(i.Email, "@")
+	return local
+}
+
+// UserIdentity はユーザーと ID プロバイダーのアカウントの紐付けを表します。
+type UserIdentity struct {
+	ID        string
+	UserID    string
+	Issuer    string
+	Subject   string
+	CreatedAt time.Time
+}
+
+// OIDCAuthRequest は ID プロバイダーへの認可リクエストを、コールバックで検証するために保持します。
+// state は保存せず、SHA-256 ハッシュのみを保持します。
+type OIDCAuthRequest struct {
+	StateHash    string
+	Nonce        string // ID トークンに含まれることを確認し、トークンの使い回しを防ぐ
+	CodeVerifier string // PKCE のコード検証子
+	ExpiresAt    time.Time
+}
+
+// NewOIDCAuthRequest は新しい OIDCAuthRequest と、認可リクエストに付ける state を作成します。
+func NewOIDCAuthRequest(ttl time.Duration) (*OIDCAuthRequest, string, error) {
+	state, err := generateTokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	nonce, err := generateTokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	verifier, err := generateTokenSecret() // 43 文字 (RFC 7636 の下限)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &OIDCAuthRequest{
+		StateHash:    HashOIDCState(state),
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    time.Now().Add(ttl),
+	}, state, nil
+}
+
+// HashOIDCState は state から保存用のハッシュを計算します。
+func HashOIDCState(state string) string {
+	return hashTokenSecret(state)
+}
+
+// CodeChallenge は PKCE のコードチャレンジ (S256) を返します。
+func (r *OIDCAuthRequest) CodeChallenge() string {
+	sum := sha256.Sum256([]byte(r.CodeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// IsExpired は認可リクエストの有効期限が切れているかどうかを返します。
+func (r *OIDCAuthRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,104 @@
+package model
+
+import "fmt"
+
+// MaxTaskDepth はタスクの階層の最大の段数です (親のないタスクを 1 段目とします)。
+const MaxTaskDepth = 5
+
+// SubtaskProgress はタスクの子孫 (サブタスクとそのサブタスクすべて) のうち、完了しているものの数です。
+type SubtaskProgress struct {
+	Completed int
+	Total     int
+}
+
+// SubtaskDisposition はサブタスクのあるタスクを削除するときの、サブタスクの扱いを表す型
+type SubtaskDisposition string
+
+// サブタスクの扱いの定数
+const (
+	SubtaskDispositionUnspecified SubtaskDisposition = ""         // サブタスクがある場合は削除できない
+	SubtaskDispositionCascade     SubtaskDisposition = "cascade"  // サブタスク (子孫すべて) も削除する
+	SubtaskDispositionReparent    SubtaskDisposition = "reparent" // サブタスクを削除するタスクの親に付け替える (親がない場合は親なしにする)
+)
+
+// Validate はサブタスクの扱いが定義済みの値かを確認します。
+func (d SubtaskDisposition) Validate() error {
+	switch d {
+	case SubtaskDispositionUnspecified, SubtaskDispositionCascade, SubtaskDispositionReparent:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidSubtaskDisposition, d)
+	}
+}
+
+// CheckParent はタスクの親を parent にできるかを確認します。
+// ancestors には parent の祖先を parent の親から順に根まで、subtreeDepth にはタスクを根とする部分木の段数
+// (サブタスクがない場合は 1) を渡します。
+// parent がタスク自身やタスクの子孫の場合 (循環する場合) と、階層が MaxTaskDepth 段を超える場合はエラーを返します。
+func (t *Task) CheckParent(parent *Task, ancestors []*Task, subtreeDepth int) error {
+	if !t.sharesOwnerWith(parent) {
+		return fmt.Errorf("%w: parent must belong to the same workspace or creator", ErrInvalidParentTask)
+	}
+	if parent.ID == t.ID {
+		return fmt.Errorf("%w: task cannot be its own parent", ErrTaskCycle)
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.ID == t.ID {
+			return fmt.Errorf("%w: task %s is an ancestor of the parent", ErrTaskCycle, t.ID)
+		}
+	}
+	// parent は len(ancestors)+1 段目で、タスクの部分木はその下に続く
+	if depth := len(ancestors) + 1 + subtreeDepth; depth > MaxTaskDepth {
+		return fmt.Errorf("%w: %d levels (max %d)", ErrTaskDepthExceeded, depth, MaxTaskDepth)
+	}
+	return nil
+}
+
+// sharesOwnerWith は 2 つのタスクが同じワークスペースのタスク、または同じユーザーが作成した個人のタスクかどうかを返します。
+func (t *Task) sharesOwnerWith(other *Task) bool {
+	if t.InWorkspace() || other.InWorkspace() {
+		return equalStringPtr(t.WorkspaceID, other.WorkspaceID)
+	}
+	return t.UserID == other.UserID
+}
+
+// TaskTreeNode はタスクの階層 (部分木) の節です。
+type TaskTreeNode struct {
+	Task     *Task
+	Children []*TaskTreeNode
+}
+
+// NewTaskTree は root と root の子孫から部分木を作成します。子は descendants の順に並べます。
+// 親が部分木に含まれない子孫 (閲覧できずに除いたタスクの子孫など) は含めません。
+func NewTaskTree(root *Task, descendants []*Task) *TaskTreeNode {
+	rootNode := &TaskTreeNode{Task: root}
+	children := make(map[string][]*Task)
+	for _, task := range descendants {
+		if task.ParentID != nil {
+			children[*task.ParentID] = append(children[*task.ParentID], task)
+		}
+	}
+
+	var build func(node *TaskTreeNode, depth int)
+	build = func(node *TaskTreeNode, depth int) {
+		if depth >= MaxTaskDepth {
+			return
+		}
+		for _, child := range children[node.Task.ID] {
+			childNode := &TaskTreeNode{Task: child}
+			build(childNode, depth+1)
+			node.Children = append(node.Children, childNode)
+		}
+	}
+	build(rootNode, 1)
+	return rootNode
+}
+
+// Depth は節を根とする部分木の段数を返します (子がない場合は 1)。
+func (n *TaskTreeNode) Depth() int {
+	depth := 0
+	for _, child := range n.Children {
+		depth = max(depth, child.Depth())
+	}
+	return depth + 1
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,33 +18,133 @@ const (
 	PriorityLow    Priority = "low"
 )
 
+// TaskField はタスクの更新対象となるフィールドを表す型
+type TaskField string
+
+// 更新対象フィールドの定数
+const (
+	TaskFieldTitle       TaskField = "title"
+	TaskFieldDescription TaskField = "description"
+	TaskFieldStatus      TaskField = "status"
+	TaskFieldAssigneeID  TaskField = "assignee_id"
+	TaskFieldProjectID   TaskField = "project_id"
+	TaskFieldPriority    TaskField = "priority"
+	TaskFieldDueDate     TaskField = "due_date"
+	TaskFieldParentID    TaskField = "parent_id"
+	TaskFieldRank        TaskField = "rank" // ボードの列の中の並び順 (MoveTask でのみ変更する)
+)
+
+// Rank は並び替え用の優先度の重みを返します (high が最大)。
+func (p Priority) Rank() int {
+	switch p {
+	case PriorityHigh:
+		return 3
+	case PriorityMedium:
+		return 2
+	case PriorityLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Validate は優先度が定義済みの値かを確認します。
+func (p Priority) Validate() error {
+	switch p {
+	case PriorityHigh, PriorityMedium, PriorityLow:
+		return nil
+	default:
+		return fmt.Errorf("%w: %v", ErrInvalidPriority, p)
+	}
+}
+
+// MutableTaskFields は更新可能なフィールドの一覧
+var MutableTaskFields = []TaskField{
+	TaskFieldTitle,
+	TaskFieldDescription,
+	TaskFieldStatus,
+	TaskFieldAssigneeID,
+	TaskFieldProjectID,
+	TaskFieldPriority,
+	TaskFieldDueDate,
+	TaskFieldParentID,
+}
+
+// DefaultTaskUpdateFields は update_mask を指定しない場合に更新するフィールドの一覧です。
+// project_id と parent_id は後から追加したフィールドのため含めません (値を送らない既存のクライアントがタスクを
+// プロジェクトから外したり、サブタスクを親のないタスクにしたりしないよう、変更する場合はマスクで指定する必要があります)。
+var DefaultTaskUpdateFields = []TaskField{
+	TaskFieldTitle,
+	TaskFieldDescription,
+	TaskFieldStatus,
+	TaskFieldAssigneeID,
+	TaskFieldPriority,
+	TaskFieldDueDate,
+}
+
+// immutableTaskFields は存在するが更新できないフィールドの一覧
+var immutableTaskFields = map[string]struct{}{
+	"id":           {},
+	"user_id":      {},
+	"workspace_id": {}, // 作成後にワークスペースを移すことはできない
+	"is_completed": {}, // status から決まるため直接は変更できない
+	"rank":         {}, // MoveTask で変更する
+	"created_at":   {},
+	"updated_at":   {},
+}
+
+// ParseTaskFields はフィールドマスクのパスを TaskField に変換します。
+// 更新できないフィールドや存在しないフィールドが含まれる場合はエラーを返します。
+func ParseTaskFields(paths []string) ([]TaskField, error) {
+	fields := make([]TaskField, 0, len(paths))
+	for _, path := range paths {
+		if _, ok := immutableTaskFields[path]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrImmutableTaskField, path)
+		}
+		field := TaskField(path)
+		if !slices.Contains(MutableTaskFields, field) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTaskField, path)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
 type Task struct {
 	ID          string
 	Title       string
 	Description string
-	IsCompleted bool
-	UserID      string  // Taskの作成者
-	AssigneeID  *string // Taskの担当者
+	Status      TaskStatus // ワークフローの状態 (変更は TransitionTo などで行う)
+	IsCompleted bool       // Status がワークフローで完了として扱う状態かどうか (Status から導出する)
+	UserID      string     // Taskの作成者
+	WorkspaceID *string    // タスクを所有するワークスペース (個人のタスクの場合は nil)
+	AssigneeID  *string    // Taskの担当者
+	ProjectID   *string    // 所属するプロジェクト (なしの場合は nil)
+	ParentID    *string    // 親のタスク (サブタスクでない場合は nil)
+	Rank        string     // ボードの列の中の並び順のキー (辞書順で昇順)
 	Priority    Priority
 	DueDate     *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Version     int64 // 楽観的排他制御用のバージョン (更新のたびに +1)
+
+	Subtasks SubtaskProgress // 子孫のタスクの進捗 (読み込み時に集計する)
 }
 
-// NewTask は新しい User エンティティを作成します。
+// NewTask は新しい Task エンティティを作成します。
+// 状態は既定のワークフローの初期状態になります (プロジェクトに追加する場合は ResetStatus で変更します)。
 func NewTask(title, description string, userID string, priority Priority, dueDate *time.Time) (*Task, error) {
 
 	//priorityのバリデーション
-	switch priority {
-	case PriorityHigh, PriorityMedium, PriorityLow:
-	default:
-		return nil, fmt.Errorf("invalid priority: %v", priority)
+	if err := priority.Validate(); err != nil {
+		return nil, err
 	}
 
 	return &Task{
 		ID:          uuid.NewString(),
 		Title:       title,
 		Description: description,
+		Status:      DefaultWorkflow().InitialStatus,
 		IsCompleted: false,
 		UserID:      userID,
 		AssigneeID:  nil,
@@ -52,20 +153,173 @@ func NewTask(title, description string, userID string, priority Priority, dueDat
 	}, nil
 }
 
-// Update はタスクの情報を更新します。
-func (t *Task) Update(title, description string, isCompleted bool, assigneeID *string, priority Priority, dueDate *time.Time) error {
-	//priorityのバリデーション
-	switch priority {
-	case PriorityHigh, PriorityMedium, PriorityLow:
-	default:
-		return fmt.Errorf("invalid priority: %v", priority)
+// TaskPatch はタスクの部分更新を表します。Fields に含まれるフィールドだけが適用されます。
+type TaskPatch struct {
+	Fields      []TaskField
+	Title       string
+	Description string
+	Status      TaskStatus
+	AssigneeID  *string // nil の場合は担当者を外す
+	ProjectID   *string // nil の場合はプロジェクトから外す
+	Priority    Priority
+	DueDate     *time.Time // nil の場合は期限を外す
+	ParentID    *string    // nil の場合は親から外す
+}
+
+// Apply は patch.Fields に含まれるフィールドだけを更新します。
+// workflow には更新後のタスクが従うワークフロー (プロジェクトを変更する場合は変更先のもの) を渡します。
+// 同じワークフローの中での状態の変更は、ワークフローで許可された遷移に限ります。
+// プロジェクトを変更してワークフローが変わる場合は、遷移は確認せず変更先に定義された状態にします
+// (状態を指定しない場合は AdoptWorkflow と同じく、変更先にない状態を置き換えます)。
+func (t *Task) Apply(patch *TaskPatch, workflow *Workflow) error {
+	beforeProjectID := t.ProjectID
+	statusRequested := false
+	for _, field := range patch.Fields {
+		switch field {
+		case TaskFieldTitle:
+			t.Title = patch.Title
+		case TaskFieldDescription:
+			t.Description = patch.Description
+		case TaskFieldStatus:
+			statusRequested = true
+		case TaskFieldAssigneeID:
+			t.AssigneeID = patch.AssigneeID
+		case TaskFieldProjectID:
+			t.ProjectID = patch.ProjectID
+		case TaskFieldPriority:
+			//priorityのバリデーション
+			if err := patch.Priority.Validate(); err != nil {
+				return err
+			}
+			t.Priority = patch.Priority
+		case TaskFieldDueDate:
+			t.DueDate = patch.DueDate
+		case TaskFieldParentID:
+			t.ParentID = patch.ParentID
+		default:
+			return fmt.Errorf("%w: %s", ErrInvalidTaskField, field)
+		}
+	}
+
+	switch {
+	case !equalStringPtr(beforeProjectID, t.ProjectID) && statusRequested:
+		if !workflow.Has(patch.Status) {
+			return fmt.Errorf("%w: %q", ErrInvalidTaskStatus, patch.Status)
+		}
+		t.setStatus(workflow, patch.Status)
+	case !equalStringPtr(beforeProjectID, t.ProjectID):
+		t.AdoptWorkflow(workflow)
+	case statusRequested:
+		return t.TransitionTo(workflow, patch.Status)
+	}
+	return nil
+}
+
+// TransitionTo はワークフローで許可されている場合のみタスクの状態を status に変更します。
+// 現在と同じ状態を指定した場合は何もしません。
+func (t *Task) TransitionTo(workflow *Workflow, status TaskStatus) error {
+	if !workflow.Has(status) {
+		return fmt.Errorf("%w: %q", ErrInvalidTaskStatus, status)
+	}
+	if status == t.Status {
+		return nil
+	}
+	if !workflow.CanTransition(t.Status, status) {
+		return fmt.Errorf("%w: %s → %s", ErrInvalidStatusTransition, t.Status, status)
+	}
+	t.setStatus(workflow, status)
+	return nil
+}
+
+// ResetStatus はタスクの状態をワークフローの初期状態にします (タスクの作成時に使用します)。
+func (t *Task) ResetStatus(workflow *Workflow) {
+	t.setStatus(workflow, workflow.InitialStatus)
+}
+
+// AdoptWorkflow はタスクを別のワークフローに移します。移動先にない状態の場合は FallbackStatus に置き換えます。
+func (t *Task) AdoptWorkflow(workflow *Workflow) {
+	status := t.Status
+	if !workflow.Has(status) {
+		status = workflow.FallbackStatus(t.IsCompleted)
 	}
+	t.setStatus(workflow, status)
+}
+
+// setStatus は状態と、状態から導出する IsCompleted を設定します。
+func (t *Task) setStatus(workflow *Workflow, status TaskStatus) {
+	t.Status = status
+	t.IsCompleted = workflow.IsDone(status)
+}
+
+// IsCreatedBy は指定したユーザーがタスクの作成者かどうかを返します。
+func (t *Task) IsCreatedBy(userID string) bool {
+	return t.UserID == userID
+}
+
+// InWorkspace はタスクがワークスペースのタスクかどうかを返します。
+func (t *Task) InWorkspace() bool {
+	return t.WorkspaceID != nil
+}
+
+// IsAssignedTo は指定したユーザーがタスクの担当者かどうかを返します。
+func (t *Task) IsAssignedTo(userID string) bool {
+	return t.AssigneeID != nil && *t.AssigneeID == userID
+}
 
-	t.Title = title
-	t.Description = description
-	t.IsCompleted = isCompleted
-	t.AssigneeID = assigneeID
-	t.Priority = priority
-	t.DueDate = dueDate
+// ChangedFields は before と比較して値が変わったフィールドを返します。
+func (t *Task) ChangedFields(before *Task) []TaskField {
+	var fields []TaskField
+	if t.Title != before.Title {
+		fields = append(fields, TaskFieldTitle)
+	}
+	if t.Description != before.Description {
+		fields = append(fields, TaskFieldDescription)
+	}
+	if t.Status != before.Status {
+		fields = append(fields, TaskFieldStatus)
+	}
+	if !equalStringPtr(t.AssigneeID, before.AssigneeID) {
+		fields = append(fields, TaskFieldAssigneeID)
+	}
+	if !equalStringPtr(t.ProjectID, before.ProjectID) {
+		fields = append(fields, TaskFieldProjectID)
+	}
+	if t.Priority != before.Priority {
+		fields = append(fields, TaskFieldPriority)
+	}
+	if !equalTimePtr(t.DueDate, before.DueDate) {
+		fields = append(fields, TaskFieldDueDate)
+	}
+	if !equalStringPtr(t.ParentID, before.ParentID) {
+		fields = append(fields, TaskFieldParentID)
+	}
+	if t.Rank != before.Rank {
+		fields = append(fields, TaskFieldRank)
+	}
+	return fields
+}
+
+// equalStringPtr は nil を考慮して 2 つの *string を比較します。
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// equalTimePtr は nil を考慮して 2 つの *time.Time を比較します。
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// CheckVersion は期待するバージョンが現在のバージョンと一致するかを確認します。
+// expected が nil の場合は確認しません。
+func (t *Task) CheckVersion(expected *int64) error {
+	if expected != nil && *expected != t.Version {
+		return fmt.Errorf("%w: expected %d, current %d", ErrTaskVersionMismatch, *expected, t.Version)
+	}
 	return nil
 }
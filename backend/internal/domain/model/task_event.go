@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// TaskEventType はタスクの変更イベントの種類を表す型
+type TaskEventType string
+
+// イベントの種類の定数
+const (
+	TaskEventCreated TaskEventType = "created"
+	TaskEventUpdated TaskEventType = "updated"
+	TaskEventDeleted TaskEventType = "deleted"
+)
+
+// TaskEvent はタスクの作成・更新・削除を表すイベントです。
+type TaskEvent struct {
+	ID         string // ブローカーが発行時に採番する (再開位置の指定に使う)
+	Type       TaskEventType
+	Task       *Task // 変更後のタスク (削除の場合は削除前のタスク)
+	Previous   *Task // 変更前のタスク (更新の場合のみ)
+	OccurredAt time.Time
+}
+
+// NewTaskEvent は新しい TaskEvent を作成します。ID は発行時に設定されます。
+func NewTaskEvent(eventType TaskEventType, task, previous *Task) *TaskEvent {
+	return &TaskEvent{
+		Type:       eventType,
+		Task:       task,
+		Previous:   previous,
+		OccurredAt: time.Now(),
+	}
+}
@@ -0,0 +1,71 @@
+package model
+
+import "time"
+
+// TaskSortKey はタスク一覧の並び順を表す型
+type TaskSortKey string
+
+// 並び順の定数 (方向はキーごとに固定)
+const (
+	TaskSortKeyCreatedAt TaskSortKey = "created_at" // 作成日時の降順
+	TaskSortKeyUpdatedAt TaskSortKey = "updated_at" // 更新日時の降順
+	TaskSortKeyDueDate   TaskSortKey = "due_date"   // 期限の昇順 (期限なしは末尾)
+	TaskSortKeyPriority  TaskSortKey = "priority"   // 優先度の降順
+	TaskSortKeyRank      TaskSortKey = "rank"       // ボードの列の中の並び順 (Task.Rank の昇順)
+)
+
+// TaskScope はタスク一覧に含めるタスクの範囲を表す型
+type TaskScope string
+
+// 一覧の範囲の定数
+const (
+	TaskScopeCreated           TaskScope = "created"             // 自分が作成したタスク
+	TaskScopeAssigned          TaskScope = "assigned"            // 自分が担当者のタスク
+	TaskScopeCreatedOrAssigned TaskScope = "created_or_assigned" // 上記の両方
+	TaskScopeWorkspace         TaskScope = "workspace"           // ワークスペースのすべてのタスク (TaskListQuery.WorkspaceID が必要)
+)
+
+// TaskFilter はタスク一覧の絞り込み条件を表します。nil の条件は無視されます。
+type TaskFilter struct {
+	IsCompleted *bool
+	Status      *TaskStatus
+	Priority    *Priority
+	AssigneeID  *string
+	ProjectID   *string
+	DueFrom     *time.Time // 期限がこの日時以降 (含む)
+	DueTo       *time.Time // 期限がこの日時より前 (含まない)
+}
+
+// TaskCursor はキーセットページネーションの位置を表します。
+// 直前のページの最後のタスクから作成し、並び順に応じたフィールドだけが使われます。
+type TaskCursor struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DueDate   *time.Time `json:"due_date,omitempty"`
+	Priority  Priority   `json:"priority"`
+	Rank      string     `json:"rank,omitempty"`
+}
+
+// NewTaskCursor はタスクの位置を表すカーソルを作成します。
+func NewTaskCursor(task *Task) *TaskCursor {
+	return &TaskCursor{
+		ID:        task.ID,
+		CreatedAt: task.CreatedAt,
+		UpdatedAt: task.UpdatedAt,
+		DueDate:   task.DueDate,
+		Priority:  task.Priority,
+		Rank:      task.Rank,
+	}
+}
+
+// TaskListQuery はタスク一覧取得の条件を表します。
+type TaskListQuery struct {
+	UserID      string
+	Scope       TaskScope
+	WorkspaceID string // Scope が TaskScopeWorkspace の場合のワークスペース
+	Filter      TaskFilter
+	SortKey     TaskSortKey
+	Limit       int
+	After       *TaskCursor // nil の場合は先頭から取得する
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// tokenSecretBytes はリフレッシュトークンなどの推測不能なトークンに使う乱数のバイト数です。
+const tokenSecretBytes = 32
+
+// generateTokenSecret はクライアントに渡すトークン本体 (URL セーフな文字列) を生成します。
+func generateTokenSecret() (string, error) {
+	b := make([]byte, tokenSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashTokenSecret はトークン本体から保存用のハッシュ (SHA-256 の 16 進数) を計算します。
+// トークン本体は十分な長さの乱数のため、パスワードのようなストレッチングは行いません。
+func hashTokenSecret(rawToken string) string {
+	sum := sha256.Sum256([]byte(rawToken))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP (RFC 6238) のパラメーターです。認証アプリの多くが対応している既定値 (SHA-1・6 桁・30 秒) を使います。
+const (
+	totpSecretBytes = 20 // RFC 4226 で推奨されている 160 ビット
+	totpDigits      = 6
+	totpPeriod      = 30 * time.Second
+	totpSkew        = 1 // 端末の時刻のずれを考慮して前後何ステップまで受け付けるか
+)
+
+// RecoveryCodeCount は二要素認証の有効化時に発行するリカバリーコードの数です。
+const RecoveryCodeCount = 10
+
+// totpEncoding はシークレットを認証アプリに渡すときの形式 (パディングなしの Base32) です。
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TOTPCredential はユーザーの二要素認証 (TOTP) の登録情報を表します。
+// シークレットは暗号化した状態でのみ保持します。
+type TOTPCredential struct {
+	UserID          string
+	EncryptedSecret []byte
+	ConfirmedAt     *time.Time // 最初のコードで確認するまでは nil
+	LastUsedStep    int64      // 最後に使用したコードのタイムステップ
+	CreatedAt       time.Time
+}
+
+// IsConfirmed は登録が確認済み (ログインで二要素認証を求める状態) かどうかを返します。
+func (c *TOTPCredential) IsConfirmed() bool {
+	return c.ConfirmedAt != nil
+}
+
+// GenerateTOTPSecret は新しい TOTP のシークレットを生成します。
+func GenerateTOTPSecret() ([]byte, error) {
+	secret := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// EncodeTOTPSecret はシークレットを認証アプリに手入力するための Base32 文字列に変換します。
+func EncodeTOTPSecret(secret []byte) string {
+	return totpEncoding.EncodeToString(secret)
+}
+
+// TOTPURI は認証アプリに登録するための otpauth URI (QR コードの内容) を返します。
+func TOTPURI(issuer, accountName string, secret []byte) string {
+	params := url.Values{}
+	params.Set("secret", EncodeTOTPSecret(secret))
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: strings.ReplaceAll(params.Encode(), "+", "%20"), // 空白を + のまま表示する認証アプリがあるため
+	}
+	return u.String()
+}
+
+// ValidateTOTPCode は認証コードを検証し、一致したコードのタイムステップを返します。
+// 同じコードの再使用を防ぐため、呼び出し側は返されたステップが最後に使用したものより新しいことを確認してください。
+func ValidateTOTPCode(secret []byte, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpCode はタイムステップに対応する認証コードを計算します (RFC 4226 の HOTP)。
+func totpCode(secret []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%uint32(math.Pow10(totpDigits)))
+}
+
+// NewRecoveryCodes は認証アプリを使えない場合に使用する、一度だけ使用できるリカバリーコードを生成します。
+// ユーザーに表示するコードと、保存用のハッシュを同じ順序で返します。
+func NewRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, RecoveryCodeCount)
+	hashes := make([]string, RecoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		encoded := strings.ToLower(totpEncoding.EncodeToString(b)) // 8 文字
+		codes[i] = encoded[:4] + "-" + encoded[4:]
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode はリカバリーコードから保存用のハッシュを計算します。
+// 入力しやすいよう、大文字・小文字の違いや区切りのハイフン・空白は無視します。
+func HashRecoveryCode(code string) string {
+	normalized := strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(code)))
+	return hashTokenSecret(normalized)
+}
+
+// IsRecoveryCodeFormat は入力がリカバリーコードの形式 (認証コードではない) かどうかを返します。
+func IsRecoveryCodeFormat(code string) bool {
+	code = strings.TrimSpace(code)
+	return len(code) != totpDigits || strings.ContainsFunc(code, func(r rune) bool { return r < '0' || r > '9' })
+}
@@ -1,22 +1,31 @@
 package model
 
 import (
+	"fmt"
+	"net/mail"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
 type User struct {
-	ID        string
-	Name      string
-	Email     string
-	Password  string //ハッシュ化されたパスワード
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              string
+	Name            string
+	Email           string
+	Password        string //ハッシュ化されたパスワード
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	EmailVerifiedAt *time.Time // メールアドレスの確認日時 (未確認の場合は nil)
+	Role            Role
+	DisabledAt      *time.Time // 管理者が無効にした日時 (有効な場合は nil)
 }
 
 // NewUser は新しい User エンティティを作成します。
 func NewUser(id, name, email, rawPassword string) (*User, error) {
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(rawPassword), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, err
@@ -27,6 +36,24 @@ func NewUser(id, name, email, rawPassword string) (*User, error) {
 		Name:     name,
 		Email:    email,
 		Password: string(hashedPassword), // ハッシュ化されたパスワードを保存
+		Role:     RoleMember,
+	}, nil
+}
+
+// NewSSOUser はシングルサインオンで初めてログインしたユーザーの User エンティティを作成します。
+// パスワードは設定せず (パスワードではログインできない)、メールアドレスは ID プロバイダーが確認済みのものとして扱います。
+func NewSSOUser(id, name, email string) (*User, error) {
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &User{
+		ID:              id,
+		Name:            name,
+		Email:           email,
+		EmailVerifiedAt: &now,
+		Role:            RoleMember,
 	}, nil
 }
 
@@ -35,10 +62,52 @@ func (u *User) Authenticate(rawPassword string) error {
 	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(rawPassword))
 }
 
+// ChangePassword はパスワードを変更します。
+func (u *User) ChangePassword(rawPassword string) error {
+	if rawPassword == "" {
+		return ErrPasswordRequired
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(rawPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	u.Password = string(hashedPassword)
+	return nil
+}
+
+// IsEmailVerified はメールアドレスが確認済みかどうかを返します。
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// IsDisabled は管理者によって無効にされているかどうかを返します。
+func (u *User) IsDisabled() bool {
+	return u.DisabledAt != nil
+}
+
+// ConfirmEmailByIdentityProvider は ID プロバイダーが確認したメールアドレスとして確認済みにします。
+// 未確認の間に設定されたパスワードは第三者が先に登録したものの可能性があるため、無効にします (再設定は可能)。
+func (u *User) ConfirmEmailByIdentityProvider() {
+	if u.IsEmailVerified() {
+		return
+	}
+	now := time.Now()
+	u.EmailVerifiedAt = &now
+	u.Password = ""
+}
+
 // Update はユーザーの情報を更新します。
+// メールアドレスを変更した場合は、新しいメールアドレスを確認するまで未確認の状態に戻します。
 func (u *User) Update(name, email, rawPassword string) error {
 	u.Name = name
-	u.Email = email
+	if email != u.Email {
+		if err := validateEmail(email); err != nil {
+			return err
+		}
+		u.Email = email
+		u.EmailVerifiedAt = nil
+	}
 
 	if rawPassword != "" {
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(rawPassword), bcrypt.DefaultCost)
@@ -50,3 +119,18 @@ func (u *User) Update(name, email, rawPassword string) error {
 
 	return nil
 }
+
+// validateEmail はメールアドレスの形式を検証します (表示名付きの形式は受け付けません)。
+func validateEmail(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return fmt.Errorf("%w: %q", ErrInvalidEmail, email)
+	}
+	return nil
+}
+
+// EmailVerificationPolicy はメールアドレスが未確認のユーザーに対する制限です。
+type EmailVerificationPolicy struct {
+	BlockLogin          bool // 未確認のユーザーはログインできない
+	BlockTaskAssignment bool // 未確認のユーザーをタスクの担当者に設定できない
+}
@@ -0,0 +1,15 @@
+package model
+
+// UserProfile は他のユーザーに公開するユーザーの最小限の情報です (メールアドレスやそこから導ける値は含めません)。
+type UserProfile struct {
+	ID   string
+	Name string
+}
+
+// NewUserProfile はユーザーの公開プロフィールを作成します。
+func NewUserProfile(user *User) *UserProfile {
+	return &UserProfile{
+		ID:   user.ID,
+		Name: user.Name,
+	}
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// UserCursor はユーザー一覧のキーセットページネーションの位置を表します (作成日時の降順)。
+type UserCursor struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewUserCursor はユーザーの位置を表すカーソルを作成します。
+func NewUserCursor(user *User) *UserCursor {
+	return &UserCursor{ID: user.ID, CreatedAt: user.CreatedAt}
+}
+
+// UserListQuery はユーザー一覧取得の条件を表します。
+type UserListQuery struct {
+	Search string // 名前またはメールアドレスの部分一致 (空の場合は絞り込まない)
+	Limit  int
+	After  *UserCursor // nil の場合は先頭から取得する
+}
+
+// TaskDisposition はユーザーを削除するときに、そのユーザーが作成したタスクと担当しているタスクをどう扱うかを表す型
+type TaskDisposition string
+
+// タスクの扱いの定数
+const (
+	// TaskDispositionReassign は作成したタスクと担当しているタスクを引き継ぎ先のユーザーに移します。
+	TaskDispositionReassign TaskDisposition = "reassign"
+	// TaskDispositionDelete は作成した個人のタスクを削除し、他のユーザーのタスクの担当からは外します (担当者なしにする)。
+	// ワークスペースのタスクは他のメンバーのものでもあるため削除せず、作成者をそのワークスペースに残る owner にします。
+	TaskDispositionDelete TaskDisposition = "delete"
+)
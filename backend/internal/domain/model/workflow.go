@@ -0,0 +1,178 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// TaskStatus はタスクの状態 (ワークフローに定義した状態のキー) を表す型
+type TaskStatus string
+
+// 既定のワークフローの状態の定数
+const (
+	TaskStatusTodo       TaskStatus = "todo"
+	TaskStatusInProgress TaskStatus = "in_progress"
+	TaskStatusReview     TaskStatus = "review"
+	TaskStatusDone       TaskStatus = "done"
+	TaskStatusBlocked    TaskStatus = "blocked"
+)
+
+// ワークフローの制限
+const (
+	maxWorkflowStatuses       = 20
+	maxWorkflowStatusNameSize = 64
+)
+
+// statusKeyPattern は状態のキーに使える文字列 (英小文字で始まる英小文字・数字・_ の 32 文字以内) です。
+var statusKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// WorkflowStatus はワークフローの状態です。
+type WorkflowStatus struct {
+	Key  TaskStatus `json:"key"`
+	Name string     `json:"name"` // 表示名
+	Done bool       `json:"done"` // この状態のタスクを完了 (is_completed) として扱う
+}
+
+// WorkflowTransition は許可する状態の遷移です (From から To への一方向)。
+type WorkflowTransition struct {
+	From TaskStatus `json:"from"`
+	To   TaskStatus `json:"to"`
+}
+
+// Workflow はタスクの状態と、許可する状態の遷移の定義です。
+// プロジェクトごとに定義でき、定義していないプロジェクトとプロジェクトなしのタスクは DefaultWorkflow に従います。
+type Workflow struct {
+	InitialStatus TaskStatus           `json:"initial_status"` // 作成したタスクの状態
+	Statuses      []WorkflowStatus     `json:"statuses"`       // 表示順
+	Transitions   []WorkflowTransition `json:"transitions"`
+}
+
+// DefaultWorkflow は既定のワークフローを返します。
+//
+//	todo → in_progress → review → done
+//	blocked は todo / in_progress / review から移り、todo / in_progress に戻る
+//	done から in_progress に戻すことができる (再開)
+func DefaultWorkflow() *Workflow {
+	return &Workflow{
+		InitialStatus: TaskStatusTodo,
+		Statuses: []WorkflowStatus{
+			{Key: TaskStatusTodo, Name: "To Do"},
+			{Key: TaskStatusInProgress, Name: "In Progress"},
+			{Key: TaskStatusReview, Name: "Review"},
+			{Key: TaskStatusDone, Name: "Done", Done: true},
+			{Key: TaskStatusBlocked, Name: "Blocked"},
+		},
+		Transitions: []WorkflowTransition{
+			{From: TaskStatusTodo, To: TaskStatusInProgress},
+			{From: TaskStatusTodo, To: TaskStatusBlocked},
+			{From: TaskStatusInProgress, To: TaskStatusTodo},
+			{From: TaskStatusInProgress, To: TaskStatusReview},
+			{From: TaskStatusInProgress, To: TaskStatusBlocked},
+			{From: TaskStatusReview, To: TaskStatusInProgress},
+			{From: TaskStatusReview, To: TaskStatusDone},
+			{From: TaskStatusReview, To: TaskStatusBlocked},
+			{From: TaskStatusBlocked, To: TaskStatusTodo},
+			{From: TaskStatusBlocked, To: TaskStatusInProgress},
+			{From: TaskStatusDone, To: TaskStatusInProgress},
+		},
+	}
+}
+
+// NewWorkflow は定義を検証して新しい Workflow を作成します。
+// 状態は 1 つ以上 (最大 20)、完了として扱う状態が 1 つ以上必要で、初期状態は完了として扱わない状態である必要があります。
+func NewWorkflow(initialStatus TaskStatus, statuses []WorkflowStatus, transitions []WorkflowTransition) (*Workflow, error) {
+	w := &Workflow{
+		InitialStatus: initialStatus,
+		Statuses:      make([]WorkflowStatus, 0, len(statuses)),
+		Transitions:   make([]WorkflowTransition, 0, len(transitions)),
+	}
+
+	if len(statuses) == 0 || len(statuses) > maxWorkflowStatuses {
+		return nil, fmt.Errorf("%w: between 1 and %d statuses are required", ErrInvalidWorkflow, maxWorkflowStatuses)
+	}
+	for _, status := range statuses {
+		status.Name = strings.TrimSpace(status.Name)
+		switch {
+		case !statusKeyPattern.MatchString(string(status.Key)):
+			return nil, fmt.Errorf("%w: invalid status key %q", ErrInvalidWorkflow, status.Key)
+		case w.Has(status.Key):
+			return nil, fmt.Errorf("%w: duplicate status %q", ErrInvalidWorkflow, status.Key)
+		case utf8.RuneCountInString(status.Name) > maxWorkflowStatusNameSize:
+			return nil, fmt.Errorf("%w: status name must be at most %d characters", ErrInvalidWorkflow, maxWorkflowStatusNameSize)
+		}
+		if status.Name == "" {
+			status.Name = string(status.Key)
+		}
+		w.Statuses = append(w.Statuses, status)
+	}
+
+	if !w.Has(initialStatus) {
+		return nil, fmt.Errorf("%w: initial status %q is not defined", ErrInvalidWorkflow, initialStatus)
+	}
+	if w.IsDone(initialStatus) {
+		return nil, fmt.Errorf("%w: initial status %q must not be a done status", ErrInvalidWorkflow, initialStatus)
+	}
+	if len(w.DoneStatuses()) == 0 {
+		return nil, fmt.Errorf("%w: at least one done status is required", ErrInvalidWorkflow)
+	}
+
+	for _, t := range transitions {
+		switch {
+		case !w.Has(t.From) || !w.Has(t.To):
+			return nil, fmt.Errorf("%w: transition %s → %s refers to an undefined status", ErrInvalidWorkflow, t.From, t.To)
+		case t.From == t.To:
+			return nil, fmt.Errorf("%w: transition from %q to itself", ErrInvalidWorkflow, t.From)
+		case w.CanTransition(t.From, t.To):
+			return nil, fmt.Errorf("%w: duplicate transition %s → %s", ErrInvalidWorkflow, t.From, t.To)
+		}
+		w.Transitions = append(w.Transitions, t)
+	}
+	return w, nil
+}
+
+// Has は状態がワークフローに定義されているかどうかを返します。
+func (w *Workflow) Has(status TaskStatus) bool {
+	return slices.ContainsFunc(w.Statuses, func(s WorkflowStatus) bool { return s.Key == status })
+}
+
+// IsDone は状態が完了として扱う状態かどうかを返します。
+func (w *Workflow) IsDone(status TaskStatus) bool {
+	return slices.ContainsFunc(w.Statuses, func(s WorkflowStatus) bool { return s.Key == status && s.Done })
+}
+
+// CanTransition は from から to への遷移が許可されているかどうかを返します。
+func (w *Workflow) CanTransition(from, to TaskStatus) bool {
+	return slices.Contains(w.Transitions, WorkflowTransition{From: from, To: to})
+}
+
+// StatusKeys は定義されている状態のキーを表示順に返します。
+func (w *Workflow) StatusKeys() []TaskStatus {
+	keys := make([]TaskStatus, len(w.Statuses))
+	for i, s := range w.Statuses {
+		keys[i] = s.Key
+	}
+	return keys
+}
+
+// DoneStatuses は完了として扱う状態のキーを表示順に返します。
+func (w *Workflow) DoneStatuses() []TaskStatus {
+	var keys []TaskStatus
+	for _, s := range w.Statuses {
+		if s.Done {
+			keys = append(keys, s.Key)
+		}
+	}
+	return keys
+}
+
+// FallbackStatus は、このワークフローに定義されていない状態のタスクを移したときの状態を返します。
+// 完了していたタスクは最初の完了として扱う状態に、それ以外のタスクは初期状態にします。
+func (w *Workflow) FallbackStatus(completed bool) TaskStatus {
+	if completed {
+		return w.DoneStatuses()[0]
+	}
+	return w.InitialStatus
+}
@@ -0,0 +1,170 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// maxWorkspaceNameLength はワークスペース名の最大の長さ (文字数) です。
+const maxWorkspaceNameLength = 255
+
+// Workspace はチームでタスクを共有するためのワークスペースです。
+// ワークスペースのタスクはワークスペースが所有し、メンバーの役割に応じて閲覧・変更できます。
+type Workspace struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewWorkspace は新しい Workspace エンティティを作成します。
+func NewWorkspace(name string) (*Workspace, error) {
+	name = strings.TrimSpace(name)
+	if err := validateWorkspaceName(name); err != nil {
+		return nil, err
+	}
+	return &Workspace{
+		ID:   uuid.NewString(),
+		Name: name,
+	}, nil
+}
+
+// Rename はワークスペース名を変更します。
+func (w *Workspace) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if err := validateWorkspaceName(name); err != nil {
+		return err
+	}
+	w.Name = name
+	return nil
+}
+
+// validateWorkspaceName はワークスペース名が空でなく、長すぎないことを確認します。
+func validateWorkspaceName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidWorkspaceName)
+	}
+	if utf8.RuneCountInString(name) > maxWorkspaceNameLength {
+		return fmt.Errorf("%w: name must be at most %d characters", ErrInvalidWorkspaceName, maxWorkspaceNameLength)
+	}
+	return nil
+}
+
+// WorkspaceRole はワークスペースでのメンバーの役割です。上位の役割は下位の役割の権限をすべて持ちます。
+//
+//   - viewer: タスクの閲覧のみ
+//   - member: タスクの作成・更新と、自分が作成したタスクの削除
+//   - admin: すべてのタスクの削除、メンバーの招待・役割の変更・除外、ワークスペース名の変更
+//   - owner: 所有者の追加・変更とワークスペースの削除
+type WorkspaceRole string
+
+const (
+	WorkspaceRoleViewer WorkspaceRole = "viewer"
+	WorkspaceRoleMember WorkspaceRole = "member"
+	WorkspaceRoleAdmin  WorkspaceRole = "admin"
+	WorkspaceRoleOwner  WorkspaceRole = "owner"
+)
+
+// workspaceRoleRanks は役割の上下関係です (大きいほど上位)。
+var workspaceRoleRanks = map[WorkspaceRole]int{
+	WorkspaceRoleViewer: 1,
+	WorkspaceRoleMember: 2,
+	WorkspaceRoleAdmin:  3,
+	WorkspaceRoleOwner:  4,
+}
+
+// ParseWorkspaceRole は文字列の役割を検証して WorkspaceRole に変換します。
+func ParseWorkspaceRole(value string) (WorkspaceRole, error) {
+	role := WorkspaceRole(value)
+	if _, ok := workspaceRoleRanks[role]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidWorkspaceRole, value)
+	}
+	return role, nil
+}
+
+// Includes は役割が required の権限を持つかどうかを返します。空の役割 (メンバーでない) は何の権限も持ちません。
+func (r WorkspaceRole) Includes(required WorkspaceRole) bool {
+	rank, ok := workspaceRoleRanks[r]
+	return ok && rank >= workspaceRoleRanks[required]
+}
+
+// WorkspaceMember はワークスペースのメンバーです。
+type WorkspaceMember struct {
+	WorkspaceID string
+	UserID      string
+	Role        WorkspaceRole
+	CreatedAt   time.Time // ワークスペースに参加した日時
+	UpdatedAt   time.Time
+
+	// 一覧で返す場合のみ設定されるユーザーの情報
+	Name  string
+	Email string
+}
+
+// WorkspaceMembership はユーザーが参加しているワークスペースと、そのワークスペースでの役割です。
+type WorkspaceMembership struct {
+	Workspace *Workspace
+	Role      WorkspaceRole
+}
+
+// InvitationStatus はワークスペースへの招待の状態です。
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+)
+
+// WorkspaceInvitation はメールアドレス宛てのワークスペースへの招待です。
+// 招待したメールアドレスを確認済みのユーザーのみが、有効期限内に承諾または辞退できます。
+type WorkspaceInvitation struct {
+	ID          string
+	WorkspaceID string
+	Email       string
+	Role        WorkspaceRole // 承諾したときの役割
+	InvitedBy   string
+	Status      InvitationStatus
+	ExpiresAt   time.Time
+	RespondedAt *time.Time
+	CreatedAt   time.Time
+
+	// 招待されたユーザーに一覧で返す場合のみ設定される
+	WorkspaceName string
+}
+
+// NewWorkspaceInvitation は新しい WorkspaceInvitation エンティティを作成します。
+// 所有者として招待することはできません (参加後に所有者が役割を変更します)。
+func NewWorkspaceInvitation(workspaceID, email string, role WorkspaceRole, invitedBy string, ttl time.Duration) (*WorkspaceInvitation, error) {
+	email = strings.TrimSpace(email)
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+	if _, ok := workspaceRoleRanks[role]; !ok || role == WorkspaceRoleOwner {
+		return nil, fmt.Errorf("%w: cannot invite as %q", ErrInvalidWorkspaceRole, role)
+	}
+	return &WorkspaceInvitation{
+		ID:          uuid.NewString(),
+		WorkspaceID: workspaceID,
+		Email:       email,
+		Role:        role,
+		InvitedBy:   invitedBy,
+		Status:      InvitationStatusPending,
+		ExpiresAt:   time.Now().Add(ttl),
+	}, nil
+}
+
+// IsPending は招待が未回答で有効期限内かどうかを返します。
+func (i *WorkspaceInvitation) IsPending(now time.Time) bool {
+	return i.Status == InvitationStatusPending && now.Before(i.ExpiresAt)
+}
+
+// IsAddressedTo は招待がユーザーのメールアドレス宛てかどうかを返します (大文字・小文字は区別しない)。
+func (i *WorkspaceInvitation) IsAddressedTo(user *User) bool {
+	return strings.EqualFold(i.Email, user.Email)
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/adapter/token"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+const (
+	defaultUserPageSize = 50
+	maxUserPageSize     = 200
+)
+
+// AdminService は管理者によるユーザーの管理に関するビジネスロジックを提供します。
+// 呼び出し元が管理者であることは認可インターセプターで確認済みとして扱います。
+type AdminService struct {
+	userRepository                repository.UserRepository
+	taskRepository                repository.TaskRepository
+	projectRepository             repository.ProjectRepository
+	workspaceRepository           repository.WorkspaceRepository
+	refreshTokenRepository        repository.RefreshTokenRepository
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository
+	tokenManager                  token.TokenManager
+}
+
+// NewAdminService は新しい AdminService インスタンスを作成します。
+func NewAdminService(
+	userRepo repository.UserRepository,
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	patRepo repository.PersonalAccessTokenRepository,
+	tokenManager token.TokenManager,
+) *AdminService {
+	return &AdminService{
+		userRepository:                userRepo,
+		taskRepository:                taskRepo,
+		projectRepository:             projectRepo,
+		workspaceRepository:           workspaceRepo,
+		refreshTokenRepository:        refreshTokenRepo,
+		personalAccessTokenRepository: patRepo,
+		tokenManager:                  tokenManager,
+	}
+}
+
+// ListUsers は名前またはメールアドレスに search を含むユーザーを作成日時の新しい順に返します。
+// 続きがある場合は次のページトークンを返します。
+func (s *AdminService) ListUsers(ctx context.Context, search string, pageSize int, pageToken string) ([]*model.User, string, error) {
+	switch {
+	case pageSize < 0:
+		return nil, "", model.ErrInvalidPageSize
+	case pageSize == 0:
+		pageSize = defaultUserPageSize
+	case pageSize > maxUserPageSize:
+		pageSize = maxUserPageSize
+	}
+
+	var after *model.UserCursor
+	if pageToken != "" {
+		cursor, err := decodeUserPageToken(pageToken, search)
+		if err != nil {
+			return nil, "", err
+		}
+		after = cursor
+	}
+
+	// 次ページの有無を判定するため 1 件多く取得する
+	users, err := s.userRepository.ListUsers(ctx, &model.UserListQuery{
+		Search: search,
+		Limit:  pageSize + 1,
+		After:  after,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(users) <= pageSize {
+		return users, "", nil
+	}
+
+	users = users[:pageSize]
+	nextPageToken, err := encodeUserPageToken(search, model.NewUserCursor(users[len(users)-1]))
+	if err != nil {
+		return nil, "", err
+	}
+	return users, nextPageToken, nil
+}
+
+// GetUser はユーザーを返します。
+func (s *AdminService) GetUser(ctx context.Context, id string) (*model.User, error) {
+	return s.userRepository.GetUserByID(ctx, id)
+}
+
+// DisableUser はユーザーを無効にし、発行済みのアクセストークン・リフレッシュトークン・パーソナルアクセストークンを
+// すべて失効させます。無効にしたユーザーはログインできません。自分自身は無効にできません。
+func (s *AdminService) DisableUser(ctx context.Context, adminID, id string) (*model.User, error) {
+	if id == adminID {
+		return nil, model.ErrCannotManageSelf
+	}
+	user, err := s.userRepository.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsDisabled() {
+		now := time.Now()
+		if err := s.userRepository.SetUserDisabledAt(ctx, id, &now); err != nil {
+			return nil, fmt.Errorf("failed to disable user: %w", err)
+		}
+		user.DisabledAt = &now
+	}
+	// 無効化済みの場合も失効させ直す (前回の失効が途中で失敗していた場合に備える)
+	if err := s.revokeCredentials(ctx, id); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// EnableUser は無効にしたユーザーを有効に戻します。
+// 無効にしたときに失効させたトークンは戻らないため、ユーザーはログインし直す必要があります。
+func (s *AdminService) EnableUser(ctx context.Context, id string) (*model.User, error) {
+	user, err := s.userRepository.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsDisabled() {
+		return user, nil
+	}
+	if err := s.userRepository.SetUserDisabledAt(ctx, id, nil); err != nil {
+		return nil, fmt.Errorf("failed to enable user: %w", err)
+	}
+	user.DisabledAt = nil
+	return user, nil
+}
+
+// DeleteUser はユーザーを削除します。tasks はユーザーを外部キーで参照しているため、
+// 同じトランザクション内で disposition に従ってタスクを引き継ぎ先に移すか削除してからユーザーを削除します。
+// 引き継ぐ場合はユーザーが所有するプロジェクトも引き継ぎ先に移し、ユーザーが所有者であるワークスペースには
+// 引き継ぎ先を所有者として加えます (削除する場合はプロジェクトも削除されます)。引き継ぎ先がメンバーでないワークスペースの
+// タスクは引き継ぎ先に移さず、作成者をそのワークスペースに残る所有者にし、担当者なしにします。
+// 削除する場合、ワークスペースのタスクは削除せず作成者をそのワークスペースに残る所有者にするため、
+// ユーザーが唯一の所有者であるワークスペースがあるときは削除できません。削除するプロジェクトに残る他のユーザーのタスクは、
+// プロジェクトから外す場合と同じく既定のワークフローにない状態を既定のワークフローの状態にしてからプロジェクトから外します。
+// 自分自身は削除できません。
+//
+// 一括で変更したタスクの変更イベントは配信しないため、WatchTasks のクライアントは ListTasks で同期し直す必要があります。
+func (s *AdminService) DeleteUser(ctx context.Context, adminID, id string, disposition model.TaskDisposition, successorID string) error {
+	if id == adminID {
+		return model.ErrCannotManageSelf
+	}
+	switch disposition {
+	case model.TaskDispositionReassign:
+		if successorID == "" || successorID == id {
+			return fmt.Errorf("%w: a successor other than the deleted user is required", model.ErrInvalidTaskSuccessor)
+		}
+	case model.TaskDispositionDelete:
+		if successorID != "" {
+			return model.ErrTaskSuccessorNotAllowed
+		}
+	default:
+		return fmt.Errorf("%w: %q", model.ErrInvalidTaskDisposition, disposition)
+	}
+
+	if _, err := s.userRepository.GetUserByID(ctx, id); err != nil {
+		return err
+	}
+
+	tx, err := s.userRepository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txUserRepo := s.userRepository.WithTx(tx)
+	txTaskRepo := s.taskRepository.WithTx(tx)
+
+	switch disposition {
+	case model.TaskDispositionReassign:
+		successor, err := txUserRepo.GetUserByID(ctx, successorID)
+		if err != nil {
+			return fmt.Errorf("%w: %w", model.ErrInvalidTaskSuccessor, err)
+		}
+		if successor.IsDisabled() {
+			return fmt.Errorf("%w: successor is disabled", model.ErrInvalidTaskSuccessor)
+		}
+		// 所有者として加えたワークスペースのタスクも引き継げるよう、先にワークスペースの所有者を加える
+		if err := s.workspaceRepository.WithTx(tx).AddOwnerToUserWorkspaces(ctx, id, successorID); err != nil {
+			return fmt.Errorf("failed to reassign workspace ownership: %w", err)
+		}
+		if err := txTaskRepo.ReassignUserTasks(ctx, id, successorID); err != nil {
+			return fmt.Errorf("failed to reassign tasks: %w", err)
+		}
+		if err := s.projectRepository.WithTx(tx).ReassignUserProjects(ctx, id, successorID); err != nil {
+			return fmt.Errorf("failed to reassign projects: %w", err)
+		}
+	case model.TaskDispositionDelete:
+		owned, err := s.workspaceRepository.WithTx(tx).ListWorkspacesSolelyOwnedBy(ctx, id)
+		if err != nil {
+			return err
+		}
+		if len(owned) > 0 {
+			return fmt.Errorf("%w: user is the only owner of %d workspace(s)", model.ErrLastWorkspaceOwner, len(owned))
+		}
+		if err := txTaskRepo.DeleteUserTasks(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete tasks: %w", err)
+		}
+		// ユーザーのプロジェクトは外部キーで削除されるため、残るタスクを先にプロジェクトから外す
+		projectIDs, err := s.projectRepository.WithTx(tx).ListUserProjectIDs(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, projectID := range projectIDs {
+			if err := txTaskRepo.AdoptProjectWorkflow(ctx, projectID, model.DefaultWorkflow()); err != nil {
+				return fmt.Errorf("failed to update task statuses: %w", err)
+			}
+			if err := txTaskRepo.MoveProjectTasks(ctx, projectID, nil); err != nil {
+				return fmt.Errorf("failed to detach tasks: %w", err)
+			}
+		}
+	}
+
+	// トークンやログイン履歴などはユーザーの削除に連動して削除される (ON DELETE CASCADE)。
+	// 発行済みのアクセストークンの失効情報も削除されるが、認証インターセプターが存在しないユーザーのトークンを拒否する
+	if err := txUserRepo.DeleteUser(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	return tx.Commit()
+}
+
+// revokeCredentials はユーザーに発行済みのアクセストークン・リフレッシュトークン・パーソナルアクセストークンをすべて失効させます。
+func (s *AdminService) revokeCredentials(ctx context.Context, userID string) error {
+	if err := s.refreshTokenRepository.RevokeUserRefreshTokens(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+	if err := s.personalAccessTokenRepository.RevokeUserPersonalAccessTokens(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke personal access tokens: %w", err)
+	}
+	if err := s.tokenManager.RevokeAll(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke access tokens: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+const adminID = "admin"
+
+// adminTestService は AdminService と、テストで状態を確認するリポジトリです。
+type adminTestService struct {
+	*AdminService
+	users      *fakeUserRepository
+	tasks      *fakeTaskRepository
+	projects   *fakeProjectRepository
+	workspaces *fakeWorkspaceRepository
+}
+
+// newAdminTestService は管理者と users のユーザーがいる AdminService を作成します。
+func newAdminTestService(t *testing.T, users ...*model.User) *adminTestService {
+	t.Helper()
+	ts := &adminTestService{
+		users:      newFakeUserRepository(append(users, &model.User{ID: adminID, Role: model.RoleAdmin})...),
+		tasks:      newFakeTaskRepository(),
+		projects:   newFakeProjectRepository(),
+		workspaces: newFakeWorkspaceRepository(),
+	}
+	ts.tasks.workspaces = ts.workspaces
+	ts.AdminService = NewAdminService(ts.users, ts.tasks, ts.projects, ts.workspaces, nil, nil, nil)
+	return ts
+}
+
+// adminTestTask は userID のユーザーが作成した、status の状態のタスクを返します。
+func adminTestTask(t *testing.T, id, userID string, status model.TaskStatus, completed bool) *model.Task {
+	t.Helper()
+	task, err := model.NewTask(id, "", userID, model.PriorityMedium, nil)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+	task.ID = id
+	task.Status = status
+	task.IsCompleted = completed
+	return task
+}
+
+// 削除するユーザーのプロジェクトに残る他のユーザーのタスクは、既定のワークフローの状態にしてからプロジェクトから外す
+func TestDeleteUserDetachesTasksFromDeletedProjects(t *testing.T) {
+	ts := newAdminTestService(t, &model.User{ID: "bob"}, &model.User{ID: "alice"})
+	projectID := "project"
+	ts.projects.projects[projectID] = &model.Project{ID: projectID, OwnerID: "bob"}
+	for _, task := range []*model.Task{
+		adminTestTask(t, "bob-task", "bob", "triage", false),
+		adminTestTask(t, "open", "alice", "triage", false),
+		adminTestTask(t, "shipped", "alice", "shipped", true),
+		adminTestTask(t, "review", "alice", model.TaskStatusReview, false),
+	} {
+		task.ProjectID = &projectID
+		ts.tasks.tasks[task.ID] = task
+	}
+
+	if err := ts.DeleteUser(context.Background(), adminID, "bob", model.TaskDispositionDelete, ""); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+	if _, ok := ts.tasks.tasks["bob-task"]; ok {
+		t.Error("personal task of the deleted user was not deleted")
+	}
+	want := map[string]model.TaskStatus{
+		"open":    model.TaskStatusTodo,
+		"shipped": model.TaskStatusDone,
+		"review":  model.TaskStatusReview, // 既定のワークフローにある状態は変えない
+	}
+	for id, status := range want {
+		task := ts.tasks.tasks[id]
+		if task.ProjectID != nil {
+			t.Errorf("task %s project = %q, want none", id, *task.ProjectID)
+		}
+		if task.Status != status {
+			t.Errorf("task %s status = %q, want %q", id, task.Status, status)
+		}
+	}
+}
+
+// 引き継ぎ先がメンバーでないワークスペースのタスクは引き継ぎ先に移さず、作成者を残る owner にして担当者なしにする
+func TestDeleteUserReassignKeepsWorkspaceTasksInWorkspace(t *testing.T) {
+	ts := newAdminTestService(t, &model.User{ID: "bob"}, &model.User{ID: "carol"}, &model.User{ID: "dave"})
+	ts.workspaces.addMember("owned", "bob", model.WorkspaceRoleOwner)   // bob が owner (carol を owner として加える)
+	ts.workspaces.addMember("joined", "bob", model.WorkspaceRoleMember) // carol はメンバーでない
+	ts.workspaces.addMember("joined", "dave", model.WorkspaceRoleOwner)
+
+	bob := "bob"
+	for id, workspaceID := range map[string]string{"personal": "", "owned": "owned", "joined": "joined"} {
+		task := adminTestTask(t, id, "bob", model.TaskStatusTodo, false)
+		task.AssigneeID = &bob
+		if workspaceID != "" {
+			task.WorkspaceID = &workspaceID
+		}
+		ts.tasks.tasks[id] = task
+	}
+
+	if err := ts.DeleteUser(context.Background(), adminID, "bob", model.TaskDispositionReassign, "carol"); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+	for _, id := range []string{"personal", "owned"} {
+		task := ts.tasks.tasks[id]
+		if task.UserID != "carol" || task.AssigneeID == nil || *task.AssigneeID != "carol" {
+			t.Errorf("task %s creator = %q, assignee = %v, want carol for both", id, task.UserID, task.AssigneeID)
+		}
+	}
+	if task := ts.tasks.tasks["joined"]; task.UserID != "dave" || task.AssigneeID != nil {
+		t.Errorf("task joined creator = %q, assignee = %v, want dave and none", task.UserID, task.AssigneeID)
+	}
+}
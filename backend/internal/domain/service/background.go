@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/a-s/connect-task-manage/internal/infrastructure/logger"
+	"go.uber.org/zap"
+)
+
+// runInBackground はリクエストの完了を待たずに fn を実行し、失敗した場合はログに出力します。
+// リクエストのキャンセルの影響を受けないよう、ctx からは値 (ロガーなど) だけを引き継ぎます。
+func (s *UserService) runInBackground(ctx context.Context, failureMessage string, fn func(ctx context.Context) error) {
+	ctx = context.WithoutCancel(ctx)
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+		if err := fn(ctx); err != nil {
+			logger.FromContext(ctx).Error(failureMessage, zap.Error(err))
+		}
+	}()
+}
+
+// WaitBackground はリクエストの完了後に実行中の処理 (メールの送信など) がすべて終わるまで待ちます。
+// ctx が先に終了した場合は ctx のエラーを返します (サーバーの停止時に使用します)。
+func (s *UserService) WaitBackground(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.background.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
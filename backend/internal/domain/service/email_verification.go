@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/google/uuid"
+)
+
+// emailVerificationTokenDuration はメールアドレスの確認用トークンの有効期間です。
+const emailVerificationTokenDuration = 24 * time.Hour
+
+// newEmailVerificationPolicy は設定からメールアドレスが未確認のユーザーに対する制限を作成します。
+func newEmailVerificationPolicy(cfg *config.Config) model.EmailVerificationPolicy {
+	return model.EmailVerificationPolicy{
+		BlockLogin:          cfg.EmailVerification.BlockLogin,
+		BlockTaskAssignment: cfg.EmailVerification.BlockTaskAssignment,
+	}
+}
+
+// VerifyEmail は確認用のトークンを使用してユーザーのメールアドレスを確認済みにします。
+// トークンの発行後にメールアドレスが変更された場合は使用できません。
+func (s *UserService) VerifyEmail(ctx context.Context, rawToken string) error {
+	verificationToken, err := s.emailVerificationTokenRepository.GetEmailVerificationTokenByHash(ctx, model.HashEmailVerificationToken(rawToken))
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, verificationToken.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user by id: %w", err)
+	}
+	if !verificationToken.IsUsableFor(user, time.Now()) {
+		return model.ErrInvalidEmailVerificationToken
+	}
+
+	if err := s.emailVerificationTokenRepository.MarkEmailVerificationTokenUsed(ctx, verificationToken.ID); err != nil {
+		return err
+	}
+	return s.userRepository.MarkEmailVerified(ctx, user.ID, verificationToken.Email)
+}
+
+// ResendVerificationEmail は確認用のリンクを再送信します。
+// メールアドレスが登録されているかどうかを応答の内容や時間から推測されないよう、ユーザーの検索とメールの送信は
+// 応答の後に行い、登録されていない場合や確認済みの場合、送信に失敗した場合も成功として扱います (失敗はログに出力します)。
+//
+// RequestPasswordReset と同じく宛先のメールアドレスとクライアント IP ごとに呼び出し回数を制限し、
+// 上限に達した場合は *model.RateLimitedError を返します。
+func (s *UserService) ResendVerificationEmail(ctx context.Context, email string, client model.ClientInfo) error {
+	if err := s.allowMailRequest(ctx, email, client); err != nil {
+		return err
+	}
+	s.runInBackground(ctx, "failed to resend verification mail", func(ctx context.Context) error {
+		return s.resendVerificationEmail(ctx, email)
+	})
+	return nil
+}
+
+// resendVerificationEmail はメールアドレスが登録されていて未確認の場合に、確認用のリンクを送信します。
+func (s *UserService) resendVerificationEmail(ctx context.Context, email string) error {
+	user, err := s.userRepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get user by email: %w", err)
+	}
+	if user.IsEmailVerified() {
+		return nil
+	}
+	return s.sendVerificationEmail(ctx, user)
+}
+
+// sendVerificationEmailInBackground は応答の後に sendVerificationEmail を実行し、失敗した場合はログに出力します。
+func (s *UserService) sendVerificationEmailInBackground(ctx context.Context, user *model.User) {
+	s.runInBackground(ctx, "failed to send verification mail", func(ctx context.Context) error {
+		return s.sendVerificationEmail(ctx, user)
+	})
+}
+
+// sendVerificationEmail は確認用のトークンを発行し、ユーザーの現在のメールアドレスにリンクを送信します。
+// 以前に発行したトークンは無効にし、最新のメールのリンクだけを使用できるようにします。
+func (s *UserService) sendVerificationEmail(ctx context.Context, user *model.User) error {
+	verificationToken, rawToken, err := model.NewEmailVerificationToken(uuid.New().String(), user, emailVerificationTokenDuration)
+	if err != nil {
+		return fmt.Errorf("failed to create email verification token: %w", err)
+	}
+
+	tx, err := s.emailVerificationTokenRepository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txRepo := s.emailVerificationTokenRepository.WithTx(tx)
+
+	if err := txRepo.InvalidateUserEmailVerificationTokens(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to invalidate email verification tokens: %w", err)
+	}
+	if err := txRepo.CreateEmailVerificationToken(ctx, verificationToken); err != nil {
+		return fmt.Errorf("failed to save email verification token: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	link := s.baseURL + "/verify-email?token=" + url.QueryEscape(rawToken)
+	if err := s.mailer.Send(ctx, &mailer.Message{
+		To:      user.Email,
+		Subject: "メールアドレスの確認",
+		Body: "以下のリンクからメールアドレスを確認してください (有効期間: 24 時間)。\n\n" +
+			link + "\n\n" +
+			"このメールに心当たりがない場合は破棄してください。\n",
+	}); err != nil {
+		return fmt.Errorf("failed to send verification mail: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+var verifyClient = model.ClientInfo{IPAddress: "192.0.2.2"}
+
+// lastVerificationToken は最後に送信されたメールが email 宛ての確認メールであることを確認し、リンクからトークン本体を取り出します。
+func lastVerificationToken(t *testing.T, ts *testUserService, email string) string {
+	t.Helper()
+	ts.wait(t)
+	msg, ok := ts.outbox.Last()
+	if !ok {
+		t.Fatal("no verification mail sent")
+	}
+	if msg.To != email {
+		t.Fatalf("mail to = %q, want %q", msg.To, email)
+	}
+	return tokenFromMail(t, msg, "/verify-email")
+}
+
+func TestCreateUserSendsVerificationEmail(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestUserService(t)
+	if _, err := ts.CreateUser(ctx, "bob", "bob@example.com", "password"); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	rawToken := lastVerificationToken(t, ts, "bob@example.com")
+
+	if err := ts.VerifyEmail(ctx, rawToken); err != nil {
+		t.Fatalf("VerifyEmail: %v", err)
+	}
+	user, _ := ts.users.GetUserByEmail(ctx, "bob@example.com")
+	if !user.IsEmailVerified() {
+		t.Error("email is not verified")
+	}
+	if err := ts.VerifyEmail(ctx, rawToken); !errors.Is(err, model.ErrInvalidEmailVerificationToken) {
+		t.Errorf("VerifyEmail(used token) err = %v, want ErrInvalidEmailVerificationToken", err)
+	}
+}
+
+// 確認メールの送信に失敗しても、ユーザーの作成や更新は成功する
+func TestVerificationMailFailureDoesNotFailRequest(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestUserService(t, newTestUser(t, "carol", "carol@example.com", "password"))
+	ts.mailer = failingMailer{}
+
+	if _, err := ts.CreateUser(ctx, "bob", "bob@example.com", "password"); err != nil {
+		t.Errorf("CreateUser err = %v, want nil", err)
+	}
+	if _, err := ts.users.GetUserByEmail(ctx, "bob@example.com"); err != nil {
+		t.Errorf("created user not found: %v", err)
+	}
+
+	updated, err := ts.UpdateUser(ctx, "carol", "carol", "carol@example.org", "")
+	if err != nil {
+		t.Fatalf("UpdateUser err = %v, want nil", err)
+	}
+	if updated.Email != "carol@example.org" || updated.IsEmailVerified() {
+		t.Errorf("updated email = %q (verified=%v), want unverified carol@example.org", updated.Email, updated.IsEmailVerified())
+	}
+	ts.wait(t)
+}
+
+// メールアドレスを変更すると、変更前のアドレスに送ったリンクは使用できなくなる
+func TestUpdateUserEmailInvalidatesOldLink(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestUserService(t)
+	if _, err := ts.CreateUser(ctx, "bob", "bob@example.com", "password"); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	oldToken := lastVerificationToken(t, ts, "bob@example.com")
+	user, _ := ts.users.GetUserByEmail(ctx, "bob@example.com")
+
+	if _, err := ts.UpdateUser(ctx, user.ID, "bob", "bob@example.org", ""); err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+	newToken := lastVerificationToken(t, ts, "bob@example.org")
+
+	if err := ts.VerifyEmail(ctx, oldToken); !errors.Is(err, model.ErrInvalidEmailVerificationToken) {
+		t.Errorf("VerifyEmail(old token) err = %v, want ErrInvalidEmailVerificationToken", err)
+	}
+	if err := ts.VerifyEmail(ctx, newToken); err != nil {
+		t.Errorf("VerifyEmail(new token): %v", err)
+	}
+}
+
+func TestResendVerificationEmail(t *testing.T) {
+	ctx := context.Background()
+	unverified := newTestUser(t, "bob", "bob@example.com", "password")
+	verified := newTestUser(t, "carol", "carol@example.com", "password")
+	verifiedAt := time.Now()
+	verified.EmailVerifiedAt = &verifiedAt
+	ts := newTestUserService(t, unverified, verified)
+
+	// 登録されていない場合や確認済みの場合も成功として扱い、メールは送信しない
+	for _, email := range []string{"unknown@example.com", "carol@example.com"} {
+		if err := ts.ResendVerificationEmail(ctx, email, verifyClient); err != nil {
+			t.Errorf("ResendVerificationEmail(%s) err = %v, want nil", email, err)
+		}
+	}
+	ts.wait(t)
+	if messages := ts.outbox.Messages(); len(messages) != 0 {
+		t.Fatalf("sent %d mails, want 0", len(messages))
+	}
+
+	if err := ts.ResendVerificationEmail(ctx, "bob@example.com", verifyClient); err != nil {
+		t.Fatalf("ResendVerificationEmail: %v", err)
+	}
+	if err := ts.VerifyEmail(ctx, lastVerificationToken(t, ts, "bob@example.com")); err != nil {
+		t.Errorf("VerifyEmail: %v", err)
+	}
+}
+
+// 宛先のメールアドレスごとの上限は、パスワードの再設定と共通で数える
+func TestResendVerificationEmailRateLimit(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestUserService(t, newTestUser(t, "bob", "bob@example.com", "password"))
+	for range 2 {
+		if err := ts.ResendVerificationEmail(ctx, "bob@example.com", verifyClient); err != nil {
+			t.Fatalf("ResendVerificationEmail: %v", err)
+		}
+	}
+	if err := ts.RequestPasswordReset(ctx, "bob@example.com", verifyClient); err != nil {
+		t.Fatalf("RequestPasswordReset: %v", err)
+	}
+	var limited *model.RateLimitedError
+	if err := ts.ResendVerificationEmail(ctx, "bob@example.com", verifyClient); !errors.As(err, &limited) {
+		t.Errorf("ResendVerificationEmail err = %v, want RateLimitedError", err)
+	}
+	ts.wait(t)
+}
@@ -0,0 +1,844 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
+	mailermemory "github.com/a-s/connect-task-manage/internal/adapter/mailer/memory"
+	ratelimitmemory "github.com/a-s/connect-task-manage/internal/adapter/ratelimit/memory"
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/adapter/repository/memory"
+	"github.com/a-s/connect-task-manage/internal/adapter/token/jwt"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+)
+
+// fakeDB は何もしないトランザクションだけを提供するデータベースです。
+// テスト用のリポジトリは BeginTx でこのトランザクションを返し、WithTx では自身を返します (書き込みはすぐに反映される)。
+var fakeDB = func() *sql.DB {
+	sql.Register("fake", fakeDriver{})
+	db, err := sql.Open("fake", "")
+	if err != nil {
+		panic(err)
+	}
+	return db
+}()
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (fakeConn) Close() error                        { return nil }
+func (fakeConn) Begin() (driver.Tx, error)           { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+// fakeUserRepository は UserRepository のテスト用のインメモリ実装です。使用しないメソッドは実装していません。
+type fakeUserRepository struct {
+	repository.UserRepository
+
+	mu         sync.Mutex
+	users      map[string]*model.User
+	workspaces map[string][]string // ユーザーの ID ごとの、メンバーであるワークスペースの ID (SearchUsers で使う)
+}
+
+func newFakeUserRepository(users ...*model.User) *fakeUserRepository {
+	r := &fakeUserRepository{users: make(map[string]*model.User)}
+	for _, u := range users {
+		r.users[u.ID] = u
+	}
+	return r
+}
+
+func (r *fakeUserRepository) CreateUser(_ context.Context, user *model.User) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *user
+	r.users[user.ID] = &copied
+	return user, nil
+}
+
+func (r *fakeUserRepository) GetUserByEmail(_ context.Context, email string) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if u.Email == email {
+			copied := *u
+			return &copied, nil
+		}
+	}
+	return nil, model.ErrUserNotFound
+}
+
+func (r *fakeUserRepository) GetUserByID(_ context.Context, id string) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return nil, model.ErrUserNotFound
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (r *fakeUserRepository) UpdateUser(_ context.Context, user *model.User) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.users[user.ID]; !ok {
+		return nil, model.ErrUserNotFound
+	}
+	copied := *user
+	r.users[user.ID] = &copied
+	return user, nil
+}
+
+func (r *fakeUserRepository) MarkEmailVerified(_ context.Context, id, email string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return model.ErrUserNotFound
+	}
+	if u.Email != email {
+		return model.ErrInvalidEmailVerificationToken
+	}
+	now := time.Now()
+	u.EmailVerifiedAt = &now
+	return nil
+}
+
+func (r *fakeUserRepository) SearchUsers(_ context.Context, callerID, q string, limit int) ([]*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var users []*model.User
+	for _, u := range r.users {
+		if u.IsDisabled() || !(strings.HasPrefix(u.Name, q) || u.Email == q) {
+			continue
+		}
+		if !slices.ContainsFunc(r.workspaces[u.ID], func(id string) bool { return slices.Contains(r.workspaces[callerID], id) }) {
+			continue
+		}
+		copied := *u
+		users = append(users, &copied)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Name < users[j].Name })
+	if len(users) > limit {
+		users = users[:limit]
+	}
+	return users, nil
+}
+
+func (r *fakeUserRepository) DeleteUser(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.users, id)
+	return nil
+}
+
+func (r *fakeUserRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return fakeDB.BeginTx(ctx, nil)
+}
+
+func (r *fakeUserRepository) WithTx(*sql.Tx) repository.UserRepository { return r }
+
+// fakePasswordResetTokenRepository は PasswordResetTokenRepository のテスト用のインメモリ実装です。
+type fakePasswordResetTokenRepository struct {
+	mu     sync.Mutex
+	tokens map[string]*model.PasswordResetToken
+}
+
+func newFakePasswordResetTokenRepository() *fakePasswordResetTokenRepository {
+	return &fakePasswordResetTokenRepository{tokens: make(map[string]*model.PasswordResetToken)}
+}
+
+func (r *fakePasswordResetTokenRepository) CreatePasswordResetToken(_ context.Context, token *model.PasswordResetToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *token
+	r.tokens[token.ID] = &copied
+	return nil
+}
+
+func (r *fakePasswordResetTokenRepository) GetPasswordResetTokenByHash(_ context.Context, tokenHash string) (*model.PasswordResetToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range r.tokens {
+		if t.TokenHash == tokenHash {
+			copied := *t
+			return &copied, nil
+		}
+	}
+	return nil, model.ErrInvalidPasswordResetToken
+}
+
+func (r *fakePasswordResetTokenRepository) MarkPasswordResetTokenUsed(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tokens[id]
+	if !ok || t.UsedAt != nil {
+		return model.ErrInvalidPasswordResetToken
+	}
+	now := time.Now()
+	t.UsedAt = &now
+	return nil
+}
+
+func (r *fakePasswordResetTokenRepository) InvalidateUserPasswordResetTokens(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, t := range r.tokens {
+		if t.UserID == userID && t.UsedAt == nil {
+			t.UsedAt = &now
+		}
+	}
+	return nil
+}
+
+// expireAll は保存されているトークンをすべて有効期限切れにします。
+func (r *fakePasswordResetTokenRepository) expireAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range r.tokens {
+		t.ExpiresAt = time.Now().Add(-time.Second)
+	}
+}
+
+func (r *fakePasswordResetTokenRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return fakeDB.BeginTx(ctx, nil)
+}
+
+func (r *fakePasswordResetTokenRepository) WithTx(*sql.Tx) repository.PasswordResetTokenRepository {
+	return r
+}
+
+// fakeEmailVerificationTokenRepository は EmailVerificationTokenRepository のテスト用のインメモリ実装です。
+type fakeEmailVerificationTokenRepository struct {
+	mu     sync.Mutex
+	tokens map[string]*model.EmailVerificationToken
+}
+
+func newFakeEmailVerificationTokenRepository() *fakeEmailVerificationTokenRepository {
+	return &fakeEmailVerificationTokenRepository{tokens: make(map[string]*model.EmailVerificationToken)}
+}
+
+func (r *fakeEmailVerificationTokenRepository) CreateEmailVerificationToken(_ context.Context, token *model.EmailVerificationToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *token
+	r.tokens[token.ID] = &copied
+	return nil
+}
+
+func (r *fakeEmailVerificationTokenRepository) GetEmailVerificationTokenByHash(_ context.Context, tokenHash string) (*model.EmailVerificationToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range r.tokens {
+		if t.TokenHash == tokenHash {
+			copied := *t
+			return &copied, nil
+		}
+	}
+	return nil, model.ErrInvalidEmailVerificationToken
+}
+
+func (r *fakeEmailVerificationTokenRepository) MarkEmailVerificationTokenUsed(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tokens[id]
+	if !ok || t.UsedAt != nil {
+		return model.ErrInvalidEmailVerificationToken
+	}
+	now := time.Now()
+	t.UsedAt = &now
+	return nil
+}
+
+func (r *fakeEmailVerificationTokenRepository) InvalidateUserEmailVerificationTokens(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, t := range r.tokens {
+		if t.UserID == userID && t.UsedAt == nil {
+			t.UsedAt = &now
+		}
+	}
+	return nil
+}
+
+func (r *fakeEmailVerificationTokenRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return fakeDB.BeginTx(ctx, nil)
+}
+
+func (r *fakeEmailVerificationTokenRepository) WithTx(*sql.Tx) repository.EmailVerificationTokenRepository {
+	return r
+}
+
+// fakeRefreshTokenRepository は RefreshTokenRepository のテスト用の実装です。失効させたユーザーだけを記録します。
+type fakeRefreshTokenRepository struct {
+	repository.RefreshTokenRepository
+
+	mu      sync.Mutex
+	revoked []string
+}
+
+func (r *fakeRefreshTokenRepository) CreateRefreshToken(context.Context, *model.RefreshToken) error {
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) RevokeUserRefreshTokens(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.revoked = append(r.revoked, userID)
+	return nil
+}
+
+// fakePersonalAccessTokenRepository は PersonalAccessTokenRepository のテスト用の実装です。失効させたユーザーだけを記録します。
+type fakePersonalAccessTokenRepository struct {
+	repository.PersonalAccessTokenRepository
+
+	mu      sync.Mutex
+	revoked []string
+}
+
+func (r *fakePersonalAccessTokenRepository) RevokeUserPersonalAccessTokens(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.revoked = append(r.revoked, userID)
+	return nil
+}
+
+// fakeOIDCAuthRequestRepository は OIDCAuthRequestRepository のテスト用のインメモリ実装です。
+// tamper を設定すると、取り出した認可リクエストを書き換えてから返します。
+type fakeOIDCAuthRequestRepository struct {
+	mu       sync.Mutex
+	requests map[string]*model.OIDCAuthRequest
+	tamper   func(req *model.OIDCAuthRequest)
+}
+
+func (r *fakeOIDCAuthRequestRepository) CreateOIDCAuthRequest(_ context.Context, req *model.OIDCAuthRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *req
+	r.requests[req.StateHash] = &copied
+	return nil
+}
+
+func (r *fakeOIDCAuthRequestRepository) ConsumeOIDCAuthRequest(_ context.Context, stateHash string) (*model.OIDCAuthRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req, ok := r.requests[stateHash]
+	if !ok {
+		return nil, model.ErrInvalidSSOState
+	}
+	delete(r.requests, stateHash)
+	if r.tamper != nil {
+		r.tamper(req)
+	}
+	return req, nil
+}
+
+// fakeUserIdentityRepository は UserIdentityRepository のテスト用のインメモリ実装です。
+type fakeUserIdentityRepository struct {
+	mu         sync.Mutex
+	identities []*model.UserIdentity
+}
+
+func (r *fakeUserIdentityRepository) CreateUserIdentity(_ context.Context, identity *model.UserIdentity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *identity
+	r.identities = append(r.identities, &copied)
+	return nil
+}
+
+func (r *fakeUserIdentityRepository) GetUserIdentity(_ context.Context, issuer, subject string) (*model.UserIdentity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, identity := range r.identities {
+		if identity.Issuer == issuer && identity.Subject == subject {
+			copied := *identity
+			return &copied, nil
+		}
+	}
+	return nil, model.ErrUserIdentityNotFound
+}
+
+func (r *fakeUserIdentityRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return fakeDB.BeginTx(ctx, nil)
+}
+
+func (r *fakeUserIdentityRepository) WithTx(*sql.Tx) repository.UserIdentityRepository { return r }
+
+// fakeTOTPRepository は TOTPRepository のテスト用の実装です。登録の取得のみを実装しています。
+type fakeTOTPRepository struct {
+	repository.TOTPRepository
+
+	mu          sync.Mutex
+	credentials map[string]*model.TOTPCredential
+}
+
+func (r *fakeTOTPRepository) GetTOTPCredential(_ context.Context, userID string) (*model.TOTPCredential, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	credential, ok := r.credentials[userID]
+	if !ok {
+		return nil, model.ErrTOTPNotEnabled
+	}
+	copied := *credential
+	return &copied, nil
+}
+
+// enable はユーザーの二要素認証を確認済みの状態で登録します。
+func (r *fakeTOTPRepository) enable(userID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	confirmedAt := time.Now()
+	r.credentials[userID] = &model.TOTPCredential{UserID: userID, ConfirmedAt: &confirmedAt}
+}
+
+// fakeLoginEventRepository は LoginEventRepository のテスト用の実装です。記録したログインの結果を保持します。
+type fakeLoginEventRepository struct {
+	repository.LoginEventRepository
+
+	mu     sync.Mutex
+	events []*model.LoginEvent
+}
+
+func (r *fakeLoginEventRepository) CreateLoginEvent(_ context.Context, event *model.LoginEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+// testUserService は UserService とテストで確認するリポジトリ・送信したメールをまとめたものです。
+type testUserService struct {
+	*UserService
+	users          *fakeUserRepository
+	resetTokens    *fakePasswordResetTokenRepository
+	verifyTokens   *fakeEmailVerificationTokenRepository
+	totp           *fakeTOTPRepository
+	loginEvents    *fakeLoginEventRepository
+	identities     *fakeUserIdentityRepository
+	authRequests   *fakeOIDCAuthRequestRepository
+	refreshTokens  *fakeRefreshTokenRepository
+	personalTokens *fakePersonalAccessTokenRepository
+	outbox         *mailermemory.Outbox
+}
+
+// newTestUserService はインメモリのリポジトリとメールの Outbox を使う UserService を作成します。
+// メールの送信の要求は宛先ごとに連続して 3 回までに制限します。
+func newTestUserService(t *testing.T, users ...*model.User) *testUserService {
+	t.Helper()
+	cfg := &config.Config{
+		JWT: config.JWTConfig{Secret: "test-secret", DurationMinutes: 15, RefreshDurationHours: 24},
+		App: config.AppConfig{BaseURL: "https://app.example.com"},
+	}
+	keys, err := jwt.NewKeySet(cfg)
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+
+	ts := &testUserService{
+		users:          newFakeUserRepository(users...),
+		resetTokens:    newFakePasswordResetTokenRepository(),
+		verifyTokens:   newFakeEmailVerificationTokenRepository(),
+		totp:           &fakeTOTPRepository{credentials: make(map[string]*model.TOTPCredential)},
+		loginEvents:    &fakeLoginEventRepository{},
+		identities:     &fakeUserIdentityRepository{},
+		authRequests:   &fakeOIDCAuthRequestRepository{requests: make(map[string]*model.OIDCAuthRequest)},
+		refreshTokens:  &fakeRefreshTokenRepository{},
+		personalTokens: &fakePersonalAccessTokenRepository{},
+		outbox:         mailermemory.NewOutbox(),
+	}
+	limiters := &RequestLimiters{
+		UserSearch:     ratelimitmemory.NewLimiter(30, 10),
+		UserSearchByIP: ratelimitmemory.NewLimiter(60, 20),
+		MailByEmail:    ratelimitmemory.NewLimiter(1, 3),
+		MailByIP:       ratelimitmemory.NewLimiter(10, 20),
+	}
+	ts.UserService = NewUserService(
+		ts.users, ts.refreshTokens, ts.resetTokens, ts.verifyTokens, ts.totp, ts.loginEvents, ts.identities, ts.authRequests, ts.personalTokens,
+		NewLoginThrottler(memory.NewLoginThrottleRepository(), cfg),
+		jwt.NewJWTManager(cfg, keys, memory.NewTokenRevocationRepository()), nil, ts.outbox, nil, limiters, cfg,
+	)
+	return ts
+}
+
+// wait はリクエストの完了後に実行中の処理 (メールの送信など) が終わるまで待ちます。
+func (ts *testUserService) wait(t *testing.T) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := ts.WaitBackground(ctx); err != nil {
+		t.Fatalf("WaitBackground: %v", err)
+	}
+}
+
+// newTestUser はテスト用のユーザーを作成します。
+func newTestUser(t *testing.T, id, email, password string) *model.User {
+	t.Helper()
+	user, err := model.NewUser(id, id, email, password)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	return user
+}
+
+// tokenFromMail はメールの本文の APP_BASE_URL + path のリンクからトークン本体を取り出します。
+func tokenFromMail(t *testing.T, msg *mailer.Message, path string) string {
+	t.Helper()
+	for _, line := range strings.Split(msg.Body, "\n") {
+		if !strings.HasPrefix(line, "https://app.example.com"+path+"?") {
+			continue
+		}
+		link, err := url.Parse(line)
+		if err != nil {
+			t.Fatalf("parse link: %v", err)
+		}
+		return link.Query().Get("token")
+	}
+	t.Fatalf("link to %s not found in mail body:\n%s", path, msg.Body)
+	return ""
+}
+
+// fakeTaskRepository は TaskRepository のテスト用のインメモリ実装です。使用しないメソッドは実装していません。
+// 削除したタスクの子孫は、外部キーの ON DELETE CASCADE と同じように削除します。
+type fakeTaskRepository struct {
+	repository.TaskRepository
+
+	mu         sync.Mutex
+	tasks      map[string]*model.Task
+	locked     []string // GetTaskByIDForUpdate と ListDescendantsForUpdate でロックしたタスクの ID
+	plainReads []string // ロックせずに読んだタスクの ID
+
+	workspaces *fakeWorkspaceRepository // ReassignUserTasks でワークスペースのメンバーを確認する
+}
+
+func newFakeTaskRepository(tasks ...*model.Task) *fakeTaskRepository {
+	r := &fakeTaskRepository{tasks: make(map[string]*model.Task)}
+	for _, task := range tasks {
+		r.tasks[task.ID] = task
+	}
+	return r
+}
+
+func (r *fakeTaskRepository) GetTaskByID(_ context.Context, id string) (*model.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.plainReads = append(r.plainReads, id)
+	return r.get(id)
+}
+
+func (r *fakeTaskRepository) GetTaskByIDForUpdate(_ context.Context, id string) (*model.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.locked = append(r.locked, id)
+	return r.get(id)
+}
+
+func (r *fakeTaskRepository) ListDescendants(_ context.Context, rootID string) ([]*model.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	descendants := r.descendants(rootID)
+	for _, task := range descendants {
+		r.plainReads = append(r.plainReads, task.ID)
+	}
+	return descendants, nil
+}
+
+func (r *fakeTaskRepository) ListDescendantsForUpdate(_ context.Context, rootID string) ([]*model.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	descendants := r.descendants(rootID)
+	for _, task := range descendants {
+		r.locked = append(r.locked, task.ID)
+	}
+	return descendants, nil
+}
+
+func (r *fakeTaskRepository) CreateTask(_ context.Context, task *model.Task) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *task
+	r.tasks[task.ID] = &copied
+	return nil
+}
+
+func (r *fakeTaskRepository) LastRankInColumn(context.Context, model.BoardColumn, string) (string, error) {
+	return "", nil
+}
+
+func (r *fakeTaskRepository) UpdateTask(_ context.Context, task *model.Task) (*model.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current, ok := r.tasks[task.ID]
+	if !ok || current.Version != task.Version {
+		return nil, model.ErrTaskConflict
+	}
+	copied := *task
+	copied.Version++
+	r.tasks[task.ID] = &copied
+	updated := copied
+	return &updated, nil
+}
+
+func (r *fakeTaskRepository) ReparentSubtasks(_ context.Context, parentID string, newParentID *string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, task := range r.tasks {
+		if equalParent(task, parentID) {
+			task.ParentID = newParentID
+			task.Version++
+		}
+	}
+	return nil
+}
+
+func (r *fakeTaskRepository) DeleteTask(_ context.Context, id string, version int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	task, ok := r.tasks[id]
+	if !ok || task.Version != version {
+		return model.ErrTaskConflict
+	}
+	for _, descendant := range r.descendants(id) {
+		delete(r.tasks, descendant.ID)
+	}
+	delete(r.tasks, id)
+	return nil
+}
+
+// ReassignUserTasks は、successorID のユーザーがメンバーでないワークスペースのタスクの作成者を残る owner にし、
+// 担当者なしにします。それ以外のタスクは successorID のユーザーに移します。
+func (r *fakeTaskRepository) ReassignUserTasks(_ context.Context, userID, successorID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, task := range r.tasks {
+		member := task.WorkspaceID == nil || r.workspaces.role(*task.WorkspaceID, successorID) != ""
+		if task.UserID == userID {
+			if member {
+				task.UserID = successorID
+			} else {
+				task.UserID = r.workspaces.otherOwner(*task.WorkspaceID, userID)
+			}
+			task.Version++
+		}
+		if task.AssigneeID != nil && *task.AssigneeID == userID {
+			if member {
+				task.AssigneeID = &successorID
+			} else {
+				task.AssigneeID = nil
+			}
+			task.Version++
+		}
+	}
+	return nil
+}
+
+// DeleteUserTasks はユーザーが作成した個人のタスクを削除し、担当しているタスクを担当者なしにします。
+// ワークスペースのタスクの作成者を残る owner にする処理は実装していません。
+func (r *fakeTaskRepository) DeleteUserTasks(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, task := range r.tasks {
+		switch {
+		case task.UserID == userID && task.WorkspaceID == nil:
+			delete(r.tasks, id)
+		case task.AssigneeID != nil && *task.AssigneeID == userID:
+			task.AssigneeID = nil
+			task.Version++
+		}
+	}
+	return nil
+}
+
+func (r *fakeTaskRepository) AdoptProjectWorkflow(_ context.Context, projectID string, workflow *model.Workflow) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, task := range r.tasks {
+		if task.ProjectID != nil && *task.ProjectID == projectID && !workflow.Has(task.Status) {
+			task.Status = workflow.FallbackStatus(task.IsCompleted)
+			task.Version++
+		}
+	}
+	return nil
+}
+
+func (r *fakeTaskRepository) MoveProjectTasks(_ context.Context, projectID string, targetProjectID *string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, task := range r.tasks {
+		if task.ProjectID != nil && *task.ProjectID == projectID {
+			task.ProjectID = targetProjectID
+			task.Version++
+		}
+	}
+	return nil
+}
+
+func (r *fakeTaskRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return fakeDB.BeginTx(ctx, nil)
+}
+
+func (r *fakeTaskRepository) WithTx(*sql.Tx) repository.TaskRepository { return r }
+
+// get は ID のタスクの複製を返します (r.mu を保持して呼び出す)。
+func (r *fakeTaskRepository) get(id string) (*model.Task, error) {
+	task, ok := r.tasks[id]
+	if !ok {
+		return nil, model.ErrTaskNotFound
+	}
+	copied := *task
+	return &copied, nil
+}
+
+// descendants は rootID のタスクの子孫の複製を、階層の浅い順に返します (r.mu を保持して呼び出す)。
+func (r *fakeTaskRepository) descendants(rootID string) []*model.Task {
+	var descendants []*model.Task
+	for parents := []string{rootID}; len(parents) > 0; {
+		var children []string
+		for _, task := range r.tasks {
+			if task.ParentID != nil && slices.Contains(parents, *task.ParentID) {
+				copied := *task
+				descendants = append(descendants, &copied)
+				children = append(children, task.ID)
+			}
+		}
+		parents = children
+	}
+	return descendants
+}
+
+// fakeProjectRepository は ProjectRepository のテスト用のインメモリ実装です。使用しないメソッドは実装していません。
+type fakeProjectRepository struct {
+	repository.ProjectRepository
+
+	mu       sync.Mutex
+	projects map[string]*model.Project
+}
+
+func newFakeProjectRepository(projects ...*model.Project) *fakeProjectRepository {
+	r := &fakeProjectRepository{projects: make(map[string]*model.Project)}
+	for _, project := range projects {
+		r.projects[project.ID] = project
+	}
+	return r
+}
+
+func (r *fakeProjectRepository) ListUserProjectIDs(_ context.Context, userID string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []string
+	for _, project := range r.projects {
+		if project.OwnerID == userID {
+			ids = append(ids, project.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeProjectRepository) ReassignUserProjects(_ context.Context, userID, successorID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, project := range r.projects {
+		if project.OwnerID == userID {
+			project.OwnerID = successorID
+		}
+	}
+	return nil
+}
+
+func (r *fakeProjectRepository) WithTx(*sql.Tx) repository.ProjectRepository { return r }
+
+// fakeWorkspaceRepository は WorkspaceRepository のテスト用のインメモリ実装です。使用しないメソッドは実装していません。
+type fakeWorkspaceRepository struct {
+	repository.WorkspaceRepository
+
+	mu      sync.Mutex
+	members map[string]map[string]model.WorkspaceRole // ワークスペースの ID ごとの、メンバーのユーザーの ID と役割
+}
+
+func newFakeWorkspaceRepository() *fakeWorkspaceRepository {
+	return &fakeWorkspaceRepository{members: make(map[string]map[string]model.WorkspaceRole)}
+}
+
+// addMember はユーザーをワークスペースのメンバーにします。
+func (r *fakeWorkspaceRepository) addMember(workspaceID, userID string, role model.WorkspaceRole) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.members[workspaceID] == nil {
+		r.members[workspaceID] = make(map[string]model.WorkspaceRole)
+	}
+	r.members[workspaceID][userID] = role
+}
+
+// role はワークスペースでのユーザーの役割を返します (メンバーでない場合は空)。
+func (r *fakeWorkspaceRepository) role(workspaceID, userID string) model.WorkspaceRole {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.members[workspaceID][userID]
+}
+
+// otherOwner は userID のユーザー以外のワークスペースの owner を返します。
+func (r *fakeWorkspaceRepository) otherOwner(workspaceID, userID string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, role := range r.members[workspaceID] {
+		if id != userID && role == model.WorkspaceRoleOwner {
+			return id
+		}
+	}
+	return ""
+}
+
+func (r *fakeWorkspaceRepository) AddOwnerToUserWorkspaces(_ context.Context, userID, newOwnerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, members := range r.members {
+		if members[userID] == model.WorkspaceRoleOwner {
+			members[newOwnerID] = model.WorkspaceRoleOwner
+		}
+	}
+	return nil
+}
+
+func (r *fakeWorkspaceRepository) ListWorkspacesSolelyOwnedBy(_ context.Context, userID string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []string
+	for workspaceID, members := range r.members {
+		if members[userID] != model.WorkspaceRoleOwner {
+			continue
+		}
+		owners := 0
+		for _, role := range members {
+			if role == model.WorkspaceRoleOwner {
+				owners++
+			}
+		}
+		if owners == 1 {
+			ids = append(ids, workspaceID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeWorkspaceRepository) WithTx(*sql.Tx) repository.WorkspaceRepository { return r }
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxLoginEvents は ListLoginEvents で一度に返す記録の上限です。
+const maxLoginEvents = 100
+
+// ListLoginEvents はユーザーの最近のログインの試行を新しい順に返します。
+func (s *UserService) ListLoginEvents(ctx context.Context, userID string, limit int) ([]*model.LoginEvent, error) {
+	if limit <= 0 || limit > maxLoginEvents {
+		limit = maxLoginEvents
+	}
+	return s.loginEventRepository.ListLoginEventsByUser(ctx, userID, limit)
+}
+
+// recordLoginEvent はログインの試行を記録します。
+// 記録に失敗してもログインの結果は変えず、ログに出力するだけにします。
+func (s *UserService) recordLoginEvent(ctx context.Context, userID string, result model.LoginEventResult, client model.ClientInfo) {
+	event := model.NewLoginEvent(uuid.New().String(), userID, result, client)
+	if err := s.loginEventRepository.CreateLoginEvent(ctx, event); err != nil {
+		logger.FromContext(ctx).Warn("failed to record login event",
+			zap.String("user_id", userID),
+			zap.String("result", string(result)),
+			zap.Error(err),
+		)
+	}
+}
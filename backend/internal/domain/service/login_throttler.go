@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+)
+
+// LoginThrottler はアカウントとクライアント IP ごとにログインの失敗回数を記録し、
+// しきい値に達した場合は一時的にログインをロックします。
+// 試行はパスワードなどを確認する前に Reserve で失敗として数え、正しかった場合に取り消します。
+type LoginThrottler struct {
+	repository    repository.LoginThrottleRepository
+	accountPolicy model.LoginThrottlePolicy
+	ipPolicy      model.LoginThrottlePolicy
+	window        time.Duration // 最後の失敗からこの時間が経過すると失敗回数をリセットする
+}
+
+// NewLoginThrottler は新しい LoginThrottler インスタンスを作成します。
+func NewLoginThrottler(repo repository.LoginThrottleRepository, cfg *config.Config) *LoginThrottler {
+	baseLockout := time.Duration(cfg.LoginThrottle.BaseLockoutSecs) * time.Second
+	maxLockout := time.Duration(cfg.LoginThrottle.MaxLockoutMins) * time.Minute
+	return &LoginThrottler{
+		repository: repo,
+		accountPolicy: model.LoginThrottlePolicy{
+			MaxFailures: cfg.LoginThrottle.MaxAccountFailures,
+			BaseLockout: baseLockout,
+			MaxLockout:  maxLockout,
+		},
+		ipPolicy: model.LoginThrottlePolicy{
+			MaxFailures: cfg.LoginThrottle.MaxIPFailures,
+			BaseLockout: baseLockout,
+			MaxLockout:  maxLockout,
+		},
+		window: time.Duration(cfg.LoginThrottle.FailureWindowMins) * time.Minute,
+	}
+}
+
+// throttleTarget は失敗回数を記録するキーと、そのキーに適用する方針の組です。
+type throttleTarget struct {
+	key    string
+	policy model.LoginThrottlePolicy
+}
+
+// targets はログインの試行に対応するキーを返します。クライアント IP が不明な場合はアカウントのみを対象とします。
+func (t *LoginThrottler) targets(email string, client model.ClientInfo) []throttleTarget {
+	targets := []throttleTarget{{key: model.AccountThrottleKey(email), policy: t.accountPolicy}}
+	if client.IPAddress != "" {
+		targets = append(targets, throttleTarget{key: model.IPThrottleKey(client.IPAddress), policy: t.ipPolicy})
+	}
+	return targets
+}
+
+// Check はアカウントまたはクライアント IP がロック中であれば *model.LoginLockedError を返します。
+// ロック中はパスワードを確認せずに拒否し、ロックの解除までの時間が長い方を返します。
+func (t *LoginThrottler) Check(ctx context.Context, email string, client model.ClientInfo) error {
+	now := time.Now()
+	var retryAfter time.Duration
+	for _, target := range t.targets(email, client) {
+		lockedUntil, err := t.repository.GetLoginLockedUntil(ctx, target.key)
+		if err != nil {
+			return fmt.Errorf("failed to get login lock: %w", err)
+		}
+		retryAfter = max(retryAfter, lockedUntil.Sub(now))
+	}
+	if retryAfter > 0 {
+		return &model.LoginLockedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// LoginAttempt は Reserve で予約したログインの試行です。
+// 予約した試行は失敗として数えるため、パスワードなどが正しかった場合は Cancel で取り消します。
+type LoginAttempt struct {
+	throttler    *LoginThrottler
+	reservations []reservation
+}
+
+// reservation は予約で失敗回数を加算したキーです。
+type reservation struct {
+	key    string
+	locked bool // 加算後の回数がしきい値に達したため、確認の前にロックした
+}
+
+// Reserve はパスワードなどを確認する前に、ログインの試行を失敗として数えます。
+// 加算後の回数がしきい値に達した場合はその時点でロックし、同時に送られた試行がしきい値を超えて確認されないようにします。
+// アカウントまたはクライアント IP がロック中の場合は、試行を数えずに *model.LoginLockedError を返します。
+func (t *LoginThrottler) Reserve(ctx context.Context, email string, client model.ClientInfo) (*LoginAttempt, error) {
+	if err := t.Check(ctx, email, client); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	attempt := &LoginAttempt{throttler: t}
+	for _, target := range t.targets(email, client) {
+		failures, err := t.repository.RecordLoginFailure(ctx, target.key, now, t.window)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to record login failure: %w", err), attempt.Cancel(ctx))
+		}
+		attempt.reservations = append(attempt.reservations, reservation{key: target.key})
+
+		lockout := target.policy.LockoutDuration(failures)
+		if lockout == 0 {
+			continue
+		}
+		locked, err := t.repository.LockLogin(ctx, target.key, now, now.Add(lockout))
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to lock login: %w", err), attempt.Cancel(ctx))
+		}
+		if !locked {
+			// 同時に送られた他の試行がしきい値に達してロックした (ロック中の試行は数えない)
+			if err := attempt.Cancel(ctx); err != nil {
+				return nil, err
+			}
+			return nil, t.lockedError(ctx, target.key, now)
+		}
+		attempt.reservations[len(attempt.reservations)-1].locked = true
+	}
+	return attempt, nil
+}
+
+// Cancel は予約した試行を取り消し、予約でロックした場合はロックも解除します。
+func (a *LoginAttempt) Cancel(ctx context.Context) error {
+	for _, r := range a.reservations {
+		if err := a.throttler.repository.CancelLoginFailure(ctx, r.key, r.locked); err != nil {
+			return fmt.Errorf("failed to cancel login failure: %w", err)
+		}
+	}
+	a.reservations = nil
+	return nil
+}
+
+// lockedError はキーのロックが解除されるまでの時間を *model.LoginLockedError として返します。
+// 確認までの間にロックが解除された場合も、すぐに再試行させず 1 秒後とします。
+func (t *LoginThrottler) lockedError(ctx context.Context, key string, now time.Time) error {
+	lockedUntil, err := t.repository.GetLoginLockedUntil(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to get login lock: %w", err)
+	}
+	return &model.LoginLockedError{RetryAfter: max(lockedUntil.Sub(now), time.Second)}
+}
+
+// RecordSuccess はアカウントの失敗回数をリセットします。
+// クライアント IP の失敗回数は、1 つのアカウントでのログインを挟んで他のアカウントを試し続けられないようリセットしません。
+func (t *LoginThrottler) RecordSuccess(ctx context.Context, email string) error {
+	if err := t.repository.ResetLoginFailures(ctx, model.AccountThrottleKey(email)); err != nil {
+		return fmt.Errorf("failed to reset login failures: %w", err)
+	}
+	return nil
+}
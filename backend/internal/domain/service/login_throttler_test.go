@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository/memory"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+const throttleEmail = "alice@example.com"
+
+var throttleClient = model.ClientInfo{IPAddress: "192.0.2.3"}
+
+// newTestLoginThrottler はアカウントごとに maxFailures 回の失敗でロックする LoginThrottler を作成します。
+// クライアント IP のしきい値はアカウントより大きくします。
+func newTestLoginThrottler(maxFailures int, lockout time.Duration) *LoginThrottler {
+	return &LoginThrottler{
+		repository:    memory.NewLoginThrottleRepository(),
+		accountPolicy: model.LoginThrottlePolicy{MaxFailures: maxFailures, BaseLockout: lockout, MaxLockout: time.Hour},
+		ipPolicy:      model.LoginThrottlePolicy{MaxFailures: maxFailures * 10, BaseLockout: lockout, MaxLockout: time.Hour},
+		window:        time.Hour,
+	}
+}
+
+// 同時に送られた試行のうち、確認まで進めるのはしきい値の回数だけになる
+func TestLoginThrottlerReserveConcurrent(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestLoginThrottler(3, time.Minute)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		reserved int
+		locked   int
+	)
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := throttler.Reserve(ctx, throttleEmail, throttleClient)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				reserved++
+			case errors.Is(err, model.ErrTooManyLoginAttempts):
+				locked++
+			default:
+				t.Errorf("Reserve: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if reserved != 3 || locked != 17 {
+		t.Errorf("reserved = %d, locked = %d, want 3 and 17", reserved, locked)
+	}
+}
+
+// 取り消した試行は失敗として数えない
+func TestLoginThrottlerCancel(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestLoginThrottler(3, time.Minute)
+
+	for i := range 10 {
+		attempt, err := throttler.Reserve(ctx, throttleEmail, throttleClient)
+		if err != nil {
+			t.Fatalf("Reserve #%d: %v", i+1, err)
+		}
+		if err := attempt.Cancel(ctx); err != nil {
+			t.Fatalf("Cancel: %v", err)
+		}
+	}
+}
+
+// しきい値に達した試行が正しかった場合は、予約でかけたロックを解除する
+func TestLoginThrottlerCancelUnlocks(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestLoginThrottler(3, time.Minute)
+
+	for range 2 {
+		if _, err := throttler.Reserve(ctx, throttleEmail, throttleClient); err != nil {
+			t.Fatalf("Reserve: %v", err)
+		}
+	}
+	attempt, err := throttler.Reserve(ctx, throttleEmail, throttleClient)
+	if err != nil {
+		t.Fatalf("Reserve: %v", err)
+	}
+	if err := throttler.Check(ctx, throttleEmail, throttleClient); !errors.Is(err, model.ErrTooManyLoginAttempts) {
+		t.Fatalf("Check before Cancel err = %v, want ErrTooManyLoginAttempts", err)
+	}
+	if err := attempt.Cancel(ctx); err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	if err := throttler.Check(ctx, throttleEmail, throttleClient); err != nil {
+		t.Errorf("Check after Cancel err = %v, want nil", err)
+	}
+}
+
+// ロックが解除された後は、次の 1 回だけを確認まで進める (失敗するとロック時間を倍にする)
+func TestLoginThrottlerAfterLockout(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestLoginThrottler(2, 20*time.Millisecond)
+
+	for range 2 {
+		if _, err := throttler.Reserve(ctx, throttleEmail, throttleClient); err != nil {
+			t.Fatalf("Reserve: %v", err)
+		}
+	}
+	var locked *model.LoginLockedError
+	if _, err := throttler.Reserve(ctx, throttleEmail, throttleClient); !errors.As(err, &locked) {
+		t.Fatalf("Reserve while locked err = %v, want LoginLockedError", err)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if _, err := throttler.Reserve(ctx, throttleEmail, throttleClient); err != nil {
+		t.Fatalf("Reserve after lockout: %v", err)
+	}
+	if _, err := throttler.Reserve(ctx, throttleEmail, throttleClient); !errors.As(err, &locked) {
+		t.Fatalf("second Reserve after lockout err = %v, want LoginLockedError", err)
+	}
+	if locked.RetryAfter <= 20*time.Millisecond {
+		t.Errorf("RetryAfter = %v, want the doubled lockout (40ms)", locked.RetryAfter)
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// taskPageToken は ListTasks のページトークンの中身です。
+// クライアントには base64 でエンコードした不透明な文字列として渡します。
+type taskPageToken struct {
+	Scope     model.TaskScope   `json:"sc"`
+	Workspace string            `json:"w,omitempty"` // 範囲が workspace の場合のワークスペースの ID
+	SortKey   model.TaskSortKey `json:"s"`
+	Filter    string            `json:"f"` // 発行時の絞り込み条件のハッシュ
+	Cursor    *model.TaskCursor `json:"c"`
+}
+
+// encodeTaskPageToken はカーソルをページトークンにエンコードします。
+func encodeTaskPageToken(scope model.TaskScope, workspaceID string, sortKey model.TaskSortKey, filter model.TaskFilter, cursor *model.TaskCursor) (string, error) {
+	b, err := json.Marshal(&taskPageToken{
+		Scope:     scope,
+		Workspace: workspaceID,
+		SortKey:   sortKey,
+		Filter:    taskFilterHash(filter),
+		Cursor:    cursor,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeTaskPageToken はページトークンをカーソルにデコードします。
+// 範囲・ワークスペース・並び順・絞り込み条件が発行時と異なる場合はエラーを返します。
+func decodeTaskPageToken(token string, scope model.TaskScope, workspaceID string, sortKey model.TaskSortKey, filter model.TaskFilter) (*model.TaskCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, model.ErrInvalidPageToken
+	}
+	var t taskPageToken
+	if err := json.Unmarshal(b, &t); err != nil || t.Cursor == nil {
+		return nil, model.ErrInvalidPageToken
+	}
+	if t.Scope != scope || t.Workspace != workspaceID || t.SortKey != sortKey || t.Filter != taskFilterHash(filter) {
+		return nil, fmt.Errorf("%w: request parameters changed between pages", model.ErrInvalidPageToken)
+	}
+	return t.Cursor, nil
+}
+
+// taskFilterHash は絞り込み条件を比較するためのハッシュを返します。
+func taskFilterHash(filter model.TaskFilter) string {
+	b, _ := json.Marshal(filter)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
+
+// userPageToken は管理者向けの ListUsers のページトークンの中身です。
+type userPageToken struct {
+	Search string            `json:"q"` // 発行時の検索文字列のハッシュ
+	Cursor *model.UserCursor `json:"c"`
+}
+
+// encodeUserPageToken はカーソルをページトークンにエンコードします。
+func encodeUserPageToken(search string, cursor *model.UserCursor) (string, error) {
+	b, err := json.Marshal(&userPageToken{
+		Search: searchHash(search),
+		Cursor: cursor,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeUserPageToken はページトークンをカーソルにデコードします。
+// 検索文字列が発行時と異なる場合はエラーを返します。
+func decodeUserPageToken(token, search string) (*model.UserCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, model.ErrInvalidPageToken
+	}
+	var t userPageToken
+	if err := json.Unmarshal(b, &t); err != nil || t.Cursor == nil {
+		return nil, model.ErrInvalidPageToken
+	}
+	if t.Search != searchHash(search) {
+		return nil, fmt.Errorf("%w: request parameters changed between pages", model.ErrInvalidPageToken)
+	}
+	return t.Cursor, nil
+}
+
+// searchHash は検索文字列を比較するためのハッシュを返します (ページトークンに検索文字列をそのまま含めない)。
+func searchHash(search string) string {
+	sum := sha256.Sum256([]byte(search))
+	return hex.EncodeToString(sum[:8])
+}
+
+// projectPageToken は ListProjects のページトークンの中身です。
+type projectPageToken struct {
+	IncludeArchived bool                 `json:"a"`
+	Cursor          *model.ProjectCursor `json:"c"`
+}
+
+// encodeProjectPageToken はカーソルをページトークンにエンコードします。
+func encodeProjectPageToken(includeArchived bool, cursor *model.ProjectCursor) (string, error) {
+	b, err := json.Marshal(&projectPageToken{
+		IncludeArchived: includeArchived,
+		Cursor:          cursor,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeProjectPageToken はページトークンをカーソルにデコードします。
+// アーカイブ済みのプロジェクトを含めるかどうかが発行時と異なる場合はエラーを返します。
+func decodeProjectPageToken(token string, includeArchived bool) (*model.ProjectCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, model.ErrInvalidPageToken
+	}
+	var t projectPageToken
+	if err := json.Unmarshal(b, &t); err != nil || t.Cursor == nil {
+		return nil, model.ErrInvalidPageToken
+	}
+	if t.IncludeArchived != includeArchived {
+		return nil, fmt.Errorf("%w: request parameters changed between pages", model.ErrInvalidPageToken)
+	}
+	return t.Cursor, nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/google/uuid"
+)
+
+// passwordResetTokenDuration はパスワード再設定用トークンの有効期間です。
+const passwordResetTokenDuration = 30 * time.Minute
+
+// RequestPasswordReset はパスワード再設定用のトークンを発行し、リンクをメールで送信します。
+// メールアドレスが登録されているかどうかを応答の内容や時間から推測されないよう、ユーザーの検索とメールの送信は
+// 応答の後に行い、登録されていない場合や送信に失敗した場合も成功として扱います (失敗はログに出力します)。
+//
+// 宛先のメールアドレスとクライアント IP ごとに呼び出し回数を制限し、上限に達した場合は *model.RateLimitedError を返します。
+func (s *UserService) RequestPasswordReset(ctx context.Context, email string, client model.ClientInfo) error {
+	if err := s.allowMailRequest(ctx, email, client); err != nil {
+		return err
+	}
+	s.runInBackground(ctx, "failed to send password reset mail", func(ctx context.Context) error {
+		return s.sendPasswordResetEmail(ctx, email)
+	})
+	return nil
+}
+
+// sendPasswordResetEmail はメールアドレスが登録されている場合にトークンを発行し、リンクをメールで送信します。
+func (s *UserService) sendPasswordResetEmail(ctx context.Context, email string) error {
+	user, err := s.userRepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	resetToken, rawToken, err := model.NewPasswordResetToken(uuid.New().String(), user.ID, passwordResetTokenDuration)
+	if err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	// 以前に発行したトークンは無効にし、最新のメールのリンクだけを使用できるようにする
+	tx, err := s.passwordResetTokenRepository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txRepo := s.passwordResetTokenRepository.WithTx(tx)
+
+	if err := txRepo.InvalidateUserPasswordResetTokens(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to invalidate password reset tokens: %w", err)
+	}
+	if err := txRepo.CreatePasswordResetToken(ctx, resetToken); err != nil {
+		return fmt.Errorf("failed to save password reset token: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	link := s.baseURL + "/reset-password?token=" + url.QueryEscape(rawToken)
+	if err := s.mailer.Send(ctx, &mailer.Message{
+		To:      user.Email,
+		Subject: "パスワード再設定のご案内",
+		Body: "パスワード再設定のリクエストを受け付けました。\n" +
+			fmt.Sprintf("以下のリンクから %d 分以内に新しいパスワードを設定してください。\n\n", int(passwordResetTokenDuration.Minutes())) +
+			link + "\n\n" +
+			"このメールに心当たりがない場合は破棄してください。パスワードは変更されません。\n",
+	}); err != nil {
+		return fmt.Errorf("failed to send password reset mail: %w", err)
+	}
+	return nil
+}
+
+// ResetPassword はパスワード再設定用のトークンを使用してパスワードを変更します。
+// トークンは一度だけ使用でき、変更後は発行済みのアクセストークン・リフレッシュトークン・パーソナルアクセストークンをすべて失効させます。
+func (s *UserService) ResetPassword(ctx context.Context, rawToken, newPassword string) error {
+	resetToken, err := s.passwordResetTokenRepository.GetPasswordResetTokenByHash(ctx, model.HashPasswordResetToken(rawToken))
+	if err != nil {
+		return err
+	}
+	if !resetToken.IsUsable(time.Now()) {
+		return model.ErrInvalidPasswordResetToken
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, resetToken.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user by id: %w", err)
+	}
+	// トークンを使用済みにする前に検証し、入力ミスでトークンが無駄にならないようにする
+	if err := user.ChangePassword(newPassword); err != nil {
+		return err
+	}
+
+	if err := s.passwordResetTokenRepository.MarkPasswordResetTokenUsed(ctx, resetToken.ID); err != nil {
+		return err
+	}
+	if _, err := s.userRepository.UpdateUser(ctx, user); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	return s.revokeAllSessions(ctx, user.ID)
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+const (
+	resetUserEmail = "alice@example.com"
+	resetPassword  = "old-password"
+)
+
+var resetClient = model.ClientInfo{IPAddress: "192.0.2.1"}
+
+// requestPasswordReset はパスワードの再設定を要求し、送信されたメールのリンクからトークン本体を取り出します。
+func requestPasswordReset(t *testing.T, ts *testUserService) string {
+	t.Helper()
+	sent := len(ts.outbox.Messages())
+	if err := ts.RequestPasswordReset(context.Background(), resetUserEmail, resetClient); err != nil {
+		t.Fatalf("RequestPasswordReset: %v", err)
+	}
+	ts.wait(t)
+
+	messages := ts.outbox.Messages()
+	if len(messages) != sent+1 {
+		t.Fatalf("sent %d mails, want 1", len(messages)-sent)
+	}
+	msg := messages[len(messages)-1]
+	if msg.To != resetUserEmail {
+		t.Errorf("mail to = %q, want %q", msg.To, resetUserEmail)
+	}
+	return tokenFromMail(t, msg, "/reset-password")
+}
+
+func newResetTestService(t *testing.T) *testUserService {
+	t.Helper()
+	return newTestUserService(t, newTestUser(t, "alice", resetUserEmail, resetPassword))
+}
+
+func TestResetPassword(t *testing.T) {
+	ctx := context.Background()
+	ts := newResetTestService(t)
+	rawToken := requestPasswordReset(t, ts)
+
+	if err := ts.ResetPassword(ctx, rawToken, "new-password"); err != nil {
+		t.Fatalf("ResetPassword: %v", err)
+	}
+	user, _ := ts.users.GetUserByID(ctx, "alice")
+	if err := user.Authenticate("new-password"); err != nil {
+		t.Errorf("Authenticate(new password): %v", err)
+	}
+	if len(ts.refreshTokens.revoked) != 1 || len(ts.personalTokens.revoked) != 1 {
+		t.Errorf("revoked refresh tokens of %v and personal access tokens of %v, want [alice]",
+			ts.refreshTokens.revoked, ts.personalTokens.revoked)
+	}
+
+	// トークンは一度だけ使用できる
+	if err := ts.ResetPassword(ctx, rawToken, "another-password"); !errors.Is(err, model.ErrInvalidPasswordResetToken) {
+		t.Errorf("ResetPassword(used token) err = %v, want ErrInvalidPasswordResetToken", err)
+	}
+}
+
+// 入力ミス (空のパスワード) ではトークンを使用済みにしない
+func TestResetPasswordInvalidPasswordKeepsToken(t *testing.T) {
+	ctx := context.Background()
+	ts := newResetTestService(t)
+	rawToken := requestPasswordReset(t, ts)
+
+	if err := ts.ResetPassword(ctx, rawToken, ""); !errors.Is(err, model.ErrPasswordRequired) {
+		t.Fatalf("ResetPassword(empty password) err = %v, want ErrPasswordRequired", err)
+	}
+	if err := ts.ResetPassword(ctx, rawToken, "new-password"); err != nil {
+		t.Errorf("ResetPassword: %v", err)
+	}
+}
+
+func TestResetPasswordExpiredToken(t *testing.T) {
+	ts := newResetTestService(t)
+	rawToken := requestPasswordReset(t, ts)
+	ts.resetTokens.expireAll()
+
+	if err := ts.ResetPassword(context.Background(), rawToken, "new-password"); !errors.Is(err, model.ErrInvalidPasswordResetToken) {
+		t.Errorf("ResetPassword(expired token) err = %v, want ErrInvalidPasswordResetToken", err)
+	}
+}
+
+// 新しいリンクを送信すると、以前のメールのリンクは使用できなくなる
+func TestRequestPasswordResetInvalidatesOlderTokens(t *testing.T) {
+	ctx := context.Background()
+	ts := newResetTestService(t)
+	older := requestPasswordReset(t, ts)
+	newer := requestPasswordReset(t, ts)
+
+	if err := ts.ResetPassword(ctx, older, "new-password"); !errors.Is(err, model.ErrInvalidPasswordResetToken) {
+		t.Errorf("ResetPassword(older token) err = %v, want ErrInvalidPasswordResetToken", err)
+	}
+	if err := ts.ResetPassword(ctx, newer, "new-password"); err != nil {
+		t.Errorf("ResetPassword(newer token): %v", err)
+	}
+}
+
+// 登録されていないメールアドレスでも同じように成功し、メールは送信しない
+func TestRequestPasswordResetUnknownEmail(t *testing.T) {
+	ts := newResetTestService(t)
+	if err := ts.RequestPasswordReset(context.Background(), "unknown@example.com", resetClient); err != nil {
+		t.Fatalf("RequestPasswordReset: %v", err)
+	}
+	ts.wait(t)
+	if messages := ts.outbox.Messages(); len(messages) != 0 {
+		t.Errorf("sent %d mails, want 0", len(messages))
+	}
+}
+
+// メールの送信に失敗しても、登録されているかどうかが分からないよう成功として扱う
+func TestRequestPasswordResetMailFailure(t *testing.T) {
+	ts := newResetTestService(t)
+	ts.mailer = failingMailer{}
+	if err := ts.RequestPasswordReset(context.Background(), resetUserEmail, resetClient); err != nil {
+		t.Errorf("RequestPasswordReset err = %v, want nil", err)
+	}
+	ts.wait(t)
+}
+
+// 宛先のメールアドレスごとの上限は、登録されているかどうかに関わらず同じように数える
+func TestRequestPasswordResetRateLimit(t *testing.T) {
+	ctx := context.Background()
+	ts := newResetTestService(t)
+	for _, email := range []string{resetUserEmail, "unknown@example.com"} {
+		for range 3 {
+			if err := ts.RequestPasswordReset(ctx, email, resetClient); err != nil {
+				t.Fatalf("RequestPasswordReset(%s): %v", email, err)
+			}
+		}
+		var limited *model.RateLimitedError
+		if err := ts.RequestPasswordReset(ctx, email, resetClient); !errors.As(err, &limited) {
+			t.Errorf("RequestPasswordReset(%s) err = %v, want RateLimitedError", email, err)
+		}
+	}
+	ts.wait(t)
+}
+
+// failingMailer は常に送信に失敗する Mailer です。
+type failingMailer struct{}
+
+func (failingMailer) Send(context.Context, *mailer.Message) error {
+	return errors.New("smtp: connection refused")
+}
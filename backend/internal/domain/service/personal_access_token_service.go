@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/google/uuid"
+)
+
+// maxPersonalAccessTokenLifetime はパーソナルアクセストークンに設定できる有効期間の上限です。
+const maxPersonalAccessTokenLifetime = 366 * 24 * time.Hour
+
+// PersonalAccessTokenService はパーソナルアクセストークンに関するビジネスロジックを提供します。
+type PersonalAccessTokenService struct {
+	tokenRepository    repository.PersonalAccessTokenRepository
+	userRepository     repository.UserRepository
+	verificationPolicy model.EmailVerificationPolicy
+}
+
+// NewPersonalAccessTokenService は新しい PersonalAccessTokenService インスタンスを作成します。
+func NewPersonalAccessTokenService(tokenRepo repository.PersonalAccessTokenRepository, userRepo repository.UserRepository, cfg *config.Config) *PersonalAccessTokenService {
+	return &PersonalAccessTokenService{
+		tokenRepository:    tokenRepo,
+		userRepository:     userRepo,
+		verificationPolicy: newEmailVerificationPolicy(cfg),
+	}
+}
+
+// CreatePersonalAccessToken はトークンを作成し、エンティティとトークン本体を返します。
+// トークン本体はハッシュのみを保存するため、取得できるのはこのときだけです。
+func (s *PersonalAccessTokenService) CreatePersonalAccessToken(ctx context.Context, userID, name string, scopes []string, expiresAt time.Time) (*model.PersonalAccessToken, string, error) {
+	parsedScopes, err := model.ParseScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if expiresAt.After(time.Now().Add(maxPersonalAccessTokenLifetime)) {
+		return nil, "", fmt.Errorf("%w: must be within %s", model.ErrInvalidTokenExpiry, maxPersonalAccessTokenLifetime)
+	}
+
+	token, rawToken, err := model.NewPersonalAccessToken(uuid.New().String(), userID, name, parsedScopes, expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.tokenRepository.CreatePersonalAccessToken(ctx, token); err != nil {
+		return nil, "", fmt.Errorf("failed to save personal access token: %w", err)
+	}
+	token.CreatedAt = time.Now()
+	return token, rawToken, nil
+}
+
+// ListPersonalAccessTokens はユーザーの失効していないトークンを作成日時の新しい順に返します。
+func (s *PersonalAccessTokenService) ListPersonalAccessTokens(ctx context.Context, userID string) ([]*model.PersonalAccessToken, error) {
+	return s.tokenRepository.ListPersonalAccessTokensByUser(ctx, userID)
+}
+
+// RevokePersonalAccessToken はユーザーのトークンを失効させます。
+func (s *PersonalAccessTokenService) RevokePersonalAccessToken(ctx context.Context, userID, id string) error {
+	return s.tokenRepository.RevokePersonalAccessToken(ctx, id, userID)
+}
+
+// AuthenticatePersonalAccessToken はトークン本体を検証し、有効なトークンとトークンを作成したユーザーを返します。
+// 存在しない・期限切れ・失効済みのトークンはすべて model.ErrInvalidPersonalAccessToken を返します。
+// ユーザーがログインできない状態 (無効にされている、またはメールアドレスが未確認でログインを制限している) の場合も
+// トークンは使用できず、それぞれ model.ErrUserDisabled / model.ErrEmailNotVerified を返します。
+func (s *PersonalAccessTokenService) AuthenticatePersonalAccessToken(ctx context.Context, rawToken string) (*model.PersonalAccessToken, *model.User, error) {
+	token, err := s.tokenRepository.GetPersonalAccessTokenByHash(ctx, model.HashPersonalAccessToken(rawToken))
+	if err != nil {
+		if errors.Is(err, model.ErrPersonalAccessTokenNotFound) {
+			return nil, nil, model.ErrInvalidPersonalAccessToken
+		}
+		return nil, nil, err
+	}
+	if !token.IsActive(time.Now()) {
+		return nil, nil, model.ErrInvalidPersonalAccessToken
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return nil, nil, model.ErrInvalidPersonalAccessToken
+		}
+		return nil, nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+	if user.IsDisabled() {
+		return nil, nil, model.ErrUserDisabled
+	}
+	if s.verificationPolicy.BlockLogin && !user.IsEmailVerified() {
+		return nil, nil, model.ErrEmailNotVerified
+	}
+	return token, user, nil
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+const (
+	defaultProjectPageSize = 50
+	maxProjectPageSize     = 200
+)
+
+// ProjectService はプロジェクトに関するビジネスロジックを提供します。
+// プロジェクトは所有者のみが閲覧・変更でき、他のユーザーのプロジェクトは ErrPermissionDenied になります。
+type ProjectService struct {
+	projectRepository repository.ProjectRepository
+	taskRepository    repository.TaskRepository
+}
+
+// NewProjectService は新しい ProjectService インスタンスを作成します。
+func NewProjectService(projectRepo repository.ProjectRepository, taskRepo repository.TaskRepository) *ProjectService {
+	return &ProjectService{
+		projectRepository: projectRepo,
+		taskRepository:    taskRepo,
+	}
+}
+
+// CreateProject はプロジェクトを作成します。
+func (s *ProjectService) CreateProject(ctx context.Context, userID, name, description string) (*model.Project, error) {
+	project, err := model.NewProject(userID, name, description)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.projectRepository.CreateProject(ctx, project); err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+	// 作成日時などを含めて返すため読み直す
+	return s.projectRepository.GetProjectByID(ctx, project.ID)
+}
+
+// GetProject は所有者であることを確認したうえでプロジェクトを返します。
+func (s *ProjectService) GetProject(ctx context.Context, userID, id string) (*model.Project, error) {
+	project, err := s.projectRepository.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !project.IsOwnedBy(userID) {
+		return nil, model.ErrPermissionDenied
+	}
+	return project, nil
+}
+
+// ListProjects はユーザーが所有するプロジェクトを作成日時の新しい順に返します。
+// includeArchived が false の場合はアーカイブ済みのプロジェクトを含めません。続きがある場合は次のページトークンを返します。
+func (s *ProjectService) ListProjects(ctx context.Context, userID string, includeArchived bool, pageSize int, pageToken string) ([]*model.Project, string, error) {
+	switch {
+	case pageSize < 0:
+		return nil, "", model.ErrInvalidPageSize
+	case pageSize == 0:
+		pageSize = defaultProjectPageSize
+	case pageSize > maxProjectPageSize:
+		pageSize = maxProjectPageSize
+	}
+
+	var after *model.ProjectCursor
+	if pageToken != "" {
+		cursor, err := decodeProjectPageToken(pageToken, includeArchived)
+		if err != nil {
+			return nil, "", err
+		}
+		after = cursor
+	}
+
+	// 次ページの有無を判定するため 1 件多く取得する
+	projects, err := s.projectRepository.ListProjects(ctx, &model.ProjectListQuery{
+		OwnerID:         userID,
+		IncludeArchived: includeArchived,
+		Limit:           pageSize + 1,
+		After:           after,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(projects) <= pageSize {
+		return projects, "", nil
+	}
+
+	projects = projects[:pageSize]
+	nextPageToken, err := encodeProjectPageToken(includeArchived, model.NewProjectCursor(projects[len(projects)-1]))
+	if err != nil {
+		return nil, "", err
+	}
+	return projects, nextPageToken, nil
+}
+
+// UpdateProject は patch.Fields に含まれるフィールドだけを更新します。
+func (s *ProjectService) UpdateProject(ctx context.Context, userID, id string, patch *model.ProjectPatch) (*model.Project, error) {
+	project, err := s.GetProject(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.Apply(patch); err != nil {
+		return nil, err
+	}
+	return s.projectRepository.UpdateProject(ctx, project)
+}
+
+// SetProjectWorkflow はプロジェクトのタスクが従うワークフローを変更します。workflow が nil の場合は既定のワークフローに戻します。
+// 新しいワークフローにない状態のタスクがある場合は変更できません (先にタスクの状態を変更する必要があります)。
+// 完了として扱う状態が変わったタスクは、同じトランザクション内で is_completed を更新します。
+//
+// 一括で変更したタスクの変更イベントは配信しないため、WatchTasks のクライアントは ListTasks で同期し直す必要があります。
+func (s *ProjectService) SetProjectWorkflow(ctx context.Context, userID, id string, workflow *model.Workflow) (*model.Project, error) {
+	if _, err := s.GetProject(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	effective := workflow
+	if effective == nil {
+		effective = model.DefaultWorkflow()
+	}
+
+	tx, err := s.projectRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txProjectRepo := s.projectRepository.WithTx(tx)
+	txTaskRepo := s.taskRepository.WithTx(tx)
+
+	outside, err := txTaskRepo.CountProjectTasksOutsideWorkflow(ctx, id, effective)
+	if err != nil {
+		return nil, err
+	}
+	if outside > 0 {
+		return nil, fmt.Errorf("%w: %d task(s) have a status that is not in the new workflow", model.ErrWorkflowStatusInUse, outside)
+	}
+	if err := txProjectRepo.UpdateProjectWorkflow(ctx, id, workflow); err != nil {
+		return nil, fmt.Errorf("failed to update workflow: %w", err)
+	}
+	if err := txTaskRepo.SyncProjectTaskCompletion(ctx, id, effective); err != nil {
+		return nil, fmt.Errorf("failed to update task completion: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.projectRepository.GetProjectByID(ctx, id)
+}
+
+// ArchiveProject はプロジェクトをアーカイブします。アーカイブしたプロジェクトのタスクはそのまま残りますが、
+// 新しくタスクを追加することはできません。アーカイブ済みの場合は何もしません。
+func (s *ProjectService) ArchiveProject(ctx context.Context, userID, id string) (*model.Project, error) {
+	project, err := s.GetProject(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if project.IsArchived() {
+		return project, nil
+	}
+	now := time.Now()
+	project.ArchivedAt = &now
+	return s.projectRepository.UpdateProject(ctx, project)
+}
+
+// UnarchiveProject はアーカイブしたプロジェクトを元に戻します。アーカイブしていない場合は何もしません。
+func (s *ProjectService) UnarchiveProject(ctx context.Context, userID, id string) (*model.Project, error) {
+	project, err := s.GetProject(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !project.IsArchived() {
+		return project, nil
+	}
+	project.ArchivedAt = nil
+	return s.projectRepository.UpdateProject(ctx, project)
+}
+
+// DeleteProject はプロジェクトを削除します。同じトランザクション内で、プロジェクトのタスクを disposition に従って
+// プロジェクトなしにするか、targetProjectID のプロジェクトに移すか、削除してからプロジェクトを削除します。
+// 移したタスクのうち、移動先のワークフローにない状態のものは model.Workflow.FallbackStatus の状態になります。
+//
+// 一括で変更したタスクの変更イベントは配信しないため、WatchTasks のクライアントは ListTasks で同期し直す必要があります。
+func (s *ProjectService) DeleteProject(ctx context.Context, userID, id string, disposition model.ProjectTaskDisposition, targetProjectID string) error {
+	switch disposition {
+	case model.ProjectTaskDispositionMove:
+		if targetProjectID == "" || targetProjectID == id {
+			return fmt.Errorf("%w: a target project other than the deleted project is required", model.ErrInvalidTargetProject)
+		}
+	case model.ProjectTaskDispositionDetach, model.ProjectTaskDispositionDelete:
+		if targetProjectID != "" {
+			return model.ErrTargetProjectNotAllowed
+		}
+	default:
+		return fmt.Errorf("%w: %q", model.ErrInvalidProjectTaskDisposition, disposition)
+	}
+
+	if _, err := s.GetProject(ctx, userID, id); err != nil {
+		return err
+	}
+
+	tx, err := s.projectRepository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txProjectRepo := s.projectRepository.WithTx(tx)
+	txTaskRepo := s.taskRepository.WithTx(tx)
+
+	switch disposition {
+	case model.ProjectTaskDispositionDetach:
+		if err := txTaskRepo.AdoptProjectWorkflow(ctx, id, model.DefaultWorkflow()); err != nil {
+			return fmt.Errorf("failed to update task statuses: %w", err)
+		}
+		if err := txTaskRepo.MoveProjectTasks(ctx, id, nil); err != nil {
+			return fmt.Errorf("failed to detach tasks: %w", err)
+		}
+	case model.ProjectTaskDispositionMove:
+		target, err := txProjectRepo.GetProjectByID(ctx, targetProjectID)
+		if err != nil {
+			return fmt.Errorf("%w: %w", model.ErrInvalidTargetProject, err)
+		}
+		if !target.IsOwnedBy(userID) {
+			return fmt.Errorf("%w: target project is not owned by the user", model.ErrInvalidTargetProject)
+		}
+		if target.IsArchived() {
+			return fmt.Errorf("%w: target project is archived", model.ErrInvalidTargetProject)
+		}
+		if err := txTaskRepo.AdoptProjectWorkflow(ctx, id, target.EffectiveWorkflow()); err != nil {
+			return fmt.Errorf("failed to update task statuses: %w", err)
+		}
+		if err := txTaskRepo.MoveProjectTasks(ctx, id, &targetProjectID); err != nil {
+			return fmt.Errorf("failed to move tasks: %w", err)
+		}
+	case model.ProjectTaskDispositionDelete:
+		if err := txTaskRepo.DeleteProjectTasks(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete tasks: %w", err)
+		}
+	}
+
+	if err := txProjectRepo.DeleteProject(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/ratelimit"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// RequestLimiters はユーザーの列挙やメール爆撃を防ぐための呼び出し回数の制限をまとめたものです。
+type RequestLimiters struct {
+	UserSearch     ratelimit.Limiter // ユーザーの検索 (呼び出し元のユーザーごと)
+	UserSearchByIP ratelimit.Limiter // ユーザーの検索 (クライアント IP ごと)
+	MailByEmail    ratelimit.Limiter // メールの送信の要求 (宛先のメールアドレスごと)
+	MailByIP       ratelimit.Limiter // メールの送信の要求 (クライアント IP ごと)
+}
+
+// allowUserSearch はユーザーの検索を、呼び出し元のユーザーとクライアント IP ごとに制限します。
+// 複数のアカウントを使い分けた列挙も防ぐため、上限に達した場合は *model.RateLimitedError を返します。
+func (s *UserService) allowUserSearch(ctx context.Context, userID string, client model.ClientInfo) error {
+	if client.IPAddress != "" {
+		if err := allow(ctx, s.limiters.UserSearchByIP, client.IPAddress); err != nil {
+			return err
+		}
+	}
+	return allow(ctx, s.limiters.UserSearch, userID)
+}
+
+// allowMailRequest はパスワード再設定などのメールの送信の要求を、宛先のメールアドレスとクライアント IP ごとに制限します。
+// メールアドレスが登録されているかどうかに関わらず同じように数え、上限に達した場合は *model.RateLimitedError を返します。
+func (s *UserService) allowMailRequest(ctx context.Context, email string, client model.ClientInfo) error {
+	if client.IPAddress != "" {
+		if err := allow(ctx, s.limiters.MailByIP, client.IPAddress); err != nil {
+			return err
+		}
+	}
+	return allow(ctx, s.limiters.MailByEmail, strings.ToLower(strings.TrimSpace(email)))
+}
+
+// allow は limiter でキーの呼び出しを 1 回分消費し、上限に達している場合は *model.RateLimitedError を返します。
+func allow(ctx context.Context, limiter ratelimit.Limiter, key string) error {
+	retryAfter, err := limiter.Allow(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	if retryAfter > 0 {
+		return &model.RateLimitedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
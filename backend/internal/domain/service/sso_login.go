@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/google/uuid"
+)
+
+// oidcAuthRequestDuration は ID プロバイダーでのログインを完了するまでの有効期間です。
+const oidcAuthRequestDuration = 10 * time.Minute
+
+// StartSSOLogin は ID プロバイダーへの認可リクエスト (PKCE) を作成し、ユーザーをリダイレクトする URL と state を返します。
+// ログイン CSRF を防ぐため、クライアントは state を保持し、リダイレクト先で受け取った state と一致することを確認してください。
+func (s *UserService) StartSSOLogin(ctx context.Context) (string, string, error) {
+	if s.identityProvider == nil {
+		return "", "", model.ErrSSONotConfigured
+	}
+
+	req, state, err := model.NewOIDCAuthRequest(oidcAuthRequestDuration)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create oidc auth request: %w", err)
+	}
+	authURL, err := s.identityProvider.AuthCodeURL(ctx, state, req.Nonce, req.CodeChallenge())
+	if err != nil {
+		return "", "", err
+	}
+	if err := s.oidcAuthRequestRepository.CreateOIDCAuthRequest(ctx, req); err != nil {
+		return "", "", fmt.Errorf("failed to save oidc auth request: %w", err)
+	}
+	return authURL, state, nil
+}
+
+// CompleteSSOLogin は ID プロバイダーからリダイレクトで受け取った認可コードを交換し、
+// ID トークンが示すユーザーのアクセストークンと新しいファミリーのリフレッシュトークンを発行します。
+// state は一度だけ使用できます。
+//
+// 二要素認証が有効なユーザーの場合は Login と同じくトークンを発行せず、VerifySecondFactor で使用する確認待ちトークンを返します
+// (ID プロバイダー側の認証だけでは二要素認証を省略できないようにする)。
+func (s *UserService) CompleteSSOLogin(ctx context.Context, state, code string, client model.ClientInfo) (*model.LoginResult, error) {
+	if s.identityProvider == nil {
+		return nil, model.ErrSSONotConfigured
+	}
+
+	req, err := s.oidcAuthRequestRepository.ConsumeOIDCAuthRequest(ctx, model.HashOIDCState(state))
+	if err != nil {
+		return nil, err
+	}
+	if req.IsExpired(time.Now()) {
+		return nil, model.ErrInvalidSSOState
+	}
+
+	identity, err := s.identityProvider.Exchange(ctx, code, req.CodeVerifier, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userForExternalIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsDisabled() {
+		s.recordLoginEvent(ctx, user.ID, model.LoginEventDisabled, client)
+		return nil, model.ErrUserDisabled
+	}
+
+	required, err := s.requiresSecondFactor(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if required {
+		challengeToken, err := s.tokenManager.GenerateChallenge(user)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate challenge token: %w", err)
+		}
+		return &model.LoginResult{ChallengeToken: challengeToken}, nil
+	}
+
+	pair, err := s.completeLogin(ctx, user, client)
+	if err != nil {
+		return nil, err
+	}
+	return &model.LoginResult{Tokens: pair}, nil
+}
+
+// userForExternalIdentity は ID プロバイダーのアカウントに紐付くユーザーを返します。
+// 紐付けがない場合は、ID プロバイダーが確認済みのメールアドレスで登録済みのユーザーに紐付けるか、新しいユーザーを作成します。
+func (s *UserService) userForExternalIdentity(ctx context.Context, identity *model.ExternalIdentity) (*model.User, error) {
+	linked, err := s.userIdentityRepository.GetUserIdentity(ctx, identity.Issuer, identity.Subject)
+	switch {
+	case err == nil:
+		user, err := s.userRepository.GetUserByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user by id: %w", err)
+		}
+		return user, nil
+	case !errors.Is(err, model.ErrUserIdentityNotFound):
+		return nil, err
+	}
+
+	// 未確認のメールアドレスで紐付けると、他人のメールアドレスを名乗るだけでそのユーザーとしてログインできてしまう
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, model.ErrSSOEmailNotVerified
+	}
+
+	user, err := s.userRepository.GetUserByEmail(ctx, identity.Email)
+	switch {
+	case err == nil:
+		return s.linkUserIdentity(ctx, user, identity)
+	case errors.Is(err, model.ErrUserNotFound):
+		if !s.allowSSOSignup {
+			return nil, model.ErrSSOSignupDisabled
+		}
+		return s.createSSOUser(ctx, identity)
+	default:
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+}
+
+// linkUserIdentity は登録済みのユーザーを ID プロバイダーのアカウントに紐付けます。
+// メールアドレスが未確認のユーザーは、パスワードを無効にし、発行済みのトークンを失効させたうえで確認済みにします。
+func (s *UserService) linkUserIdentity(ctx context.Context, user *model.User, identity *model.ExternalIdentity) (*model.User, error) {
+	claimed := !user.IsEmailVerified()
+	user.ConfirmEmailByIdentityProvider()
+
+	tx, err := s.userRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+
+	if claimed {
+		if _, err := s.userRepository.WithTx(tx).UpdateUser(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+	if err := s.userIdentityRepository.WithTx(tx).CreateUserIdentity(ctx, newUserIdentity(user.ID, identity)); err != nil {
+		return nil, fmt.Errorf("failed to link user identity: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if claimed {
+		if err := s.revokeAllSessions(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
+// createSSOUser は ID プロバイダーのアカウントに紐付いた、パスワードのないユーザーを作成します。
+func (s *UserService) createSSOUser(ctx context.Context, identity *model.ExternalIdentity) (*model.User, error) {
+	user, err := model.NewSSOUser(uuid.New().String(), identity.DisplayName(), identity.Email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user entity: %w", err)
+	}
+
+	tx, err := s.userRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txUserRepo := s.userRepository.WithTx(tx)
+
+	if _, err := txUserRepo.CreateUser(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to create user in repository: %w", err)
+	}
+	if err := txUserRepo.MarkEmailVerified(ctx, user.ID, user.Email); err != nil {
+		return nil, fmt.Errorf("failed to mark email verified: %w", err)
+	}
+	if err := s.userIdentityRepository.WithTx(tx).CreateUserIdentity(ctx, newUserIdentity(user.ID, identity)); err != nil {
+		return nil, fmt.Errorf("failed to link user identity: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// newUserIdentity はユーザーと ID プロバイダーのアカウントの紐付けを作成します。
+func newUserIdentity(userID string, identity *model.ExternalIdentity) *model.UserIdentity {
+	return &model.UserIdentity{
+		ID:      uuid.New().String(),
+		UserID:  userID,
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+	}
+}
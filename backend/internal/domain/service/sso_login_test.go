@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/sso/oidc"
+	"github.com/a-s/connect-task-manage/internal/adapter/sso/oidc/oidctest"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+)
+
+var ssoClient = model.ClientInfo{IPAddress: "192.0.2.4"}
+
+// newSSOTestService は oidctest の ID プロバイダーでシングルサインオンする UserService を作成します。
+func newSSOTestService(t *testing.T, users ...*model.User) (*testUserService, *oidctest.Provider) {
+	t.Helper()
+	idp, err := oidctest.NewProvider("task-manage", "client-secret")
+	if err != nil {
+		t.Fatalf("oidctest.NewProvider: %v", err)
+	}
+	t.Cleanup(idp.Close)
+
+	provider, err := oidc.NewProvider(&config.Config{OIDC: config.OIDCConfig{
+		IssuerURL:    idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RedirectURL:  "https://app.example.com/oidc/callback",
+		Scopes:       []string{"openid", "email", "profile"},
+	}})
+	if err != nil {
+		t.Fatalf("oidc.NewProvider: %v", err)
+	}
+
+	ts := newTestUserService(t, users...)
+	ts.identityProvider = provider
+	ts.allowSSOSignup = true
+	return ts, idp
+}
+
+// ssoLogin は StartSSOLogin から ID プロバイダーでの認可を経て CompleteSSOLogin を呼び出します。
+func ssoLogin(t *testing.T, ts *testUserService, idp *oidctest.Provider) (*model.LoginResult, error) {
+	t.Helper()
+	ctx := context.Background()
+	authURL, state, err := ts.StartSSOLogin(ctx)
+	if err != nil {
+		t.Fatalf("StartSSOLogin: %v", err)
+	}
+	code, returnedState, err := idp.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	if returnedState != state {
+		t.Fatalf("state = %q, want %q", returnedState, state)
+	}
+	return ts.CompleteSSOLogin(ctx, state, code, ssoClient)
+}
+
+// 紐付けのないアカウントで初めてログインすると、確認済みのメールアドレスでユーザーを作成する
+func TestSSOLoginCreatesUser(t *testing.T) {
+	ts, idp := newSSOTestService(t)
+	idp.SetUser(oidctest.User{Subject: "sub-1", Email: "dave@example.com", EmailVerified: true, Name: "Dave"})
+
+	result, err := ssoLogin(t, ts, idp)
+	if err != nil {
+		t.Fatalf("CompleteSSOLogin: %v", err)
+	}
+	if result.RequiresSecondFactor() || result.Tokens.AccessToken == "" {
+		t.Fatalf("result = %+v, want tokens", result)
+	}
+	user, err := ts.users.GetUserByEmail(context.Background(), "dave@example.com")
+	if err != nil {
+		t.Fatalf("created user not found: %v", err)
+	}
+	if user.Name != "Dave" || !user.IsEmailVerified() {
+		t.Errorf("created user = %+v, want verified user named Dave", user)
+	}
+
+	// 2 回目は紐付けからユーザーを見つけ、新しいユーザーは作成しない
+	if _, err := ssoLogin(t, ts, idp); err != nil {
+		t.Fatalf("second CompleteSSOLogin: %v", err)
+	}
+	if len(ts.users.users) != 1 || len(ts.identities.identities) != 1 {
+		t.Errorf("users = %d, identities = %d, want 1 and 1", len(ts.users.users), len(ts.identities.identities))
+	}
+}
+
+// 確認済みのメールアドレスが一致する登録済みのユーザーに紐付ける
+func TestSSOLoginLinksExistingUser(t *testing.T) {
+	existing := newTestUser(t, "erin", "erin@example.com", "password")
+	ts, idp := newSSOTestService(t, existing)
+	idp.SetUser(oidctest.User{Subject: "sub-2", Email: "erin@example.com", EmailVerified: true, Name: "Erin"})
+
+	if _, err := ssoLogin(t, ts, idp); err != nil {
+		t.Fatalf("CompleteSSOLogin: %v", err)
+	}
+	if len(ts.users.users) != 1 {
+		t.Errorf("users = %d, want 1 (no new user)", len(ts.users.users))
+	}
+	if len(ts.identities.identities) != 1 || ts.identities.identities[0].UserID != existing.ID {
+		t.Errorf("identities = %+v, want one linked to %s", ts.identities.identities, existing.ID)
+	}
+	// メールアドレスが未確認だったユーザーは、パスワードを無効にし、発行済みのトークンを失効させる
+	user, _ := ts.users.GetUserByID(context.Background(), existing.ID)
+	if !user.IsEmailVerified() || user.Authenticate("password") == nil {
+		t.Errorf("linked user verified=%v, password still usable", user.IsEmailVerified())
+	}
+	if len(ts.refreshTokens.revoked) != 1 {
+		t.Errorf("revoked refresh tokens of %v, want [%s]", ts.refreshTokens.revoked, existing.ID)
+	}
+}
+
+// ID プロバイダーがメールアドレスを確認していない場合は、紐付けもユーザーの作成もしない
+func TestSSOLoginUnverifiedEmail(t *testing.T) {
+	existing := newTestUser(t, "erin", "erin@example.com", "password")
+	ts, idp := newSSOTestService(t, existing)
+	idp.SetUser(oidctest.User{Subject: "sub-3", Email: "erin@example.com", EmailVerified: false})
+
+	if _, err := ssoLogin(t, ts, idp); !errors.Is(err, model.ErrSSOEmailNotVerified) {
+		t.Errorf("CompleteSSOLogin err = %v, want ErrSSOEmailNotVerified", err)
+	}
+	if len(ts.identities.identities) != 0 {
+		t.Errorf("identities = %+v, want none", ts.identities.identities)
+	}
+}
+
+// 保存した認可リクエストと異なるコード検証子や nonce では、ログインできない
+func TestSSOLoginRejectsMismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(req *model.OIDCAuthRequest)
+	}{
+		{name: "PKCE のコード検証子", tamper: func(req *model.OIDCAuthRequest) { req.CodeVerifier += "x" }},
+		{name: "nonce", tamper: func(req *model.OIDCAuthRequest) { req.Nonce += "x" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, idp := newSSOTestService(t)
+			ts.authRequests.tamper = tt.tamper
+
+			if _, err := ssoLogin(t, ts, idp); !errors.Is(err, model.ErrSSOAuthentication) {
+				t.Errorf("CompleteSSOLogin err = %v, want ErrSSOAuthentication", err)
+			}
+			if len(ts.users.users) != 0 {
+				t.Errorf("users = %d, want 0", len(ts.users.users))
+			}
+		})
+	}
+}
+
+// state は一度だけ使用できる
+func TestSSOLoginStateSingleUse(t *testing.T) {
+	ctx := context.Background()
+	ts, idp := newSSOTestService(t)
+	authURL, state, err := ts.StartSSOLogin(ctx)
+	if err != nil {
+		t.Fatalf("StartSSOLogin: %v", err)
+	}
+	code, _, err := idp.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	if _, err := ts.CompleteSSOLogin(ctx, state, code, ssoClient); err != nil {
+		t.Fatalf("CompleteSSOLogin: %v", err)
+	}
+	if _, err := ts.CompleteSSOLogin(ctx, state, code, ssoClient); !errors.Is(err, model.ErrInvalidSSOState) {
+		t.Errorf("CompleteSSOLogin(reused state) err = %v, want ErrInvalidSSOState", err)
+	}
+}
+
+// 二要素認証が有効なユーザーには、Login と同じくトークンの代わりに確認待ちトークンを返す
+func TestSSOLoginRequiresSecondFactor(t *testing.T) {
+	existing := newTestUser(t, "frank", "frank@example.com", "password")
+	ts, idp := newSSOTestService(t, existing)
+	ts.totp.enable(existing.ID)
+	idp.SetUser(oidctest.User{Subject: "sub-4", Email: "frank@example.com", EmailVerified: true})
+
+	result, err := ssoLogin(t, ts, idp)
+	if err != nil {
+		t.Fatalf("CompleteSSOLogin: %v", err)
+	}
+	if !result.RequiresSecondFactor() || result.Tokens != nil {
+		t.Fatalf("result = %+v, want a challenge token only", result)
+	}
+	claims, err := ts.tokenManager.VerifyChallenge(context.Background(), result.ChallengeToken)
+	if err != nil {
+		t.Fatalf("VerifyChallenge: %v", err)
+	}
+	if claims.UserID != existing.ID {
+		t.Errorf("challenge user = %q, want %q", claims.UserID, existing.ID)
+	}
+}
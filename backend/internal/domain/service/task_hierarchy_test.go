@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	eventmemory "github.com/a-s/connect-task-manage/internal/adapter/event/memory"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+const hierarchyUserID = "alice"
+
+// hierarchyTask は hierarchyUserID のユーザーが作成した個人のタスクを返します。parentID が空の場合は親なしです。
+func hierarchyTask(t *testing.T, id, parentID string) *model.Task {
+	t.Helper()
+	task, err := model.NewTask(id, "", hierarchyUserID, model.PriorityMedium, nil)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+	task.ID = id
+	if parentID != "" {
+		task.ParentID = &parentID
+	}
+	return task
+}
+
+func newHierarchyTestService(tasks *fakeTaskRepository) *TaskService {
+	return &TaskService{
+		taskRepository: tasks,
+		eventBroker:    eventmemory.NewTaskEventBroker(),
+	}
+}
+
+// assertLockedOnly は ids のタスクがすべてロックして読まれ、ロックせずには読まれていないことを確認します。
+func assertLockedOnly(t *testing.T, tasks *fakeTaskRepository, ids ...string) {
+	t.Helper()
+	for _, id := range ids {
+		if !slices.Contains(tasks.locked, id) {
+			t.Errorf("task %s was not locked (locked %v)", id, tasks.locked)
+		}
+		if slices.Contains(tasks.plainReads, id) {
+			t.Errorf("task %s was read without a lock", id)
+		}
+	}
+}
+
+// サブタスクを作成するときは、親の祖先の鎖をロックしてから階層の深さを確認する
+func TestCreateSubtaskLocksAncestors(t *testing.T) {
+	tasks := newFakeTaskRepository(
+		hierarchyTask(t, "a", ""),
+		hierarchyTask(t, "b", "a"),
+	)
+	s := newHierarchyTestService(tasks)
+	parentID := "b"
+
+	if err := s.CreateTask(context.Background(), "c", "", hierarchyUserID, string(model.PriorityMedium), nil, nil, nil, &parentID); err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	var created *model.Task
+	for _, task := range tasks.tasks {
+		if task.Title == "c" {
+			created = task
+		}
+	}
+	if created == nil || !equalParent(created, "b") {
+		t.Fatalf("created = %+v, want a subtask of b", created)
+	}
+	assertLockedOnly(t, tasks, "b", "a")
+}
+
+// 親を変更するときは、タスクと子孫、新しい親の祖先の鎖をロックしてから確認する
+func TestUpdateTaskParentLocksHierarchy(t *testing.T) {
+	tasks := newFakeTaskRepository(
+		hierarchyTask(t, "a", ""),
+		hierarchyTask(t, "b", "a"),
+		hierarchyTask(t, "c", ""),
+		hierarchyTask(t, "d", "c"),
+	)
+	s := newHierarchyTestService(tasks)
+	parentID := "b"
+
+	updated, err := s.UpdateTask(context.Background(), hierarchyUserID, "c", nil,
+		&model.TaskPatch{Fields: []model.TaskField{model.TaskFieldParentID}, ParentID: &parentID})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if !equalParent(updated, "b") {
+		t.Errorf("parent = %v, want b", updated.ParentID)
+	}
+	assertLockedOnly(t, tasks, "c", "d", "b", "a")
+}
+
+// 親を変更しない場合は、タスクの階層をロックしない
+func TestUpdateTaskWithoutParentDoesNotLock(t *testing.T) {
+	tasks := newFakeTaskRepository(hierarchyTask(t, "a", ""))
+	s := newHierarchyTestService(tasks)
+
+	if _, err := s.UpdateTask(context.Background(), hierarchyUserID, "a", nil,
+		&model.TaskPatch{Fields: []model.TaskField{model.TaskFieldTitle}, Title: "renamed"}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if len(tasks.locked) != 0 {
+		t.Errorf("locked %v, want none", tasks.locked)
+	}
+}
+
+// ロックして読んだ祖先の鎖で循環を確認する
+func TestUpdateTaskParentRejectsCycle(t *testing.T) {
+	tasks := newFakeTaskRepository(
+		hierarchyTask(t, "a", ""),
+		hierarchyTask(t, "b", "a"),
+		hierarchyTask(t, "c", "b"),
+	)
+	s := newHierarchyTestService(tasks)
+	parentID := "c"
+
+	_, err := s.UpdateTask(context.Background(), hierarchyUserID, "a", nil,
+		&model.TaskPatch{Fields: []model.TaskField{model.TaskFieldParentID}, ParentID: &parentID})
+	if !errors.Is(err, model.ErrTaskCycle) {
+		t.Fatalf("UpdateTask err = %v, want ErrTaskCycle", err)
+	}
+	if tasks.tasks["a"].ParentID != nil {
+		t.Errorf("parent of a = %v, want unchanged", *tasks.tasks["a"].ParentID)
+	}
+	assertLockedOnly(t, tasks, "a", "b", "c")
+}
+
+// 子孫ごと削除するときは、ロックした子孫を確認し、外部キーで削除される子孫と一致させる
+func TestDeleteTaskCascadeLocksDescendants(t *testing.T) {
+	tasks := newFakeTaskRepository(
+		hierarchyTask(t, "a", ""),
+		hierarchyTask(t, "b", "a"),
+		hierarchyTask(t, "c", "b"),
+		hierarchyTask(t, "other", ""),
+	)
+	s := newHierarchyTestService(tasks)
+
+	if err := s.DeleteTask(context.Background(), hierarchyUserID, "a", nil, model.SubtaskDispositionUnspecified); !errors.Is(err, model.ErrTaskHasSubtasks) {
+		t.Fatalf("DeleteTask(unspecified) err = %v, want ErrTaskHasSubtasks", err)
+	}
+	if len(tasks.tasks) != 4 {
+		t.Fatalf("tasks = %d, want 4 (nothing deleted)", len(tasks.tasks))
+	}
+
+	if err := s.DeleteTask(context.Background(), hierarchyUserID, "a", nil, model.SubtaskDispositionCascade); err != nil {
+		t.Fatalf("DeleteTask(cascade): %v", err)
+	}
+	if _, ok := tasks.tasks["other"]; len(tasks.tasks) != 1 || !ok {
+		t.Errorf("remaining tasks = %v, want only other", tasks.tasks)
+	}
+	assertLockedOnly(t, tasks, "a", "b", "c")
+}
+
+// 子を付け替えるときは、ロックした子を削除するタスクの親に付け替える
+func TestDeleteTaskReparent(t *testing.T) {
+	tasks := newFakeTaskRepository(
+		hierarchyTask(t, "a", ""),
+		hierarchyTask(t, "b", "a"),
+		hierarchyTask(t, "c", "b"),
+	)
+	s := newHierarchyTestService(tasks)
+
+	if err := s.DeleteTask(context.Background(), hierarchyUserID, "b", nil, model.SubtaskDispositionReparent); err != nil {
+		t.Fatalf("DeleteTask(reparent): %v", err)
+	}
+	if _, ok := tasks.tasks["b"]; ok {
+		t.Error("task b was not deleted")
+	}
+	if !equalParent(tasks.tasks["c"], "a") {
+		t.Errorf("parent of c = %v, want a", tasks.tasks["c"].ParentID)
+	}
+	// 付け替えた子はコミット後に読み直して通知するため、ロックしたかどうかだけを確認する
+	for _, id := range []string{"b", "c"} {
+		if !slices.Contains(tasks.locked, id) {
+			t.Errorf("task %s was not locked (locked %v)", id, tasks.locked)
+		}
+	}
+}
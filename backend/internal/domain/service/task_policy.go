@@ -0,0 +1,88 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// タスクの権限ポリシー
+//
+// 個人のタスク (ワークスペースに属さないタスク):
+//   - 作成者 (user_id): 閲覧・全フィールドの更新・削除ができる
+//   - 担当者 (assignee_id): 閲覧と assigneeEditableFields に含まれるフィールド (状態とボードの並び順) の更新のみできる
+//   - それ以外のユーザー: 何もできない
+//
+// ワークスペースのタスクは、ワークスペースでの役割 (role) だけで判断する
+// (作成者や担当者であっても、メンバーでなくなった場合は何もできない):
+//   - viewer: 閲覧のみできる
+//   - member: 閲覧・全フィールドの更新と、自分が作成したタスクの削除ができる
+//   - admin / owner: 加えて、すべてのタスクを削除できる
+//
+// 以下の関数の role は、ユーザーのタスクのワークスペースでの役割です (個人のタスクやメンバーでない場合は空)。
+
+// assigneeEditableFields は個人のタスクの担当者が変更できるフィールドの一覧
+var assigneeEditableFields = map[model.TaskField]struct{}{
+	model.TaskFieldStatus: {},
+	model.TaskFieldRank:   {},
+}
+
+// authorizeTaskView はユーザーがタスクを閲覧できることを確認します。
+func authorizeTaskView(task *model.Task, userID string, role model.WorkspaceRole) error {
+	if task.InWorkspace() {
+		if role.Includes(model.WorkspaceRoleViewer) {
+			return nil
+		}
+		return model.ErrPermissionDenied
+	}
+	if task.IsCreatedBy(userID) || task.IsAssignedTo(userID) {
+		return nil
+	}
+	return model.ErrPermissionDenied
+}
+
+// authorizeTaskUpdate は fields の変更がユーザーに許可されているかを確認します。
+func authorizeTaskUpdate(task *model.Task, userID string, role model.WorkspaceRole, fields []model.TaskField) error {
+	if task.InWorkspace() {
+		if role.Includes(model.WorkspaceRoleMember) {
+			return nil
+		}
+		return model.ErrPermissionDenied
+	}
+	if task.IsCreatedBy(userID) {
+		return nil
+	}
+	if !task.IsAssignedTo(userID) {
+		return model.ErrPermissionDenied
+	}
+	for _, field := range fields {
+		if _, ok := assigneeEditableFields[field]; !ok {
+			return fmt.Errorf("%w: assignee cannot change %s", model.ErrPermissionDenied, field)
+		}
+	}
+	return nil
+}
+
+// authorizeTaskDelete はユーザーがタスクを削除できることを確認します。
+func authorizeTaskDelete(task *model.Task, userID string, role model.WorkspaceRole) error {
+	if task.InWorkspace() {
+		if role.Includes(model.WorkspaceRoleAdmin) || (role.Includes(model.WorkspaceRoleMember) && task.IsCreatedBy(userID)) {
+			return nil
+		}
+		return model.ErrPermissionDenied
+	}
+	if task.IsCreatedBy(userID) {
+		return nil
+	}
+	return model.ErrPermissionDenied
+}
+
+// canViewTaskEvent は変更の前後いずれかでユーザーがタスクを閲覧できたかを返します。
+// 担当者から外れたユーザーにも、外れたことを示す更新イベントが届くようにするためです。
+// タスクのワークスペースは変更できないため、role は変更の前後で共通です。
+func canViewTaskEvent(e *model.TaskEvent, userID string, role model.WorkspaceRole) bool {
+	if authorizeTaskView(e.Task, userID, role) == nil {
+		return true
+	}
+	return e.Previous != nil && authorizeTaskView(e.Previous, userID, role) == nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+const (
+	policyCreatorID  = "creator"
+	policyAssigneeID = "assignee"
+	policyStrangerID = "stranger"
+	policyWorkspace  = "workspace"
+)
+
+// policyTask はポリシーのテストで使うタスクを返します。workspace が true の場合はワークスペースのタスクです。
+func policyTask(workspace bool) *model.Task {
+	assigneeID := policyAssigneeID
+	task := &model.Task{
+		ID:         "task",
+		UserID:     policyCreatorID,
+		AssigneeID: &assigneeID,
+	}
+	if workspace {
+		workspaceID := policyWorkspace
+		task.WorkspaceID = &workspaceID
+	}
+	return task
+}
+
+func TestAuthorizeTaskView(t *testing.T) {
+	tests := []struct {
+		name      string
+		workspace bool
+		userID    string
+		role      model.WorkspaceRole
+		wantErr   bool
+	}{
+		{name: "作成者", userID: policyCreatorID},
+		{name: "担当者", userID: policyAssigneeID},
+		{name: "それ以外のユーザー", userID: policyStrangerID, wantErr: true},
+		{name: "ワークスペースの viewer", workspace: true, userID: policyStrangerID, role: model.WorkspaceRoleViewer},
+		{name: "ワークスペースの member", workspace: true, userID: policyStrangerID, role: model.WorkspaceRoleMember},
+		{name: "ワークスペースの admin", workspace: true, userID: policyStrangerID, role: model.WorkspaceRoleAdmin},
+		{name: "メンバーでなくなった作成者", workspace: true, userID: policyCreatorID, wantErr: true},
+		{name: "メンバーでなくなった担当者", workspace: true, userID: policyAssigneeID, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeTaskView(policyTask(tt.workspace), tt.userID, tt.role)
+			checkPolicyError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAuthorizeTaskUpdate(t *testing.T) {
+	allFields := []model.TaskField{
+		model.TaskFieldTitle, model.TaskFieldDescription, model.TaskFieldStatus,
+		model.TaskFieldAssigneeID, model.TaskFieldPriority, model.TaskFieldDueDate,
+	}
+	tests := []struct {
+		name      string
+		workspace bool
+		userID    string
+		role      model.WorkspaceRole
+		fields    []model.TaskField
+		wantErr   bool
+	}{
+		{name: "作成者は全フィールドを変更できる", userID: policyCreatorID, fields: allFields},
+		{name: "担当者は状態を変更できる", userID: policyAssigneeID, fields: []model.TaskField{model.TaskFieldStatus}},
+		{name: "担当者は並び順を変更できる", userID: policyAssigneeID, fields: []model.TaskField{model.TaskFieldStatus, model.TaskFieldRank}},
+		{name: "担当者はタイトルを変更できない", userID: policyAssigneeID, fields: []model.TaskField{model.TaskFieldTitle}, wantErr: true},
+		{name: "担当者は状態と一緒でも担当者を変更できない", userID: policyAssigneeID, fields: []model.TaskField{model.TaskFieldStatus, model.TaskFieldAssigneeID}, wantErr: true},
+		{name: "それ以外のユーザーは状態も変更できない", userID: policyStrangerID, fields: []model.TaskField{model.TaskFieldStatus}, wantErr: true},
+		{name: "ワークスペースの viewer は変更できない", workspace: true, userID: policyCreatorID, role: model.WorkspaceRoleViewer, fields: []model.TaskField{model.TaskFieldStatus}, wantErr: true},
+		{name: "ワークスペースの member は全フィールドを変更できる", workspace: true, userID: policyStrangerID, role: model.WorkspaceRoleMember, fields: allFields},
+		{name: "ワークスペースの admin は全フィールドを変更できる", workspace: true, userID: policyStrangerID, role: model.WorkspaceRoleAdmin, fields: allFields},
+		{name: "メンバーでなくなった作成者は変更できない", workspace: true, userID: policyCreatorID, fields: []model.TaskField{model.TaskFieldTitle}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeTaskUpdate(policyTask(tt.workspace), tt.userID, tt.role, tt.fields)
+			checkPolicyError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAuthorizeTaskDelete(t *testing.T) {
+	tests := []struct {
+		name      string
+		workspace bool
+		userID    string
+		role      model.WorkspaceRole
+		wantErr   bool
+	}{
+		{name: "作成者", userID: policyCreatorID},
+		{name: "担当者", userID: policyAssigneeID, wantErr: true},
+		{name: "それ以外のユーザー", userID: policyStrangerID, wantErr: true},
+		{name: "ワークスペースの viewer (作成者)", workspace: true, userID: policyCreatorID, role: model.WorkspaceRoleViewer, wantErr: true},
+		{name: "ワークスペースの member (作成者)", workspace: true, userID: policyCreatorID, role: model.WorkspaceRoleMember},
+		{name: "ワークスペースの member (作成者以外)", workspace: true, userID: policyAssigneeID, role: model.WorkspaceRoleMember, wantErr: true},
+		{name: "ワークスペースの admin", workspace: true, userID: policyStrangerID, role: model.WorkspaceRoleAdmin},
+		{name: "ワークスペースの owner", workspace: true, userID: policyStrangerID, role: model.WorkspaceRoleOwner},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeTaskDelete(policyTask(tt.workspace), tt.userID, tt.role)
+			checkPolicyError(t, err, tt.wantErr)
+		})
+	}
+}
+
+// checkPolicyError は wantErr に応じて err が nil か ErrPermissionDenied であることを確認します。
+func checkPolicyError(t *testing.T, err error, wantErr bool) {
+	t.Helper()
+	if wantErr {
+		if !errors.Is(err, model.ErrPermissionDenied) {
+			t.Fatalf("err = %v, want ErrPermissionDenied", err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+}
@@ -4,62 +4,793 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
+	"github.com/a-s/connect-task-manage/internal/adapter/event"
 	"github.com/a-s/connect-task-manage/internal/adapter/repository"
 	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
 )
 
 type TaskService struct {
-	taskRepository repository.TaskRepository
+	taskRepository      repository.TaskRepository
+	userRepository      repository.UserRepository
+	projectRepository   repository.ProjectRepository
+	workspaceRepository repository.WorkspaceRepository
+	eventBroker         event.TaskEventBroker
+	verificationPolicy  model.EmailVerificationPolicy
+	lockTx              *sql.Tx // タスクの階層をロックするトランザクション (withTaskLocks で作成した場合のみ)
 }
 
-func NewTaskService(taskRepo repository.TaskRepository) *TaskService {
-	return &TaskService{taskRepository: taskRepo}
+func NewTaskService(taskRepo repository.TaskRepository, userRepo repository.UserRepository, projectRepo repository.ProjectRepository, workspaceRepo repository.WorkspaceRepository, eventBroker event.TaskEventBroker, cfg *config.Config) *TaskService {
+	return &TaskService{
+		taskRepository:      taskRepo,
+		userRepository:      userRepo,
+		projectRepository:   projectRepo,
+		workspaceRepository: workspaceRepo,
+		eventBroker:         eventBroker,
+		verificationPolicy:  newEmailVerificationPolicy(cfg),
+	}
 }
 
 func (s *TaskService) WithTx(tx *sql.Tx) *TaskService {
 	return &TaskService{
-		taskRepository: s.taskRepository.WithTx(tx),
+		taskRepository:      s.taskRepository.WithTx(tx),
+		userRepository:      s.userRepository,
+		projectRepository:   s.projectRepository,
+		workspaceRepository: s.workspaceRepository,
+		eventBroker:         s.eventBroker, // eventBroker は共通
+		verificationPolicy:  s.verificationPolicy,
+	}
+}
+
+// withTaskLocks は tx 内でタスクを読むときに行をロックする TaskService を返します。
+// 親の変更やサブタスクのあるタスクの削除では、対象のタスクと子孫、親の祖先の鎖をロックしてから確認するため、
+// 同時に階層を変更するリクエストは確認から書き込みまで直列になります。
+func (s *TaskService) withTaskLocks(tx *sql.Tx) *TaskService {
+	txService := s.WithTx(tx)
+	txService.lockTx = tx
+	return txService
+}
+
+// loadTask は ID でタスクを取得します。withTaskLocks で作成した場合は行をロックします。
+func (s *TaskService) loadTask(ctx context.Context, id string) (*model.Task, error) {
+	if s.lockTx != nil {
+		return s.taskRepository.GetTaskByIDForUpdate(ctx, id)
+	}
+	return s.GetTaskByID(ctx, id)
+}
+
+// loadDescendants はタスクの子孫すべてを取得します。withTaskLocks で作成した場合は行をロックします。
+func (s *TaskService) loadDescendants(ctx context.Context, id string) ([]*model.Task, error) {
+	if s.lockTx != nil {
+		return s.taskRepository.ListDescendantsForUpdate(ctx, id)
+	}
+	return s.taskRepository.ListDescendants(ctx, id)
+}
+
+// CreateTask はタスクを作成します。projectID を指定した場合は、そのプロジェクトにタスクを追加します。
+// workspaceID を指定した場合はワークスペースのタスクとして作成します (member 以上の役割が必要です)。
+// parentID を指定した場合は、そのタスクのサブタスクとして作成します。
+// 作成したタスクはボードの列の末尾に並べます。
+// サブタスクを作成する場合は、同時に親を変更するリクエストと確認した階層の深さが食い違わないよう、
+// トランザクション内で親の祖先の鎖をロックしてから確認して作成します。
+func (s *TaskService) CreateTask(ctx context.Context, title, description, userID string, priority string, dueDate *time.Time, projectID, workspaceID, parentID *string) error {
+	var task *model.Task
+	if parentID == nil {
+		var err error
+		if task, err = s.createTask(ctx, title, description, userID, priority, dueDate, projectID, workspaceID, nil); err != nil {
+			return err
+		}
+	} else {
+		tx, err := s.taskRepository.BeginTx(ctx)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // Commit 後の呼び出しは何もしない
+
+		if task, err = s.withTaskLocks(tx).createTask(ctx, title, description, userID, priority, dueDate, projectID, workspaceID, parentID); err != nil {
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+
+	// 作成日時などを含めて通知するため読み直す
+	created, err := s.taskRepository.GetTaskByID(ctx, task.ID)
+	if err != nil {
+		return err
 	}
+	s.eventBroker.Publish(model.NewTaskEvent(model.TaskEventCreated, created, nil))
+	return nil
 }
 
-func (s *TaskService) CreateTask(ctx context.Context, title, description, userID string, priority string, dueDate *time.Time) error {
+// createTask は CreateTask の確認と作成を行い、作成したタスクを返します (作成イベントは呼び出し元で配信します)。
+func (s *TaskService) createTask(ctx context.Context, title, description, userID string, priority string, dueDate *time.Time, projectID, workspaceID, parentID *string) (*model.Task, error) {
 	task, err := model.NewTask(title, description, userID, model.Priority(priority), dueDate) // model.Priority に変換
 	if err != nil {
+		return nil, err
+	}
+	if workspaceID != nil {
+		role, err := s.memberRole(ctx, *workspaceID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if role == "" {
+			// 参加していないワークスペースの存在を明かさない
+			return nil, model.ErrWorkspaceNotFound
+		}
+		if !role.Includes(model.WorkspaceRoleMember) {
+			return nil, model.ErrPermissionDenied
+		}
+		task.WorkspaceID = workspaceID
+	}
+	if projectID != nil {
+		project, err := s.checkProjectAssignable(ctx, task, *projectID)
+		if err != nil {
+			return nil, err
+		}
+		task.ProjectID = projectID
+		task.ResetStatus(project.EffectiveWorkflow())
+	}
+	if parentID != nil {
+		if err := s.checkParent(ctx, userID, task, *parentID, 1); err != nil {
+			return nil, err
+		}
+		task.ParentID = parentID
+	}
+	rank, err := s.rankInColumn(ctx, task.BoardColumn(), nil, false, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	task.Rank = rank
+	if err := s.taskRepository.CreateTask(ctx, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// UpdateTask は権限ポリシーを確認したうえで patch.Fields に含まれるフィールドだけを更新します。
+// expectedVersion を指定した場合は現在のバージョンと一致するときのみ更新します。
+// 状態の変更は、タスクが従うワークフロー (プロジェクトのワークフローまたは既定のワークフロー) で許可された遷移に限ります。
+// 状態やプロジェクトの変更でボードの列が変わる場合は、移動先の列の末尾に並べます。
+// 親を変更する場合は、循環や階層の深さの上限を超えないことを確認します。同時に親を変更するリクエストと
+// 循環を作らないよう、トランザクション内でタスクと子孫、新しい親の祖先の鎖をロックしてから確認して更新します。
+func (s *TaskService) UpdateTask(ctx context.Context, userID, id string, expectedVersion *int64, patch *model.TaskPatch) (*model.Task, error) {
+	if !slices.Contains(patch.Fields, model.TaskFieldParentID) {
+		updated, before, err := s.updateTask(ctx, userID, id, expectedVersion, patch)
+		if err != nil {
+			return nil, err
+		}
+		s.eventBroker.Publish(model.NewTaskEvent(model.TaskEventUpdated, updated, before))
+		return updated, nil
+	}
+
+	tx, err := s.taskRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+
+	updated, before, err := s.withTaskLocks(tx).updateTask(ctx, userID, id, expectedVersion, patch)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.eventBroker.Publish(model.NewTaskEvent(model.TaskEventUpdated, updated, before))
+	return updated, nil
+}
+
+// updateTask は UpdateTask の確認と更新を行い、更新後と更新前のタスクを返します (変更イベントは呼び出し元で配信します)。
+func (s *TaskService) updateTask(ctx context.Context, userID, id string, expectedVersion *int64, patch *model.TaskPatch) (*model.Task, *model.Task, error) {
+	task, err := s.loadTask(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	role, err := s.taskRole(ctx, task, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := authorizeTaskView(task, userID, role); err != nil {
+		return nil, nil, err
+	}
+	if err := task.CheckVersion(expectedVersion); err != nil {
+		return nil, nil, err
+	}
+
+	// プロジェクトを変更する場合は変更先のワークフローに従う
+	projectID := task.ProjectID
+	if slices.Contains(patch.Fields, model.TaskFieldProjectID) {
+		projectID = patch.ProjectID
+	}
+	workflow, err := s.workflowFor(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	before := *task
+	if err := task.Apply(patch, workflow); err != nil {
+		return nil, nil, err
+	}
+	changed := task.ChangedFields(&before)
+	if err := authorizeTaskUpdate(&before, userID, role, changed); err != nil {
+		return nil, nil, err
+	}
+	if slices.Contains(changed, model.TaskFieldAssigneeID) && task.AssigneeID != nil {
+		if err := s.checkAssignable(ctx, task, *task.AssigneeID); err != nil {
+			return nil, nil, err
+		}
+	}
+	if slices.Contains(changed, model.TaskFieldProjectID) && task.ProjectID != nil {
+		if _, err := s.checkProjectAssignable(ctx, task, *task.ProjectID); err != nil {
+			return nil, nil, err
+		}
+	}
+	if slices.Contains(changed, model.TaskFieldParentID) && task.ParentID != nil {
+		descendants, err := s.loadDescendants(ctx, task.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := s.checkParent(ctx, userID, task, *task.ParentID, model.NewTaskTree(task, descendants).Depth()); err != nil {
+			return nil, nil, err
+		}
+	}
+	if column := task.BoardColumn(); !column.Equal(before.BoardColumn()) {
+		rank, err := s.rankInColumn(ctx, column, nil, false, task.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		task.Rank = rank
+	}
+
+	updated, err := s.taskRepository.UpdateTask(ctx, task)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, &before, nil
+}
+
+// MoveTask はタスクをボードの列の中で beforeID のタスクの直前、または afterID のタスクの直後に移動します
+// (どちらも空の場合は列の末尾)。status を指定した場合は、ワークフローで許可された遷移であれば
+// その状態の列に移してから並べます。基準のタスクは移動先の列のタスクである必要があります。
+// 移動するタスクの並び順のキーだけを変更しますが、前後のキーの間に余地がない場合は列のキーを振り直します
+// (振り直したタスクの変更イベントは配信しないため、WatchTasks のクライアントは ListTasks で同期し直す必要があります)。
+func (s *TaskService) MoveTask(ctx context.Context, userID, id string, expectedVersion *int64, status model.TaskStatus, beforeID, afterID string) (*model.Task, error) {
+	if beforeID != "" && afterID != "" {
+		return nil, fmt.Errorf("%w: only one of before and after can be specified", model.ErrInvalidMoveTarget)
+	}
+
+	task, err := s.GetTaskByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	role, err := s.taskRole(ctx, task, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeTaskView(task, userID, role); err != nil {
+		return nil, err
+	}
+	if err := task.CheckVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	before := *task
+	if status != "" {
+		workflow, err := s.workflowFor(ctx, task.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if err := task.TransitionTo(workflow, status); err != nil {
+			return nil, err
+		}
+	}
+	fields := []model.TaskField{model.TaskFieldRank}
+	if task.Status != before.Status {
+		fields = append(fields, model.TaskFieldStatus)
+	}
+	if err := authorizeTaskUpdate(&before, userID, role, fields); err != nil {
+		return nil, err
+	}
+
+	column := task.BoardColumn()
+	var anchor *model.Task
+	if anchorID := beforeID + afterID; anchorID != "" {
+		if anchorID == task.ID {
+			return nil, fmt.Errorf("%w: cannot move a task relative to itself", model.ErrInvalidMoveTarget)
+		}
+		anchor, err = s.GetTaskByID(ctx, anchorID)
+		if err != nil {
+			if errors.Is(err, model.ErrTaskNotFound) {
+				return nil, fmt.Errorf("%w: %w", model.ErrInvalidMoveTarget, err)
+			}
+			return nil, err
+		}
+		if !anchor.BoardColumn().Equal(column) {
+			return nil, fmt.Errorf("%w: task %s is not in the destination column", model.ErrInvalidMoveTarget, anchorID)
+		}
+	}
+
+	rank, err := s.rankInColumn(ctx, column, anchor, beforeID != "", task.ID)
+	if err != nil {
+		return nil, err
+	}
+	task.Rank = rank
+
+	updated, err := s.taskRepository.UpdateTask(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	s.eventBroker.Publish(model.NewTaskEvent(model.TaskEventUpdated, updated, &before))
+	return updated, nil
+}
+
+// ページサイズの既定値と上限
+const (
+	defaultTaskPageSize = 50
+	maxTaskPageSize     = 200
+)
+
+// ListTasks は範囲・絞り込み条件・並び順に従ってタスクを 1 ページ分取得し、次ページのトークンとともに返します。
+// 次ページがない場合のトークンは空文字です。範囲が workspace の場合は workspaceID のワークスペースのタスクを返します。
+// 個人の範囲 (created など) でも、参加していないワークスペースのタスクは含めません。
+func (s *TaskService) ListTasks(ctx context.Context, userID string, scope model.TaskScope, workspaceID string, filter model.TaskFilter, sortKey model.TaskSortKey, pageSize int, pageToken string) ([]*model.Task, string, error) {
+	switch {
+	case pageSize < 0:
+		return nil, "", model.ErrInvalidPageSize
+	case pageSize == 0:
+		pageSize = defaultTaskPageSize
+	case pageSize > maxTaskPageSize:
+		pageSize = maxTaskPageSize
+	}
+
+	switch scope {
+	case "":
+		scope = model.TaskScopeCreated
+	case model.TaskScopeCreated, model.TaskScopeAssigned, model.TaskScopeCreatedOrAssigned:
+	case model.TaskScopeWorkspace:
+		if workspaceID == "" {
+			return nil, "", fmt.Errorf("%w: workspace_id is required for the workspace scope", model.ErrInvalidTaskScope)
+		}
+		role, err := s.memberRole(ctx, workspaceID, userID)
+		if err != nil {
+			return nil, "", err
+		}
+		if role == "" {
+			return nil, "", model.ErrWorkspaceNotFound
+		}
+	default:
+		return nil, "", fmt.Errorf("%w: %s", model.ErrInvalidTaskScope, scope)
+	}
+	if scope != model.TaskScopeWorkspace && workspaceID != "" {
+		return nil, "", fmt.Errorf("%w: workspace_id is only allowed for the workspace scope", model.ErrInvalidTaskScope)
+	}
+
+	switch sortKey {
+	case "":
+		sortKey = model.TaskSortKeyCreatedAt
+	case model.TaskSortKeyCreatedAt, model.TaskSortKeyUpdatedAt, model.TaskSortKeyDueDate, model.TaskSortKeyPriority, model.TaskSortKeyRank:
+	default:
+		return nil, "", fmt.Errorf("%w: %s", model.ErrInvalidSortKey, sortKey)
+	}
+
+	if filter.Priority != nil {
+		if err := filter.Priority.Validate(); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var after *model.TaskCursor
+	if pageToken != "" {
+		cursor, err := decodeTaskPageToken(pageToken, scope, workspaceID, sortKey, filter)
+		if err != nil {
+			return nil, "", err
+		}
+		after = cursor
+	}
+
+	// 次ページの有無を判定するため 1 件多く取得する
+	tasks, err := s.taskRepository.ListTasks(ctx, &model.TaskListQuery{
+		UserID:      userID,
+		Scope:       scope,
+		WorkspaceID: workspaceID,
+		Filter:      filter,
+		SortKey:     sortKey,
+		Limit:       pageSize + 1,
+		After:       after,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(tasks) <= pageSize {
+		return tasks, "", nil
+	}
+
+	tasks = tasks[:pageSize]
+	nextPageToken, err := encodeTaskPageToken(scope, workspaceID, sortKey, filter, model.NewTaskCursor(tasks[len(tasks)-1]))
+	if err != nil {
+		return nil, "", err
+	}
+	return tasks, nextPageToken, nil
+}
+
+// DeleteTask は権限ポリシーを確認したうえでタスクを削除します。
+// expectedVersion を指定した場合は現在のバージョンと一致するときのみ削除します。
+// サブタスクがある場合は disposition に従い、子孫ごと削除する (子孫すべての削除権限が必要です) か、
+// 子を削除するタスクの親に付け替えます。disposition を指定しない場合は削除できません。
+// 確認した子孫と外部キーの ON DELETE CASCADE で削除される子孫が一致するよう、トランザクション内で
+// タスクと子孫をロックしてから確認して削除します。
+func (s *TaskService) DeleteTask(ctx context.Context, userID, id string, expectedVersion *int64, disposition model.SubtaskDisposition) error {
+	if err := disposition.Validate(); err != nil {
 		return err
 	}
-	return s.taskRepository.CreateTask(ctx, task)
+
+	tx, err := s.taskRepository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+
+	task, descendants, err := s.withTaskLocks(tx).deleteTask(ctx, userID, id, expectedVersion, disposition)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.eventBroker.Publish(model.NewTaskEvent(model.TaskEventDeleted, task, nil))
+	for _, descendant := range descendants {
+		switch {
+		case disposition == model.SubtaskDispositionCascade:
+			s.eventBroker.Publish(model.NewTaskEvent(model.TaskEventDeleted, descendant, nil))
+		case equalParent(descendant, task.ID):
+			// 付け替えた子は変更後の値で通知する
+			updated, err := s.GetTaskByID(ctx, descendant.ID)
+			if err != nil {
+				continue // 通知の直前に削除された場合など
+			}
+			s.eventBroker.Publish(model.NewTaskEvent(model.TaskEventUpdated, updated, descendant))
+		}
+	}
+	return nil
 }
 
-func (s *TaskService) UpdateTask(ctx context.Context, id, title, description string, isCompleted bool, assigneeID *string, priority string, dueDate *time.Time) (*model.Task, error) {
+// deleteTask は DeleteTask の確認と削除を行い、削除したタスクと削除前の子孫を返します (変更イベントは呼び出し元で配信します)。
+// 子を付け替える場合は、同じトランザクション内でタスクの子をタスクの親に付け替えてから、タスクを削除します。
+func (s *TaskService) deleteTask(ctx context.Context, userID, id string, expectedVersion *int64, disposition model.SubtaskDisposition) (*model.Task, []*model.Task, error) {
+	task, err := s.loadTask(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	role, err := s.taskRole(ctx, task, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := authorizeTaskDelete(task, userID, role); err != nil {
+		return nil, nil, err
+	}
+	if err := task.CheckVersion(expectedVersion); err != nil {
+		return nil, nil, err
+	}
 
-	task, err := s.taskRepository.GetTaskByID(ctx, id)
+	descendants, err := s.loadDescendants(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case len(descendants) == 0:
+	case disposition == model.SubtaskDispositionCascade:
+		for _, descendant := range descendants {
+			if err := authorizeTaskDelete(descendant, userID, role); err != nil {
+				return nil, nil, fmt.Errorf("%w: cannot delete subtask %s", err, descendant.ID)
+			}
+		}
+		// 子孫のタスクは外部キーの ON DELETE CASCADE で削除される
+	case disposition == model.SubtaskDispositionReparent:
+		if err := s.taskRepository.ReparentSubtasks(ctx, task.ID, task.ParentID); err != nil {
+			return nil, nil, fmt.Errorf("failed to reparent subtasks: %w", err)
+		}
+	default:
+		return nil, nil, fmt.Errorf("%w: %d subtask(s)", model.ErrTaskHasSubtasks, len(descendants))
+	}
+	if err := s.taskRepository.DeleteTask(ctx, id, task.Version); err != nil {
+		return nil, nil, err
+	}
+	return task, descendants, nil
+}
+
+// equalParent はタスクの親が parentID のタスクかどうかを返します。
+func equalParent(task *model.Task, parentID string) bool {
+	return task.ParentID != nil && *task.ParentID == parentID
+}
+
+// ListSubtasks は閲覧権限を確認したうえで、タスクの子のうちユーザーが閲覧できるものを作成日時の古い順に返します。
+func (s *TaskService) ListSubtasks(ctx context.Context, userID, parentID string) ([]*model.Task, error) {
+	parent, err := s.GetTask(ctx, userID, parentID)
+	if err != nil {
+		return nil, err
+	}
+	subtasks, err := s.taskRepository.ListSubtasks(ctx, parent.ID)
 	if err != nil {
 		return nil, err
 	}
-	if err := task.Update(title, description, isCompleted, assigneeID, model.Priority(priority), dueDate); err != nil { // model.Priorityに変換
+	return s.visibleTasks(ctx, userID, parent, subtasks)
+}
+
+// GetTaskTree は閲覧権限を確認したうえで、タスクを根とする部分木を返します。
+// ユーザーが閲覧できないサブタスクは、その子孫とともに部分木から除きます。
+func (s *TaskService) GetTaskTree(ctx context.Context, userID, id string) (*model.TaskTreeNode, error) {
+	root, err := s.GetTask(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	descendants, err := s.taskRepository.ListDescendants(ctx, root.ID)
+	if err != nil {
 		return nil, err
 	}
-	return s.taskRepository.UpdateTask(ctx, task)
+	visible, err := s.visibleTasks(ctx, userID, root, descendants)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewTaskTree(root, visible), nil
 }
 
-func (s *TaskService) ListTasks(ctx context.Context, userID string) ([]*model.Task, error) {
-	return s.taskRepository.ListTasks(ctx, userID)
+// visibleTasks は root の子孫のうちユーザーが閲覧できるものを返します。
+// 子孫は root と同じワークスペース (個人のタスクは同じ作成者) のタスクのため、役割は root と共通です。
+func (s *TaskService) visibleTasks(ctx context.Context, userID string, root *model.Task, tasks []*model.Task) ([]*model.Task, error) {
+	role, err := s.taskRole(ctx, root, userID)
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]*model.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if authorizeTaskView(task, userID, role) == nil {
+			visible = append(visible, task)
+		}
+	}
+	return visible, nil
 }
 
-func (s *TaskService) DeleteTask(ctx context.Context, id string) error {
-	return s.taskRepository.DeleteTask(ctx, id)
+// TaskWatch はユーザーが閲覧できるタスクの変更イベントの購読です。
+type TaskWatch struct {
+	sub     event.TaskEventSubscription
+	userID  string
+	service *TaskService
 }
+
+// Next はユーザーが閲覧できる次のイベントが発行されるまで待機して返します。
+// ワークスペースのタスクのイベントは、その時点のメンバーの役割で閲覧できるかを判断します。
+func (w *TaskWatch) Next(ctx context.Context) (*model.TaskEvent, error) {
+	for {
+		e, err := w.sub.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		role, err := w.service.taskRole(ctx, e.Task, w.userID)
+		if err != nil {
+			return nil, err
+		}
+		if canViewTaskEvent(e, w.userID, role) {
+			return e, nil
+		}
+	}
+}
+
+// WatchTasks はユーザーが閲覧できるタスクの変更イベントの購読を開始します。
+// lastEventID を指定した場合はその次のイベントから再開します。
+func (s *TaskService) WatchTasks(ctx context.Context, userID, lastEventID string) (*TaskWatch, error) {
+	sub, err := s.eventBroker.Subscribe(lastEventID)
+	if err != nil {
+		return nil, err
+	}
+	return &TaskWatch{sub: sub, userID: userID, service: s}, nil
+}
+
 func (s *TaskService) GetTaskByID(ctx context.Context, id string) (*model.Task, error) {
 	task, err := s.taskRepository.GetTaskByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrTaskNotFound //ドメイン層のエラーを返す
+		}
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// GetTask は閲覧権限を確認したうえでタスクを取得します。
+func (s *TaskService) GetTask(ctx context.Context, userID, id string) (*model.Task, error) {
+	task, err := s.GetTaskByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, model.ErrUserNotFound //ドメイン層のエラーを返す
+	role, err := s.taskRole(ctx, task, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeTaskView(task, userID, role); err != nil {
+		return nil, err
 	}
 
 	return task, nil
 }
+
+// taskRole はユーザーのタスクのワークスペースでの役割を返します。
+// 個人のタスクの場合や、ユーザーがワークスペースのメンバーでない場合は空を返します。
+func (s *TaskService) taskRole(ctx context.Context, task *model.Task, userID string) (model.WorkspaceRole, error) {
+	if !task.InWorkspace() {
+		return "", nil
+	}
+	return s.memberRole(ctx, *task.WorkspaceID, userID)
+}
+
+// memberRole はユーザーのワークスペースでの役割を返します。メンバーでない場合は空を返します。
+func (s *TaskService) memberRole(ctx context.Context, workspaceID, userID string) (model.WorkspaceRole, error) {
+	member, err := s.workspaceRepository.GetWorkspaceMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrWorkspaceMemberNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// checkAssignable はユーザーをタスクの担当者に設定できるかを確認します。
+// ワークスペースのタスクの担当者は、そのワークスペースの member 以上の役割を持つメンバーである必要があります。
+func (s *TaskService) checkAssignable(ctx context.Context, task *model.Task, assigneeID string) error {
+	assignee, err := s.userRepository.GetUserByID(ctx, assigneeID)
+	if err != nil {
+		return err
+	}
+	if s.verificationPolicy.BlockTaskAssignment && !assignee.IsEmailVerified() {
+		return model.ErrAssigneeEmailNotVerified
+	}
+	if task.InWorkspace() {
+		role, err := s.memberRole(ctx, *task.WorkspaceID, assigneeID)
+		if err != nil {
+			return err
+		}
+		if !role.Includes(model.WorkspaceRoleMember) {
+			return model.ErrAssigneeNotWorkspaceMember
+		}
+	}
+	return nil
+}
+
+// checkProjectAssignable はタスクをプロジェクトに追加できるかを確認し、プロジェクトを返します。
+// プロジェクトはタスクの作成者が所有し、アーカイブされていない必要があります。
+func (s *TaskService) checkProjectAssignable(ctx context.Context, task *model.Task, projectID string) (*model.Project, error) {
+	project, err := s.projectRepository.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !project.IsOwnedBy(task.UserID) {
+		// 他のユーザーのプロジェクトの存在を明かさない
+		return nil, model.ErrProjectNotFound
+	}
+	if project.IsArchived() {
+		return nil, model.ErrProjectArchived
+	}
+	return project, nil
+}
+
+// workflowFor はプロジェクトのタスクが従うワークフローを返します。projectID が nil の場合は既定のワークフローです。
+func (s *TaskService) workflowFor(ctx context.Context, projectID *string) (*model.Workflow, error) {
+	if projectID == nil {
+		return model.DefaultWorkflow(), nil
+	}
+	project, err := s.projectRepository.GetProjectByID(ctx, *projectID)
+	if err != nil {
+		return nil, err
+	}
+	return project.EffectiveWorkflow(), nil
+}
+
+// rankInColumn は列の中で anchor の直前 (placeBefore が true の場合) または直後に並べるキーを返します。
+// anchor が nil の場合は列の末尾のキーです。excludeID のタスク (並べるタスク自身) は列に含めません。
+// 前後のキーの間に余地がない場合は、列のキーを振り直してから求め直します。
+func (s *TaskService) rankInColumn(ctx context.Context, column model.BoardColumn, anchor *model.Task, placeBefore bool, excludeID string) (string, error) {
+	for rebalanced := false; ; rebalanced = true {
+		var prev, next string
+		var err error
+		switch {
+		case anchor == nil:
+			prev, err = s.taskRepository.LastRankInColumn(ctx, column, excludeID)
+		case placeBefore:
+			prev, err = s.taskRepository.RankBeforeInColumn(ctx, column, anchor, excludeID)
+			next = anchor.Rank
+		default:
+			prev = anchor.Rank
+			next, err = s.taskRepository.RankAfterInColumn(ctx, column, anchor, excludeID)
+		}
+		if err != nil {
+			return "", err
+		}
+		if rank, ok := model.RankBetween(prev, next); ok {
+			return rank, nil
+		}
+		if rebalanced {
+			return "", fmt.Errorf("no rank available between %q and %q", prev, next)
+		}
+
+		if err := s.rebalanceColumn(ctx, column, excludeID); err != nil {
+			return "", err
+		}
+		if anchor != nil {
+			// 振り直した後のキーを読み直す
+			if anchor, err = s.GetTaskByID(ctx, anchor.ID); err != nil {
+				return "", err
+			}
+		}
+	}
+}
+
+// rebalanceColumn は列のタスクの並び順のキーを、並び順を保ったまま等間隔に振り直します。
+// 振り直したタスクは version が進むため、編集中のクライアントには競合として伝わります。
+func (s *TaskService) rebalanceColumn(ctx context.Context, column model.BoardColumn, excludeID string) error {
+	if s.lockTx != nil {
+		// 階層をロックしたトランザクションの外で振り直すと、ロックした行を待ち続けるため同じトランザクションで振り直す
+		if err := s.taskRepository.RebalanceColumn(ctx, column, excludeID); err != nil {
+			return fmt.Errorf("failed to rebalance board column: %w", err)
+		}
+		return nil
+	}
+	tx, err := s.taskRepository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+
+	if err := s.taskRepository.WithTx(tx).RebalanceColumn(ctx, column, excludeID); err != nil {
+		return fmt.Errorf("failed to rebalance board column: %w", err)
+	}
+	return tx.Commit()
+}
+
+// checkParent はタスクの親を parentID のタスクにできるかを確認します。親はユーザーが閲覧できるタスクである必要があります。
+// subtreeDepth はタスクを根とする部分木の段数です (作成するタスクの場合は 1)。
+func (s *TaskService) checkParent(ctx context.Context, userID string, task *model.Task, parentID string, subtreeDepth int) error {
+	parent, err := s.loadTask(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, model.ErrTaskNotFound) {
+			return fmt.Errorf("%w: %w", model.ErrInvalidParentTask, err)
+		}
+		return err
+	}
+	role, err := s.taskRole(ctx, parent, userID)
+	if err != nil {
+		return err
+	}
+	if authorizeTaskView(parent, userID, role) != nil {
+		// 閲覧できないタスクの存在を明かさない
+		return fmt.Errorf("%w: %w", model.ErrInvalidParentTask, model.ErrTaskNotFound)
+	}
+	ancestors, err := s.ancestorsOf(ctx, parent)
+	if err != nil {
+		return err
+	}
+	return task.CheckParent(parent, ancestors, subtreeDepth)
+}
+
+// ancestorsOf はタスクの祖先を親から順に根まで返します。階層は MaxTaskDepth 段までのため、それより先は辿りません。
+// withTaskLocks で作成した場合は、辿った祖先の鎖をロックします。
+func (s *TaskService) ancestorsOf(ctx context.Context, task *model.Task) ([]*model.Task, error) {
+	var ancestors []*model.Task
+	for current := task; current.ParentID != nil && len(ancestors) < model.MaxTaskDepth; {
+		parent, err := s.loadTask(ctx, *current.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, parent)
+		current = parent
+	}
+	return ancestors, nil
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+// EnrollTOTP は二要素認証 (TOTP) の新しいシークレットを発行し、認証アプリに登録するための
+// シークレット (Base32) と otpauth URI を返します。ConfirmTOTP で最初のコードを確認するまでは有効になりません。
+func (s *UserService) EnrollTOTP(ctx context.Context, userID string) (string, string, error) {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	current, err := s.totpRepository.GetTOTPCredential(ctx, userID)
+	if err != nil && !errors.Is(err, model.ErrTOTPNotEnabled) {
+		return "", "", err
+	}
+	if current != nil && current.IsConfirmed() {
+		return "", "", model.ErrTOTPAlreadyEnabled
+	}
+
+	secret, err := model.GenerateTOTPSecret()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+	// ユーザー ID を関連データとし、他のユーザーの行にコピーされた暗号文は復号できないようにする
+	encrypted, err := s.secretCipher.Encrypt(secret, []byte(userID))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encrypt totp secret: %w", err)
+	}
+	if err := s.totpRepository.SaveTOTPCredential(ctx, &model.TOTPCredential{
+		UserID:          userID,
+		EncryptedSecret: encrypted,
+	}); err != nil {
+		return "", "", fmt.Errorf("failed to save totp credential: %w", err)
+	}
+
+	return model.EncodeTOTPSecret(secret), model.TOTPURI(s.totpIssuer, user.Email, secret), nil
+}
+
+// ConfirmTOTP は認証アプリに表示されたコードで登録を確認して二要素認証を有効にし、リカバリーコードを返します。
+// リカバリーコードはハッシュのみを保存するため、取得できるのはこのときだけです。
+func (s *UserService) ConfirmTOTP(ctx context.Context, userID, code string) ([]string, error) {
+	credential, err := s.totpRepository.GetTOTPCredential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if credential.IsConfirmed() {
+		return nil, model.ErrTOTPAlreadyEnabled
+	}
+
+	secret, err := s.decryptTOTPSecret(credential)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := model.ValidateTOTPCode(secret, code, time.Now())
+	if !ok {
+		return nil, model.ErrInvalidTOTPCode
+	}
+
+	codes, hashes, err := model.NewRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate recovery codes: %w", err)
+	}
+
+	tx, err := s.totpRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txRepo := s.totpRepository.WithTx(tx)
+
+	if err := txRepo.ConfirmTOTPCredential(ctx, userID, step); err != nil {
+		return nil, err
+	}
+	if err := txRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("failed to save recovery codes: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes は認証コード (またはリカバリーコード) を確認し、リカバリーコードを発行し直します。
+// 以前のリカバリーコードは使用できなくなります。
+func (s *UserService) RegenerateRecoveryCodes(ctx context.Context, userID, code string) ([]string, error) {
+	credential, err := s.confirmedTOTPCredential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifySecondFactorCode(ctx, credential, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := model.NewRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate recovery codes: %w", err)
+	}
+
+	tx, err := s.totpRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+
+	if err := s.totpRepository.WithTx(tx).ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("failed to save recovery codes: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableTOTP は認証コード (またはリカバリーコード) を確認し、二要素認証を無効にします。
+func (s *UserService) DisableTOTP(ctx context.Context, userID, code string) error {
+	credential, err := s.confirmedTOTPCredential(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.verifySecondFactorCode(ctx, credential, code); err != nil {
+		return err
+	}
+
+	tx, err := s.totpRepository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+
+	if err := s.totpRepository.WithTx(tx).DeleteTOTPCredential(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete totp credential: %w", err)
+	}
+	return tx.Commit()
+}
+
+// VerifySecondFactor は Login で発行した確認待ちトークンと認証コード (またはリカバリーコード) を検証し、
+// アクセストークンと新しいファミリーのリフレッシュトークンを発行します。確認待ちトークンは一度だけ使用できます。
+// 誤ったコードはパスワードの誤りと同じく失敗回数に数え、しきい値に達するとロックします。
+func (s *UserService) VerifySecondFactor(ctx context.Context, challengeToken, code string, client model.ClientInfo) (*model.TokenPair, error) {
+	claims, err := s.tokenManager.VerifyChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+	// パスワードと同じく、コードを確認する前に試行を失敗として数える
+	attempt, err := s.loginThrottler.Reserve(ctx, user.Email, client)
+	if err != nil {
+		if errors.Is(err, model.ErrTooManyLoginAttempts) {
+			s.recordLoginEvent(ctx, user.ID, model.LoginEventLockedOut, client)
+		}
+		return nil, err
+	}
+
+	credential, err := s.confirmedTOTPCredential(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, model.ErrTOTPNotEnabled) {
+			// 確認待ちトークンの発行後に二要素認証が無効にされた
+			err = model.ErrInvalidChallengeToken
+		}
+		return nil, errors.Join(err, attempt.Cancel(ctx))
+	}
+	if err := s.verifySecondFactorCode(ctx, credential, code); err != nil {
+		if !errors.Is(err, model.ErrInvalidTOTPCode) {
+			return nil, errors.Join(err, attempt.Cancel(ctx))
+		}
+		s.recordLoginEvent(ctx, user.ID, model.LoginEventInvalidSecondFactor, client)
+		return nil, err
+	}
+	if err := attempt.Cancel(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.tokenManager.Revoke(ctx, claims); err != nil {
+		return nil, fmt.Errorf("failed to revoke challenge token: %w", err)
+	}
+	return s.completeLogin(ctx, user, client)
+}
+
+// requiresSecondFactor はユーザーのログインに二要素認証が必要かどうかを返します。
+func (s *UserService) requiresSecondFactor(ctx context.Context, userID string) (bool, error) {
+	_, err := s.confirmedTOTPCredential(ctx, userID)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, model.ErrTOTPNotEnabled):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// confirmedTOTPCredential は確認済みの登録を返します。未登録・未確認の場合は model.ErrTOTPNotEnabled を返します。
+func (s *UserService) confirmedTOTPCredential(ctx context.Context, userID string) (*model.TOTPCredential, error) {
+	credential, err := s.totpRepository.GetTOTPCredential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !credential.IsConfirmed() {
+		return nil, model.ErrTOTPNotEnabled
+	}
+	return credential, nil
+}
+
+// verifySecondFactorCode は認証コードまたはリカバリーコードを検証し、使用済みとして記録します。
+// 同じ認証コードやリカバリーコードは二度使用できません。
+func (s *UserService) verifySecondFactorCode(ctx context.Context, credential *model.TOTPCredential, code string) error {
+	if model.IsRecoveryCodeFormat(code) {
+		return s.totpRepository.UseRecoveryCode(ctx, credential.UserID, model.HashRecoveryCode(code))
+	}
+
+	secret, err := s.decryptTOTPSecret(credential)
+	if err != nil {
+		return err
+	}
+	step, ok := model.ValidateTOTPCode(secret, code, time.Now())
+	if !ok || step <= credential.LastUsedStep {
+		return model.ErrInvalidTOTPCode
+	}
+	return s.totpRepository.UseTOTPStep(ctx, credential.UserID, step)
+}
+
+// decryptTOTPSecret は保存されているシークレットを復号します。
+func (s *UserService) decryptTOTPSecret(credential *model.TOTPCredential) ([]byte, error) {
+	secret, err := s.secretCipher.Decrypt(credential.EncryptedSecret, []byte(credential.UserID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+	return secret, nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+const (
+	defaultUserSearchResults = 10
+	maxUserSearchResults     = 20  // 一度に返す件数の上限 (ページングはしない)
+	maxUserSearchQueryLength = 100 // 検索文字列の最大の長さ (文字数)
+)
+
+// SearchUsers は名前が query で始まるか、メールアドレスが query と一致するユーザーの公開プロフィールを名前順に返します。
+// 担当者の選択などに使うため、無効にされたユーザーは含めません。
+// メールアドレスの一部から登録されているアドレスを推測できないよう、メールアドレスは完全に一致する場合のみ対象にします。
+//
+// 対象は userID のユーザーと同じワークスペースのメンバー (userID のユーザー自身を含む) のみです。
+// ワークスペースに参加していないユーザーには結果を返しません。
+// ユーザーの列挙を防ぐため、呼び出し元のユーザーとクライアント IP ごとに呼び出し回数を制限し、
+// 上限に達した場合は *model.RateLimitedError を返します。
+func (s *UserService) SearchUsers(ctx context.Context, userID, query string, limit int, client model.ClientInfo) ([]*model.UserProfile, error) {
+	query = strings.TrimSpace(query)
+	switch {
+	case query == "":
+		return nil, fmt.Errorf("%w: query is required", model.ErrInvalidSearchQuery)
+	case utf8.RuneCountInString(query) > maxUserSearchQueryLength:
+		return nil, fmt.Errorf("%w: query must be at most %d characters", model.ErrInvalidSearchQuery, maxUserSearchQueryLength)
+	}
+	switch {
+	case limit < 0:
+		return nil, model.ErrInvalidPageSize
+	case limit == 0:
+		limit = defaultUserSearchResults
+	case limit > maxUserSearchResults:
+		limit = maxUserSearchResults
+	}
+
+	if err := s.allowUserSearch(ctx, userID, client); err != nil {
+		return nil, err
+	}
+
+	users, err := s.userRepository.SearchUsers(ctx, userID, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]*model.UserProfile, len(users))
+	for i, u := range users {
+		profiles[i] = model.NewUserProfile(u)
+	}
+	return profiles, nil
+}
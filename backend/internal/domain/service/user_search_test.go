@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+)
+
+var searchClient = model.ClientInfo{IPAddress: "192.0.2.5"}
+
+func TestSearchUsers(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestUserService(t,
+		newTestUser(t, "alice", "alice@example.com", "password"),
+		newTestUser(t, "bob", "bob@example.com", "password"),
+		newTestUser(t, "carol", "carol@example.com", "password"),
+	)
+	ts.users.users["alice"].Name = "tanaka"
+	ts.users.users["bob"].Name = "suzuki"
+	ts.users.users["carol"].Name = "takahashi"
+	ts.users.workspaces = map[string][]string{
+		"alice": {"ws-1"},
+		"bob":   {"ws-1"},
+		"carol": {"ws-2"},
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "tan", want: []string{"alice"}},
+		{query: "bob@example.com", want: []string{"bob"}},
+		// メールアドレスの一部では検索できない
+		{query: "bob@", want: nil},
+		{query: "example.com", want: nil},
+		// 同じワークスペースのメンバーでないユーザーは含めない
+		{query: "ta", want: []string{"alice"}},
+		{query: "carol@example.com", want: nil},
+	}
+	for _, tt := range tests {
+		profiles, err := ts.SearchUsers(ctx, "alice", tt.query, 0, searchClient)
+		if err != nil {
+			t.Fatalf("SearchUsers(%q): %v", tt.query, err)
+		}
+		var got []string
+		for _, p := range profiles {
+			got = append(got, p.ID)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("SearchUsers(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+// クライアント IP ごとの上限は、呼び出し元のユーザーを変えても共通で数える
+func TestSearchUsersRateLimitByIP(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestUserService(t)
+
+	var limited *model.RateLimitedError
+	for i := range 21 {
+		userID := fmt.Sprintf("user-%d", i)
+		_, err := ts.SearchUsers(ctx, userID, "tan", 0, searchClient)
+		if i < 20 && err != nil {
+			t.Fatalf("SearchUsers #%d: %v", i+1, err)
+		}
+		if i == 20 && !errors.As(err, &limited) {
+			t.Errorf("SearchUsers #%d err = %v, want RateLimitedError", i+1, err)
+		}
+	}
+
+	// 別のクライアント IP からは検索できる
+	if _, err := ts.SearchUsers(ctx, "user-0", "tan", 0, model.ClientInfo{IPAddress: "192.0.2.6"}); err != nil {
+		t.Errorf("SearchUsers from another IP: %v", err)
+	}
+}
+
+// ユーザーごとの上限は、クライアント IP を変えても共通で数える
+func TestSearchUsersRateLimitByUser(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestUserService(t)
+
+	var limited *model.RateLimitedError
+	for i := range 11 {
+		client := model.ClientInfo{IPAddress: fmt.Sprintf("192.0.2.%d", 10+i)}
+		_, err := ts.SearchUsers(ctx, "alice", "tan", 0, client)
+		if i < 10 && err != nil {
+			t.Fatalf("SearchUsers #%d: %v", i+1, err)
+		}
+		if i == 10 && !errors.As(err, &limited) {
+			t.Errorf("SearchUsers #%d err = %v, want RateLimitedError", i+1, err)
+		}
+	}
+}
@@ -3,34 +3,96 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
 	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/adapter/sso"
 	"github.com/a-s/connect-task-manage/internal/adapter/token"
 	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/encryption"
 	"github.com/google/uuid"
 )
 
 // UserService はユーザーに関するビジネスロジックを提供します。
 type UserService struct {
-	userRepository repository.UserRepository
-	tokenManager   token.TokenManager
+	userRepository                   repository.UserRepository
+	refreshTokenRepository           repository.RefreshTokenRepository
+	passwordResetTokenRepository     repository.PasswordResetTokenRepository
+	emailVerificationTokenRepository repository.EmailVerificationTokenRepository
+	totpRepository                   repository.TOTPRepository
+	loginEventRepository             repository.LoginEventRepository
+	userIdentityRepository           repository.UserIdentityRepository
+	oidcAuthRequestRepository        repository.OIDCAuthRequestRepository
+	personalAccessTokenRepository    repository.PersonalAccessTokenRepository
+	loginThrottler                   *LoginThrottler
+	tokenManager                     token.TokenManager
+	secretCipher                     *encryption.SecretCipher // 二要素認証のシークレットの暗号化
+	mailer                           mailer.Mailer
+	identityProvider                 sso.IdentityProvider // シングルサインオンが無効の場合は nil
+	limiters                         *RequestLimiters
+	background                       *sync.WaitGroup // リクエストの完了後に実行中の処理 (メールの送信など)
+	verificationPolicy               model.EmailVerificationPolicy
+	refreshTokenDuration             time.Duration
+	baseURL                          string // メールに記載するリンクの基点
+	totpIssuer                       string // 認証アプリに表示するサービス名
+	allowSSOSignup                   bool   // シングルサインオンで未登録のユーザーを作成する
 }
 
 // NewUserService は新しい UserService インスタンスを作成します。
-func NewUserService(userRepo repository.UserRepository, tokenManager token.TokenManager) *UserService {
+func NewUserService(
+	userRepo repository.UserRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	passwordResetTokenRepo repository.PasswordResetTokenRepository,
+	emailVerificationTokenRepo repository.EmailVerificationTokenRepository,
+	totpRepo repository.TOTPRepository,
+	loginEventRepo repository.LoginEventRepository,
+	userIdentityRepo repository.UserIdentityRepository,
+	oidcAuthRequestRepo repository.OIDCAuthRequestRepository,
+	patRepo repository.PersonalAccessTokenRepository,
+	loginThrottler *LoginThrottler,
+	tokenManager token.TokenManager,
+	secretCipher *encryption.SecretCipher,
+	mailer mailer.Mailer,
+	identityProvider sso.IdentityProvider,
+	limiters *RequestLimiters,
+	cfg *config.Config,
+) *UserService {
 	return &UserService{
-		userRepository: userRepo,
-		tokenManager:   tokenManager,
+		userRepository:                   userRepo,
+		refreshTokenRepository:           refreshTokenRepo,
+		passwordResetTokenRepository:     passwordResetTokenRepo,
+		emailVerificationTokenRepository: emailVerificationTokenRepo,
+		totpRepository:                   totpRepo,
+		loginEventRepository:             loginEventRepo,
+		userIdentityRepository:           userIdentityRepo,
+		oidcAuthRequestRepository:        oidcAuthRequestRepo,
+		personalAccessTokenRepository:    patRepo,
+		loginThrottler:                   loginThrottler,
+		tokenManager:                     tokenManager,
+		secretCipher:                     secretCipher,
+		mailer:                           mailer,
+		identityProvider:                 identityProvider,
+		limiters:                         limiters,
+		background:                       &sync.WaitGroup{},
+		verificationPolicy:               newEmailVerificationPolicy(cfg),
+		refreshTokenDuration:             time.Duration(cfg.JWT.RefreshDurationHours) * time.Hour,
+		baseURL:                          strings.TrimRight(cfg.App.BaseURL, "/"),
+		totpIssuer:                       cfg.TOTP.Issuer,
+		allowSSOSignup:                   cfg.OIDC.AllowSignup,
 	}
 }
 
 // WithTx はトランザクションを開始し、トランザクション内で操作を行うための新しい UserService インスタンスを返します。
 func (s *UserService) WithTx(tx *sql.Tx) *UserService {
-	return &UserService{
-		userRepository: s.userRepository.WithTx(tx), // トランザクション用のリポジトリを使用
-		tokenManager:   s.tokenManager,              // tokenManager は共通
-	}
+	txService := *s
+	txService.userRepository = s.userRepository.WithTx(tx) // トランザクション用のリポジトリを使用 (その他は共通)
+	return &txService
 }
 
 func (s *UserService) CreateUser(ctx context.Context, name, email, password string) (*model.User, error) {
@@ -49,25 +111,197 @@ func (s *UserService) CreateUser(ctx context.Context, name, email, password stri
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user in repository: %w", err)
 	}
+
+	// 新しいユーザーはメールアドレスが未確認の状態で作成し、確認用のリンクを送信する
+	// (送信に失敗してもユーザーは作成済みのため、ログに出力するだけにする。リンクは ResendVerificationEmail で再送できる)
+	s.sendVerificationEmailInBackground(ctx, user)
 	return nil, nil
 }
 
-func (s *UserService) Login(ctx context.Context, email, password string) (string, error) {
+// Login はユーザーを認証し、アクセストークンと新しいファミリーのリフレッシュトークンを発行します。
+// 二要素認証が有効なユーザーの場合はトークンを発行せず、VerifySecondFactor で使用する確認待ちトークンを返します。
+//
+// 失敗が続いたアカウントやクライアント IP は一時的にロックし、ロック中は *model.LoginLockedError を返します。
+// 登録されていないメールアドレスも同じように扱い、登録の有無を推測されないようにします。
+func (s *UserService) Login(ctx context.Context, email, password string, client model.ClientInfo) (*model.LoginResult, error) {
 	user, err := s.userRepository.GetUserByEmail(ctx, email)
+	if err != nil && !errors.Is(err, model.ErrUserNotFound) {
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	// パスワードを確認する前に試行を失敗として数え、同時に送られた試行でしきい値を超えないようにする
+	attempt, err := s.loginThrottler.Reserve(ctx, email, client)
+	if err != nil {
+		if user != nil && errors.Is(err, model.ErrTooManyLoginAttempts) {
+			s.recordLoginEvent(ctx, user.ID, model.LoginEventLockedOut, client)
+		}
+		return nil, err
+	}
+
+	if user == nil || user.Authenticate(password) != nil {
+		if user != nil {
+			s.recordLoginEvent(ctx, user.ID, model.LoginEventInvalidPassword, client)
+		}
+		return nil, model.ErrAuthentication
+	}
+	// パスワードが正しい場合は予約した試行を取り消す (アカウントの失敗回数はログインの完了時にリセットする)
+	if err := attempt.Cancel(ctx); err != nil {
+		return nil, err
+	}
+	// パスワードが正しい場合のみ伝え、メールアドレスの確認状況を推測されないようにする
+	if s.verificationPolicy.BlockLogin && !user.IsEmailVerified() {
+		return nil, model.ErrEmailNotVerified
+	}
+	if user.IsDisabled() {
+		s.recordLoginEvent(ctx, user.ID, model.LoginEventDisabled, client)
+		return nil, model.ErrUserDisabled
+	}
+
+	required, err := s.requiresSecondFactor(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if required {
+		// 失敗回数は二要素認証が完了するまでリセットしない (認証コードの総当たりも同じ回数で制限する)
+		challengeToken, err := s.tokenManager.GenerateChallenge(user)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate challenge token: %w", err)
+		}
+		return &model.LoginResult{ChallengeToken: challengeToken}, nil
+	}
+
+	pair, err := s.completeLogin(ctx, user, client)
+	if err != nil {
+		return nil, err
+	}
+	return &model.LoginResult{Tokens: pair}, nil
+}
+
+// completeLogin は認証が完了したユーザーの失敗回数をリセットしてログインを記録し、トークンを発行します。
+// 二要素認証やシングルサインオンの途中で無効にされたユーザーには発行しません。
+func (s *UserService) completeLogin(ctx context.Context, user *model.User, client model.ClientInfo) (*model.TokenPair, error) {
+	if user.IsDisabled() {
+		s.recordLoginEvent(ctx, user.ID, model.LoginEventDisabled, client)
+		return nil, model.ErrUserDisabled
+	}
+	if err := s.loginThrottler.RecordSuccess(ctx, user.Email); err != nil {
+		return nil, err
+	}
+	s.recordLoginEvent(ctx, user.ID, model.LoginEventSuccess, client)
+	return s.issueTokenPair(ctx, s.refreshTokenRepository, user, uuid.New().String())
+}
+
+// RefreshToken はリフレッシュトークンをローテーションし、新しいトークンの組を発行します。
+// ローテーション済みのトークンが再度使用された場合は、漏洩したとみなして同じファミリーのトークンをすべて失効させます。
+func (s *UserService) RefreshToken(ctx context.Context, rawToken string) (*model.TokenPair, error) {
+	current, err := s.refreshTokenRepository.GetRefreshTokenByHash(ctx, model.HashRefreshToken(rawToken))
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case current.IsRevoked(), current.IsExpired(time.Now()):
+		return nil, model.ErrInvalidRefreshToken
+	case current.IsUsed():
+		return nil, s.revokeReusedFamily(ctx, current.FamilyID)
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, current.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+	if user.IsDisabled() {
+		// 無効にしたときに失効させているが、無効にする前に発行されたトークンが同時に使用された場合に備える
+		return nil, model.ErrUserDisabled
+	}
+
+	tx, err := s.refreshTokenRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txRepo := s.refreshTokenRepository.WithTx(tx)
+
+	if err := txRepo.MarkRefreshTokenUsed(ctx, current.ID); err != nil {
+		if errors.Is(err, model.ErrRefreshTokenReused) {
+			// 同じトークンが同時に使用された
+			_ = tx.Rollback()
+			return nil, s.revokeReusedFamily(ctx, current.FamilyID)
+		}
+		return nil, err
+	}
+
+	pair, err := s.issueTokenPair(ctx, txRepo, user, current.FamilyID)
 	if err != nil {
-		return "", fmt.Errorf("failed to get user by email: %w", err)
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
+	return pair, nil
+}
 
-	if err := user.Authenticate(password); err != nil {
-		return "", model.ErrAuthentication
+// Logout はリクエストに使用したアクセストークンと、リフレッシュトークンと同じファミリー
+// (同じログインから発行されたもの) をすべて失効させます。
+func (s *UserService) Logout(ctx context.Context, claims *token.Claims, rawToken string) error {
+	current, err := s.refreshTokenRepository.GetRefreshTokenByHash(ctx, model.HashRefreshToken(rawToken))
+	if err != nil {
+		return err
 	}
+	// 他のユーザーのトークンは存在しないものとして扱う
+	if current.UserID != claims.UserID {
+		return model.ErrInvalidRefreshToken
+	}
+	if err := s.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, current.FamilyID); err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+	if err := s.tokenManager.Revoke(ctx, claims); err != nil {
+		return fmt.Errorf("failed to revoke access token: %w", err)
+	}
+	return nil
+}
 
-	token, err := s.tokenManager.Generate(user)
+// revokeAllSessions はユーザーに発行済みのアクセストークン・リフレッシュトークン・パーソナルアクセストークンをすべて失効させます。
+func (s *UserService) revokeAllSessions(ctx context.Context, userID string) error {
+	if err := s.refreshTokenRepository.RevokeUserRefreshTokens(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+	if err := s.personalAccessTokenRepository.RevokeUserPersonalAccessTokens(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke personal access tokens: %w", err)
+	}
+	if err := s.tokenManager.RevokeAll(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke access tokens: %w", err)
+	}
+	return nil
+}
+
+// issueTokenPair はアクセストークンと、familyID のファミリーに属する新しいリフレッシュトークンを発行します。
+func (s *UserService) issueTokenPair(ctx context.Context, refreshTokenRepo repository.RefreshTokenRepository, user *model.User, familyID string) (*model.TokenPair, error) {
+	accessToken, err := s.tokenManager.Generate(user)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate token: %w", err)
+		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	return token, nil
+	refreshToken, rawRefreshToken, err := model.NewRefreshToken(uuid.New().String(), user.ID, familyID, s.refreshTokenDuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	if err := refreshTokenRepo.CreateRefreshToken(ctx, refreshToken); err != nil {
+		return nil, fmt.Errorf("failed to save refresh token: %w", err)
+	}
+
+	return &model.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: rawRefreshToken,
+	}, nil
+}
+
+// revokeReusedFamily は再利用が検知されたファミリーを失効させ、model.ErrRefreshTokenReused を返します。
+func (s *UserService) revokeReusedFamily(ctx context.Context, familyID string) error {
+	if err := s.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, familyID); err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+	return model.ErrRefreshTokenReused
 }
 
 func (s *UserService) GetUserByID(ctx context.Context, id string) (*model.User, error) {
@@ -78,7 +312,21 @@ func (s *UserService) GetUserByID(ctx context.Context, id string) (*model.User,
 	return user, nil
 }
 
+// CheckActiveUser はユーザーが存在し、無効にされていないことを確認します。
+// 削除されたユーザーは model.ErrUserNotFound、無効にされたユーザーは model.ErrUserDisabled を返します。
+func (s *UserService) CheckActiveUser(ctx context.Context, id string) error {
+	user, err := s.userRepository.GetUserByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user.IsDisabled() {
+		return model.ErrUserDisabled
+	}
+	return nil
+}
+
 // UpdateUser はトランザクション内でユーザー情報を更新します。
+// メールアドレスを変更した場合の確認用のリンクはコミットの後に送信し、送信の失敗はログに出力するだけにします。
 func (s *UserService) UpdateUser(ctx context.Context, id, name, email, password string) (*model.User, error) {
 
 	// トランザクション開始 (WithTx が呼ばれていない場合)
@@ -86,25 +334,16 @@ func (s *UserService) UpdateUser(ctx context.Context, id, name, email, password
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
 	// トランザクション用の UserService を作成
 	txService := s.WithTx(tx)
 
-	defer func() {
-		if p := recover(); p != nil {
-			_ = tx.Rollback()
-			panic(p) // 再度パニックさせる
-		} else if err != nil {
-			_ = tx.Rollback() // エラーが発生したらロールバック
-		} else {
-			err = tx.Commit() // 成功したらコミット
-		}
-	}()
-
 	user, err := txService.userRepository.GetUserByID(ctx, id) //txServiceを使う
 	if err != nil {
 		return nil, err
 	}
 
+	emailChanged := email != user.Email
 	if err := user.Update(name, email, password); err != nil {
 		return nil, err
 	}
@@ -114,5 +353,21 @@ func (s *UserService) UpdateUser(ctx context.Context, id, name, email, password
 		return nil, err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// パスワードを変更した場合は、発行済みのトークンをすべて失効させる (すべてのセッションからログアウトする)
+	if password != "" {
+		if err := s.revokeAllSessions(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	// メールアドレスを変更した場合は、新しいメールアドレスに確認用のリンクを送信する
+	if emailChanged {
+		s.sendVerificationEmailInBackground(ctx, updatedUser)
+	}
+
 	return updatedUser, nil
 }
@@ -0,0 +1,425 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/a-s/connect-task-manage/internal/adapter/mailer"
+	"github.com/a-s/connect-task-manage/internal/adapter/repository"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+)
+
+// workspaceInvitationDuration はワークスペースへの招待の有効期間です。
+const workspaceInvitationDuration = 7 * 24 * time.Hour
+
+// WorkspaceService はワークスペースとそのメンバー・招待に関するビジネスロジックを提供します。
+// メソッドごとに必要なワークスペースでの役割は、認可インターセプターで確認済みとして扱います
+// (API の required_workspace_role)。ここでは役割の上下関係に依存する追加の制限だけを確認します。
+type WorkspaceService struct {
+	workspaceRepository repository.WorkspaceRepository
+	userRepository      repository.UserRepository
+	taskRepository      repository.TaskRepository
+	mailer              mailer.Mailer
+	baseURL             string // メールに記載するリンクの基点
+}
+
+// NewWorkspaceService は新しい WorkspaceService インスタンスを作成します。
+func NewWorkspaceService(
+	workspaceRepo repository.WorkspaceRepository,
+	userRepo repository.UserRepository,
+	taskRepo repository.TaskRepository,
+	mailer mailer.Mailer,
+	cfg *config.Config,
+) *WorkspaceService {
+	return &WorkspaceService{
+		workspaceRepository: workspaceRepo,
+		userRepository:      userRepo,
+		taskRepository:      taskRepo,
+		mailer:              mailer,
+		baseURL:             strings.TrimRight(cfg.App.BaseURL, "/"),
+	}
+}
+
+// WorkspaceRole はユーザーのワークスペースでの役割を返します。
+// メンバーでない場合は、ワークスペースの存在を明かさないよう model.ErrWorkspaceNotFound を返します。
+func (s *WorkspaceService) WorkspaceRole(ctx context.Context, workspaceID, userID string) (model.WorkspaceRole, error) {
+	member, err := s.workspaceRepository.GetWorkspaceMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrWorkspaceMemberNotFound) {
+			return "", model.ErrWorkspaceNotFound
+		}
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// CreateWorkspace はワークスペースを作成し、作成したユーザーを所有者として追加します。
+func (s *WorkspaceService) CreateWorkspace(ctx context.Context, userID, name string) (*model.WorkspaceMembership, error) {
+	workspace, err := model.NewWorkspace(name)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.workspaceRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txRepo := s.workspaceRepository.WithTx(tx)
+
+	if err := txRepo.CreateWorkspace(ctx, workspace); err != nil {
+		return nil, fmt.Errorf("failed to create workspace: %w", err)
+	}
+	if err := txRepo.CreateWorkspaceMember(ctx, &model.WorkspaceMember{
+		WorkspaceID: workspace.ID,
+		UserID:      userID,
+		Role:        model.WorkspaceRoleOwner,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to add workspace owner: %w", err)
+	}
+	// 作成日時などを含めて返すため読み直す
+	created, err := txRepo.GetWorkspaceByID(ctx, workspace.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &model.WorkspaceMembership{Workspace: created, Role: model.WorkspaceRoleOwner}, nil
+}
+
+// ListWorkspaces はユーザーが参加しているワークスペースを、ユーザーの役割とともに名前順に返します。
+func (s *WorkspaceService) ListWorkspaces(ctx context.Context, userID string) ([]*model.WorkspaceMembership, error) {
+	return s.workspaceRepository.ListUserWorkspaces(ctx, userID)
+}
+
+// GetWorkspace はワークスペースを、ユーザーの役割とともに返します。
+func (s *WorkspaceService) GetWorkspace(ctx context.Context, userID, id string) (*model.WorkspaceMembership, error) {
+	role, err := s.WorkspaceRole(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	workspace, err := s.workspaceRepository.GetWorkspaceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &model.WorkspaceMembership{Workspace: workspace, Role: role}, nil
+}
+
+// RenameWorkspace はワークスペース名を変更します。
+func (s *WorkspaceService) RenameWorkspace(ctx context.Context, userID, id, name string) (*model.WorkspaceMembership, error) {
+	membership, err := s.GetWorkspace(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := membership.Workspace.Rename(name); err != nil {
+		return nil, err
+	}
+	updated, err := s.workspaceRepository.UpdateWorkspace(ctx, membership.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	membership.Workspace = updated
+	return membership, nil
+}
+
+// DeleteWorkspace はワークスペースを削除します。メンバー・招待・ワークスペースのタスクも削除されます。
+//
+// 削除したタスクの変更イベントは配信しないため、WatchTasks のクライアントは ListTasks で同期し直す必要があります。
+func (s *WorkspaceService) DeleteWorkspace(ctx context.Context, id string) error {
+	if err := s.workspaceRepository.DeleteWorkspace(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete workspace: %w", err)
+	}
+	return nil
+}
+
+// ListMembers はワークスペースのメンバーを参加した順に返します。
+func (s *WorkspaceService) ListMembers(ctx context.Context, workspaceID string) ([]*model.WorkspaceMember, error) {
+	return s.workspaceRepository.ListWorkspaceMembers(ctx, workspaceID)
+}
+
+// UpdateMemberRole はメンバーの役割を変更します。所有者を追加・変更できるのは所有者のみで、
+// 最後の所有者の役割は変更できません。
+func (s *WorkspaceService) UpdateMemberRole(ctx context.Context, actorID, workspaceID, userID string, role model.WorkspaceRole) (*model.WorkspaceMember, error) {
+	if _, err := model.ParseWorkspaceRole(string(role)); err != nil {
+		return nil, err
+	}
+	actorRole, err := s.WorkspaceRole(ctx, workspaceID, actorID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.workspaceRepository.GetWorkspaceMember(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if (member.Role == model.WorkspaceRoleOwner || role == model.WorkspaceRoleOwner) && actorRole != model.WorkspaceRoleOwner {
+		return nil, fmt.Errorf("%w: only owners can grant or change the owner role", model.ErrPermissionDenied)
+	}
+	if member.Role == role {
+		return member, nil
+	}
+
+	tx, err := s.workspaceRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txRepo := s.workspaceRepository.WithTx(tx)
+
+	if member.Role == model.WorkspaceRoleOwner {
+		if err := checkRemainingOwners(ctx, txRepo, workspaceID); err != nil {
+			return nil, err
+		}
+	}
+	if err := txRepo.UpdateWorkspaceMemberRole(ctx, workspaceID, userID, role); err != nil {
+		return nil, fmt.Errorf("failed to update workspace member role: %w", err)
+	}
+	// 閲覧者はタスクを更新できないため、担当しているタスクから外す
+	if role == model.WorkspaceRoleViewer {
+		if err := s.taskRepository.WithTx(tx).UnassignWorkspaceTasks(ctx, workspaceID, userID); err != nil {
+			return nil, fmt.Errorf("failed to unassign tasks: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	member.Role = role
+	return member, nil
+}
+
+// RemoveMember はメンバーをワークスペースから外します。所有者を外せるのは所有者のみです。
+func (s *WorkspaceService) RemoveMember(ctx context.Context, actorID, workspaceID, userID string) error {
+	actorRole, err := s.WorkspaceRole(ctx, workspaceID, actorID)
+	if err != nil {
+		return err
+	}
+	member, err := s.workspaceRepository.GetWorkspaceMember(ctx, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+	if member.Role == model.WorkspaceRoleOwner && actorRole != model.WorkspaceRoleOwner {
+		return fmt.Errorf("%w: only owners can remove an owner", model.ErrPermissionDenied)
+	}
+	return s.removeMember(ctx, member)
+}
+
+// LeaveWorkspace はユーザー自身がワークスペースから抜けます。最後の所有者は抜けられません。
+func (s *WorkspaceService) LeaveWorkspace(ctx context.Context, userID, workspaceID string) error {
+	member, err := s.workspaceRepository.GetWorkspaceMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrWorkspaceMemberNotFound) {
+			return model.ErrWorkspaceNotFound
+		}
+		return err
+	}
+	return s.removeMember(ctx, member)
+}
+
+// removeMember はメンバーを外し、そのメンバーが担当しているワークスペースのタスクを担当者なしにします。
+// 作成したタスクはワークスペースに残ります。
+func (s *WorkspaceService) removeMember(ctx context.Context, member *model.WorkspaceMember) error {
+	tx, err := s.workspaceRepository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txRepo := s.workspaceRepository.WithTx(tx)
+
+	if member.Role == model.WorkspaceRoleOwner {
+		if err := checkRemainingOwners(ctx, txRepo, member.WorkspaceID); err != nil {
+			return err
+		}
+	}
+	if err := txRepo.DeleteWorkspaceMember(ctx, member.WorkspaceID, member.UserID); err != nil {
+		return fmt.Errorf("failed to remove workspace member: %w", err)
+	}
+	if err := s.taskRepository.WithTx(tx).UnassignWorkspaceTasks(ctx, member.WorkspaceID, member.UserID); err != nil {
+		return fmt.Errorf("failed to unassign tasks: %w", err)
+	}
+	return tx.Commit()
+}
+
+// checkRemainingOwners は所有者を 1 人外しても所有者が残ることを確認します。
+func checkRemainingOwners(ctx context.Context, repo repository.WorkspaceRepository, workspaceID string) error {
+	owners, err := repo.CountWorkspaceOwners(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return model.ErrLastWorkspaceOwner
+	}
+	return nil
+}
+
+// InviteMember はメールアドレス宛てにワークスペースへの招待を作成し、招待のメールを送信します。
+// 同じメールアドレスへの未回答の招待は取り消し、最新の招待だけを有効にします。
+func (s *WorkspaceService) InviteMember(ctx context.Context, inviterID, workspaceID, email string, role model.WorkspaceRole) (*model.WorkspaceInvitation, error) {
+	invitation, err := model.NewWorkspaceInvitation(workspaceID, email, role, inviterID, workspaceInvitationDuration)
+	if err != nil {
+		return nil, err
+	}
+	workspace, err := s.workspaceRepository.GetWorkspaceByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 既にメンバーのユーザーは招待しない
+	invitee, err := s.userRepository.GetUserByEmail(ctx, invitation.Email)
+	switch {
+	case err == nil:
+		if _, err := s.workspaceRepository.GetWorkspaceMember(ctx, workspaceID, invitee.ID); err == nil {
+			return nil, model.ErrAlreadyWorkspaceMember
+		} else if !errors.Is(err, model.ErrWorkspaceMemberNotFound) {
+			return nil, err
+		}
+	case !errors.Is(err, model.ErrUserNotFound):
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	tx, err := s.workspaceRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txRepo := s.workspaceRepository.WithTx(tx)
+
+	if err := txRepo.RevokePendingWorkspaceInvitations(ctx, workspaceID, invitation.Email); err != nil {
+		return nil, fmt.Errorf("failed to revoke previous invitations: %w", err)
+	}
+	if err := txRepo.CreateWorkspaceInvitation(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+	created, err := txRepo.GetWorkspaceInvitationByID(ctx, invitation.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	link := s.baseURL + "/invitations?id=" + url.QueryEscape(created.ID)
+	if err := s.mailer.Send(ctx, &mailer.Message{
+		To:      created.Email,
+		Subject: "ワークスペースへの招待",
+		Body: fmt.Sprintf("ワークスペース「%s」に招待されました (役割: %s)。\n", workspace.Name, created.Role) +
+			"このメールアドレスで登録・確認したアカウントでログインし、以下のリンクから承諾または辞退してください (有効期間: 7 日間)。\n\n" +
+			link + "\n\n" +
+			"このメールに心当たりがない場合は破棄してください。\n",
+	}); err != nil {
+		return nil, fmt.Errorf("failed to send invitation mail: %w", err)
+	}
+	return created, nil
+}
+
+// ListInvitations はワークスペースの有効期限内の未回答の招待を新しい順に返します。
+func (s *WorkspaceService) ListInvitations(ctx context.Context, workspaceID string) ([]*model.WorkspaceInvitation, error) {
+	return s.workspaceRepository.ListPendingWorkspaceInvitations(ctx, workspaceID)
+}
+
+// RevokeInvitation はワークスペースへの未回答の招待を取り消します。
+func (s *WorkspaceService) RevokeInvitation(ctx context.Context, workspaceID, invitationID string) error {
+	invitation, err := s.workspaceRepository.GetWorkspaceInvitationByID(ctx, invitationID)
+	if err != nil {
+		return err
+	}
+	if invitation.WorkspaceID != workspaceID {
+		return model.ErrInvitationNotFound
+	}
+	return s.workspaceRepository.RespondWorkspaceInvitation(ctx, invitation.ID, model.InvitationStatusRevoked)
+}
+
+// ListMyInvitations はユーザーのメールアドレス宛ての有効期限内の未回答の招待を返します。
+// メールアドレスを確認していない場合は、他人のメールアドレスで登録して招待を覗けないよう ErrEmailNotVerified を返します。
+func (s *WorkspaceService) ListMyInvitations(ctx context.Context, userID string) ([]*model.WorkspaceInvitation, error) {
+	user, err := s.verifiedUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.workspaceRepository.ListPendingInvitationsByEmail(ctx, user.Email)
+}
+
+// AcceptInvitation は招待を承諾し、招待された役割でワークスペースに参加します。
+func (s *WorkspaceService) AcceptInvitation(ctx context.Context, userID, invitationID string) (*model.WorkspaceMembership, error) {
+	invitation, err := s.invitationFor(ctx, userID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.workspaceRepository.GetWorkspaceMember(ctx, invitation.WorkspaceID, userID); err == nil {
+		return nil, model.ErrAlreadyWorkspaceMember
+	} else if !errors.Is(err, model.ErrWorkspaceMemberNotFound) {
+		return nil, err
+	}
+
+	tx, err := s.workspaceRepository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // Commit 後の呼び出しは何もしない
+	txRepo := s.workspaceRepository.WithTx(tx)
+
+	// 状態の変更に成功した場合のみ参加させる (同時に承諾・取り消しされた場合は ErrInvitationNotPending)
+	if err := txRepo.RespondWorkspaceInvitation(ctx, invitation.ID, model.InvitationStatusAccepted); err != nil {
+		return nil, err
+	}
+	if err := txRepo.CreateWorkspaceMember(ctx, &model.WorkspaceMember{
+		WorkspaceID: invitation.WorkspaceID,
+		UserID:      userID,
+		Role:        invitation.Role,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to add workspace member: %w", err)
+	}
+	workspace, err := txRepo.GetWorkspaceByID(ctx, invitation.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &model.WorkspaceMembership{Workspace: workspace, Role: invitation.Role}, nil
+}
+
+// DeclineInvitation は招待を辞退します。
+func (s *WorkspaceService) DeclineInvitation(ctx context.Context, userID, invitationID string) error {
+	invitation, err := s.invitationFor(ctx, userID, invitationID)
+	if err != nil {
+		return err
+	}
+	return s.workspaceRepository.RespondWorkspaceInvitation(ctx, invitation.ID, model.InvitationStatusDeclined)
+}
+
+// invitationFor はユーザーが回答できる招待を返します。
+// ユーザーのメールアドレス宛てでない招待は、存在を明かさないよう ErrInvitationNotFound にします。
+func (s *WorkspaceService) invitationFor(ctx context.Context, userID, invitationID string) (*model.WorkspaceInvitation, error) {
+	user, err := s.verifiedUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	invitation, err := s.workspaceRepository.GetWorkspaceInvitationByID(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if !invitation.IsAddressedTo(user) {
+		return nil, model.ErrInvitationNotFound
+	}
+	if !invitation.IsPending(time.Now()) {
+		return nil, model.ErrInvitationNotPending
+	}
+	return invitation, nil
+}
+
+// verifiedUser はメールアドレスを確認済みのユーザーを返します。
+func (s *WorkspaceService) verifiedUser(ctx context.Context, userID string) (*model.User, error) {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsEmailVerified() {
+		return nil, fmt.Errorf("%w: verify your email address to respond to invitations", model.ErrEmailNotVerified)
+	}
+	return user, nil
+}
@@ -5,15 +5,23 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
 
 // Config はアプリケーション全体の設定を保持します。
 type Config struct {
-	DB  DBConfig
-	JWT JWTConfig
-	App AppConfig
+	DB   DBConfig
+	JWT  JWTConfig
+	App  AppConfig
+	Mail MailConfig
+
+	EmailVerification EmailVerificationConfig
+	TOTP              TOTPConfig
+	LoginThrottle     LoginThrottleConfig
+	UserSearch        UserSearchConfig
+	OIDC              OIDCConfig
 }
 
 // DBConfig はデータベース接続設定を保持します。
@@ -23,13 +31,82 @@ type DBConfig struct {
 
 // JWTConfig は JWT 認証関連の設定を保持します。
 type JWTConfig struct {
-	Secret          string
-	DurationMinutes int
+	Secret               string // Keys が空の場合のみ使用する HS256 の共通鍵
+	DurationMinutes      int
+	RefreshDurationHours int    // リフレッシュトークンの有効期間
+	RevocationStore      string // アクセストークンの失効情報の保存先 ("mysql" または "memory")
+
+	// Keys は kid と PEM 形式の鍵ファイルのパスの対応です (RS256 / EdDSA)。
+	// 秘密鍵は署名と検証に、公開鍵は検証のみに使用します。
+	Keys        map[string]string
+	ActiveKeyID string // 署名に使用する鍵の kid (Keys が 1 つの場合は省略可)
 }
 
 // AppConfig はアプリケーションの基本設定を保持します。
 type AppConfig struct {
-	Port string
+	Port              string
+	BaseURL           string // メールに記載するリンクの基点 (フロントエンドの URL)
+	TrustProxyHeaders bool   // X-Forwarded-For のクライアント IP を信頼する (リバースプロキシの背後で動かす場合)
+}
+
+// MailConfig はメール送信の設定を保持します。
+type MailConfig struct {
+	Driver       string // "smtp", "file" または "memory"
+	From         string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string // 空の場合は認証しない
+	SMTPPassword string
+	OutboxDir    string // Driver が "file" の場合の書き出し先
+
+	// パスワード再設定などのメールの送信を要求できる回数 (メール爆撃やユーザーの列挙を防ぐ)
+	RatePerMinute   int // 宛先のメールアドレスごとに 1 分あたりに要求できる回数
+	Burst           int // 宛先のメールアドレスごとに連続して要求できる回数
+	IPRatePerMinute int // クライアント IP ごとに 1 分あたりに要求できる回数
+	IPBurst         int // クライアント IP ごとに連続して要求できる回数
+}
+
+// EmailVerificationConfig はメールアドレスが未確認のユーザーに対する制限を保持します。
+type EmailVerificationConfig struct {
+	BlockLogin          bool // 未確認のユーザーはログインできない
+	BlockTaskAssignment bool // 未確認のユーザーをタスクの担当者に設定できない
+}
+
+// TOTPConfig は二要素認証 (TOTP) の設定を保持します。
+type TOTPConfig struct {
+	EncryptionKey string // シークレットの暗号化に使う鍵 (Base64 でエンコードした 32 バイト)
+	Issuer        string // 認証アプリに表示するサービス名
+}
+
+// LoginThrottleConfig はログインの総当たり攻撃への対策の設定を保持します。
+// 失敗回数がしきい値に達すると、以後の失敗ごとにロック時間を倍にします (上限あり)。
+type LoginThrottleConfig struct {
+	Store              string // 失敗回数の保存先 ("mysql" または "memory")
+	MaxAccountFailures int    // アカウントごとの失敗回数のしきい値
+	MaxIPFailures      int    // クライアント IP ごとの失敗回数のしきい値
+	FailureWindowMins  int    // 最後の失敗からこの時間が経過すると失敗回数をリセットする
+	BaseLockoutSecs    int    // しきい値に達したときのロック時間
+	MaxLockoutMins     int    // ロック時間の上限
+}
+
+// UserSearchConfig はユーザーの検索 (担当者の選択など) の設定を保持します。
+// ユーザーの列挙を防ぐため、ユーザーごととクライアント IP ごとに呼び出し回数を制限します。
+type UserSearchConfig struct {
+	RatePerMinute   int // ユーザーごとに 1 分あたりに検索できる回数
+	Burst           int // ユーザーごとに連続して検索できる回数
+	IPRatePerMinute int // クライアント IP ごとに 1 分あたりに検索できる回数
+	IPBurst         int // クライアント IP ごとに連続して検索できる回数
+}
+
+// OIDCConfig は OpenID Connect によるシングルサインオンの設定を保持します。
+// IssuerURL が空の場合、シングルサインオンは無効です。
+type OIDCConfig struct {
+	IssuerURL    string // ID プロバイダーの issuer (ディスカバリーに使用する)
+	ClientID     string
+	ClientSecret string   // 空の場合はパブリッククライアントとして PKCE のみで認可コードを交換する
+	RedirectURL  string   // ID プロバイダーからのリダイレクト先 (フロントエンドのコールバックページ)
+	Scopes       []string // 要求するスコープ (openid は常に含める)
+	AllowSignup  bool     // 未登録のメールアドレスの場合にユーザーを作成する
 }
 
 // LoadConfig は .env ファイルおよび環境変数から設定を読み込みます。
@@ -41,23 +118,152 @@ func LoadConfig() (*Config, error) {
 
 	// 環境変数から設定値を読み込む
 	dbDSN := getEnv("DB_DSN", "user:password@tcp(localhost:3306)/authdb?parseTime=true")
-	jwtSecret := getEnv("JWT_SECRET", "your-secret-key")
+	jwtSecret := getEnv("JWT_SECRET", "")
 	jwtDurationMinutes, err := getEnvInt("JWT_DURATION_MINUTES", 15)
 	if err != nil {
 		return nil, err
 	}
+	jwtRefreshDurationHours, err := getEnvInt("JWT_REFRESH_DURATION_HOURS", 24*30)
+	if err != nil {
+		return nil, err
+	}
+	jwtRevocationStore := getEnv("JWT_REVOCATION_STORE", "mysql")
+	jwtKeys, err := getEnvMap("JWT_KEYS")
+	if err != nil {
+		return nil, err
+	}
+	jwtActiveKeyID := getEnv("JWT_ACTIVE_KEY_ID", "")
 	appPort := getEnv("APP_PORT", "8080")
+	appBaseURL := getEnv("APP_BASE_URL", "http://localhost:3000")
+	appTrustProxyHeaders, err := getEnvBool("APP_TRUST_PROXY_HEADERS", false)
+	if err != nil {
+		return nil, err
+	}
+	blockUnverifiedLogin, err := getEnvBool("EMAIL_VERIFICATION_BLOCK_LOGIN", false)
+	if err != nil {
+		return nil, err
+	}
+	blockUnverifiedAssignment, err := getEnvBool("EMAIL_VERIFICATION_BLOCK_TASK_ASSIGNMENT", true)
+	if err != nil {
+		return nil, err
+	}
+	loginMaxAccountFailures, err := getEnvInt("LOGIN_MAX_ACCOUNT_FAILURES", 5)
+	if err != nil {
+		return nil, err
+	}
+	loginMaxIPFailures, err := getEnvInt("LOGIN_MAX_IP_FAILURES", 20)
+	if err != nil {
+		return nil, err
+	}
+	loginFailureWindowMins, err := getEnvInt("LOGIN_FAILURE_WINDOW_MINUTES", 15)
+	if err != nil {
+		return nil, err
+	}
+	loginBaseLockoutSecs, err := getEnvInt("LOGIN_BASE_LOCKOUT_SECONDS", 30)
+	if err != nil {
+		return nil, err
+	}
+	loginMaxLockoutMins, err := getEnvInt("LOGIN_MAX_LOCKOUT_MINUTES", 60)
+	if err != nil {
+		return nil, err
+	}
+	userSearchRatePerMinute, err := getEnvInt("USER_SEARCH_RATE_PER_MINUTE", 30)
+	if err != nil {
+		return nil, err
+	}
+	userSearchBurst, err := getEnvInt("USER_SEARCH_BURST", 10)
+	if err != nil {
+		return nil, err
+	}
+	userSearchIPRatePerMinute, err := getEnvInt("USER_SEARCH_IP_RATE_PER_MINUTE", 60)
+	if err != nil {
+		return nil, err
+	}
+	userSearchIPBurst, err := getEnvInt("USER_SEARCH_IP_BURST", 20)
+	if err != nil {
+		return nil, err
+	}
+	mailRatePerMinute, err := getEnvInt("MAIL_RATE_PER_MINUTE", 1)
+	if err != nil {
+		return nil, err
+	}
+	mailBurst, err := getEnvInt("MAIL_BURST", 3)
+	if err != nil {
+		return nil, err
+	}
+	mailIPRatePerMinute, err := getEnvInt("MAIL_IP_RATE_PER_MINUTE", 10)
+	if err != nil {
+		return nil, err
+	}
+	mailIPBurst, err := getEnvInt("MAIL_IP_BURST", 20)
+	if err != nil {
+		return nil, err
+	}
+	oidcAllowSignup, err := getEnvBool("OIDC_ALLOW_SIGNUP", true)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Config{
 		DB: DBConfig{
 			DSN: dbDSN,
 		},
 		JWT: JWTConfig{
-			Secret:          jwtSecret,
-			DurationMinutes: jwtDurationMinutes,
+			Secret:               jwtSecret,
+			DurationMinutes:      jwtDurationMinutes,
+			RefreshDurationHours: jwtRefreshDurationHours,
+			RevocationStore:      jwtRevocationStore,
+			Keys:                 jwtKeys,
+			ActiveKeyID:          jwtActiveKeyID,
 		},
 		App: AppConfig{
-			Port: appPort,
+			Port:              appPort,
+			BaseURL:           appBaseURL,
+			TrustProxyHeaders: appTrustProxyHeaders,
+		},
+		Mail: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "file"),
+			From:         getEnv("MAIL_FROM", "no-reply@example.com"),
+			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnv("SMTP_PORT", "587"),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			OutboxDir:    getEnv("MAIL_OUTBOX_DIR", "tmp/mail"),
+
+			RatePerMinute:   mailRatePerMinute,
+			Burst:           mailBurst,
+			IPRatePerMinute: mailIPRatePerMinute,
+			IPBurst:         mailIPBurst,
+		},
+		EmailVerification: EmailVerificationConfig{
+			BlockLogin:          blockUnverifiedLogin,
+			BlockTaskAssignment: blockUnverifiedAssignment,
+		},
+		TOTP: TOTPConfig{
+			EncryptionKey: getEnv("TOTP_ENCRYPTION_KEY", ""),
+			Issuer:        getEnv("TOTP_ISSUER", "connect-task-manage"),
+		},
+		LoginThrottle: LoginThrottleConfig{
+			Store:              getEnv("LOGIN_THROTTLE_STORE", "mysql"),
+			MaxAccountFailures: loginMaxAccountFailures,
+			MaxIPFailures:      loginMaxIPFailures,
+			FailureWindowMins:  loginFailureWindowMins,
+			BaseLockoutSecs:    loginBaseLockoutSecs,
+			MaxLockoutMins:     loginMaxLockoutMins,
+		},
+		UserSearch: UserSearchConfig{
+			RatePerMinute:   userSearchRatePerMinute,
+			Burst:           userSearchBurst,
+			IPRatePerMinute: userSearchIPRatePerMinute,
+			IPBurst:         userSearchIPBurst,
+		},
+		OIDC: OIDCConfig{
+			IssuerURL:    getEnv("OIDC_ISSUER_URL", ""),
+			ClientID:     getEnv("OIDC_CLIENT_ID", ""),
+			ClientSecret: getEnv("OIDC_CLIENT_SECRET", ""),
+			RedirectURL:  getEnv("OIDC_REDIRECT_URL", strings.TrimRight(appBaseURL, "/")+"/oidc/callback"),
+			Scopes:       strings.Fields(getEnv("OIDC_SCOPES", "openid email profile")),
+			AllowSignup:  oidcAllowSignup,
 		},
 	}, nil
 }
@@ -81,3 +287,35 @@ func getEnvInt(key string, defaultValue int) (int, error) {
 	return value, nil
 
 }
+
+// getEnvBool は指定された環境変数の値を真偽値として取得します。
+func getEnvBool(key string, defaultValue bool) (bool, error) {
+	valueStr := getEnv(key, strconv.FormatBool(defaultValue))
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean value for %s: %w", key, err)
+	}
+	return value, nil
+}
+
+// getEnvMap は "key1=value1,key2=value2" 形式の環境変数の値を map として取得します。
+// 環境変数が設定されていない場合は nil を返します。
+func getEnvMap(key string) (map[string]string, error) {
+	valueStr := getEnv(key, "")
+	if strings.TrimSpace(valueStr) == "" {
+		return nil, nil
+	}
+
+	values := make(map[string]string)
+	for _, pair := range strings.Split(valueStr, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || k == "" || v == "" {
+			return nil, fmt.Errorf("invalid key=value pair for %s: %q", key, pair)
+		}
+		if _, dup := values[k]; dup {
+			return nil, fmt.Errorf("duplicate key for %s: %q", key, k)
+		}
+		values[k] = v
+	}
+	return values, nil
+}
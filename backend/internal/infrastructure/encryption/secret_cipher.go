@@ -0,0 +1,78 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/a-s/connect-task-manage/internal/infrastructure/config"
+)
+
+// ErrKeyNotConfigured は暗号化鍵が設定されていない場合のエラーです。
+var ErrKeyNotConfigured = errors.New("TOTP_ENCRYPTION_KEY is not configured")
+
+// SecretCipher は TOTP のシークレットなど、復号が必要な値を保存時に暗号化します (AES-256-GCM)。
+// 暗号文の先頭に nonce を付加します。
+type SecretCipher struct {
+	aead cipher.AEAD // 鍵が設定されていない場合は nil
+}
+
+// NewSecretCipher は設定の鍵 (Base64 でエンコードした 32 バイト) から SecretCipher を作成します。
+// 鍵が設定されていない場合も起動は妨げず、暗号化・復号の時点で ErrKeyNotConfigured を返します。
+func NewSecretCipher(cfg *config.Config) (*SecretCipher, error) {
+	if cfg.TOTP.EncryptionKey == "" {
+		return &SecretCipher{}, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(cfg.TOTP.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP_ENCRYPTION_KEY: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("invalid TOTP_ENCRYPTION_KEY: must be 32 bytes, got %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretCipher{aead: aead}, nil
+}
+
+// Encrypt は平文を暗号化します。
+// additionalData (ユーザー ID など) は暗号化されませんが、復号時に同じ値でなければ失敗します。
+func (c *SecretCipher) Encrypt(plaintext, additionalData []byte) ([]byte, error) {
+	if c.aead == nil {
+		return nil, ErrKeyNotConfigured
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Decrypt は Encrypt で暗号化した値を復号します。
+func (c *SecretCipher) Decrypt(ciphertext, additionalData []byte) ([]byte, error) {
+	if c.aead == nil {
+		return nil, ErrKeyNotConfigured
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, errors.New("ciphertext too short")
+	}
+	plaintext, err := c.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return plaintext, nil
+}
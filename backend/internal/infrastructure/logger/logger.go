@@ -1,6 +1,8 @@
 package logger
 
 import (
+	"context"
+
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -27,5 +29,15 @@ func WithRequestID(logger *zap.Logger, requestID string) *zap.Logger {
 type ctxKey int
 
 const (
-	LoggerKey ctxKey = iota // 公開 (LoggerKey)
+	LoggerKey    ctxKey = iota // 公開 (LoggerKey)
+	RequestIDKey               // リクエスト ID (外部サービスへの呼び出しに引き継ぐ)
 )
+
+// FromContext はコンテキストに設定されたリクエスト用のロガーを返します。
+// 設定されていない場合は何も出力しないロガーを返します。
+func FromContext(ctx context.Context) *zap.Logger {
+	if log, ok := ctx.Value(LoggerKey).(*zap.Logger); ok {
+		return log
+	}
+	return zap.NewNop()
+}
@@ -2,57 +2,226 @@ package authorization
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"connectrpc.com/connect"
 	"github.com/a-s/connect-task-manage/internal/adapter/token"
 	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"google.golang.org/protobuf/proto"
 )
 
+// PersonalAccessTokenAuthenticator はパーソナルアクセストークンを検証し、トークンとトークンを作成したユーザーを返すインターフェースです。
+// ユーザーが無効にされている場合は model.ErrUserDisabled、メールアドレスの確認が必要な場合は model.ErrEmailNotVerified を返します。
+type PersonalAccessTokenAuthenticator interface {
+	AuthenticatePersonalAccessToken(ctx context.Context, rawToken string) (*model.PersonalAccessToken, *model.User, error)
+}
+
+// ActiveUserChecker は JWT のアクセストークンのユーザーが現在も使用できる状態かを確認するインターフェースです。
+// ユーザーが削除されている場合は model.ErrUserNotFound、無効にされている場合は model.ErrUserDisabled を返します。
+type ActiveUserChecker interface {
+	CheckActiveUser(ctx context.Context, userID string) error
+}
+
+// WorkspaceRoleResolver はユーザーのワークスペースでの役割を返すインターフェースです。
+// ユーザーがメンバーでない場合は model.ErrWorkspaceNotFound を返します。
+type WorkspaceRoleResolver interface {
+	WorkspaceRole(ctx context.Context, workspaceID, userID string) (model.WorkspaceRole, error)
+}
+
+// workspaceIDField は required_workspace_role を指定したメソッドで、ワークスペースを表すリクエストのフィールド名です。
+const workspaceIDField = "workspace_id"
+
+// ctxKey はコンテキストで使用するキーの型です。
+type ctxKey int
+
+const (
+	claimsKey ctxKey = iota // 検証済みのアクセストークンのクレーム
+)
+
+// ClaimsFromContext は認証済みのリクエストのコンテキストからトークンのクレームを取り出します。
+// パーソナルアクセストークンの場合、TokenID はトークンの ID、IssuedAt はトークンの作成日時です。
+func ClaimsFromContext(ctx context.Context) (*token.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*token.Claims)
+	return claims, ok
+}
+
+// authInterceptor は unary とストリーミングの両方の RPC に対応した認証インターセプターです。
+//
+//   - ハンドラー側: Authorization ヘッダーのトークンを検証し、ユーザー ID をコンテキストに設定する
+//   - クライアント側: 何もしない (ユーザーのトークンを呼び出し先のサービスに送らないため。
+//     認証が必要な場合は呼び出し先ごとのクライアントで資格情報を設定する)
+//
+// Authorization ヘッダーには JWT のアクセストークンのほか、パーソナルアクセストークンも指定できます。
+// JWT の場合も、トークンのユーザーが削除または無効にされていれば拒否します (削除したユーザーの失効情報は残らないため)。
+// メソッドごとに必要な権限 (認証の要否・役割・スコープ・ワークスペースでの役割) は PermissionTable に従います。
+type authInterceptor struct {
+	tm          token.TokenManager
+	users       ActiveUserChecker
+	pats        PersonalAccessTokenAuthenticator
+	workspaces  WorkspaceRoleResolver
+	permissions PermissionTable
+}
+
 // NewAuthInterceptor は認証インターセプターを作成します。
-func NewAuthInterceptor(tm token.TokenManager) connect.UnaryInterceptorFunc { // 戻り値の型は connect.UnaryInterceptorFunc
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(
-			ctx context.Context,
-			req connect.AnyRequest,
-		) (connect.AnyResponse, error) {
-			// 認証が不要なメソッドのリスト (Login, CreateUser など)
-			unprotectedMethods := map[string]struct{}{
-				"/user.v1.UserService/CreateUser": {},
-				"/user.v1.UserService/Login":      {},
-			}
+func NewAuthInterceptor(tm token.TokenManager, users ActiveUserChecker, pats PersonalAccessTokenAuthenticator, workspaces WorkspaceRoleResolver, permissions PermissionTable) connect.Interceptor {
+	return &authInterceptor{tm: tm, users: users, pats: pats, workspaces: workspaces, permissions: permissions}
+}
 
-			// メソッド名を取得
-			methodName := req.Spec().Procedure
-			if _, ok := unprotectedMethods[methodName]; ok {
-				// 認証不要なメソッドはそのまま next に渡す
-				return next(ctx, req)
-			}
+// Order はインターセプターの適用順を返します (小さいほど外側)。
+func (i *authInterceptor) Order() int {
+	return 10
+}
 
-			// Authorization ヘッダーからトークンを取得
-			authHeader := req.Header().Get("Authorization")
-			if authHeader == "" {
-				return nil, connect.NewError(connect.CodeUnauthenticated, model.ErrUnauthorized)
-			}
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
 
-			// "Bearer " プレフィックスを取り除く
-			tokenString := ""
-			_, err := fmt.Sscanf(authHeader, "Bearer %s", &tokenString)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, model.ErrUnauthorized)
+		permission := i.permissions.lookup(req.Spec().Procedure)
+		ctx, err := i.authenticate(ctx, req.Spec().Procedure, permission, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		if permission.WorkspaceRole != "" {
+			if err := i.authorizeWorkspace(ctx, req.Spec().Procedure, permission, req.Any()); err != nil {
+				return nil, err
 			}
+		}
+		return next(ctx, req)
+	})
+}
 
-			// トークンを検証し、ユーザー ID を取得
-			userID, err := tm.Verify(tokenString)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token verification failed: %w", err))
-			}
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
 
-			// コンテキストにユーザー ID を設定
-			ctx = context.WithValue(ctx, "userID", userID)
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		// ストリーミングのメソッドにはワークスペースでの役割を指定できない (LoadPermissionTable で確認済み)
+		ctx, err := i.authenticate(ctx, conn.Spec().Procedure, i.permissions.lookup(conn.Spec().Procedure), conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	})
+}
 
-			return next(ctx, req)
-		})
+// authenticate は Authorization ヘッダーのトークンを検証し、ユーザー ID を設定したコンテキストを返します。
+func (i *authInterceptor) authenticate(ctx context.Context, procedure string, permission Permission, header http.Header) (context.Context, error) {
+	if permission.Public {
+		// 認証不要なメソッドはそのまま next に渡す
+		return ctx, nil
+	}
+
+	// Authorization ヘッダーからトークンを取得
+	authHeader := header.Get("Authorization")
+	if authHeader == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, model.ErrUnauthorized)
+	}
+
+	// "Bearer " プレフィックスを取り除く
+	tokenString := ""
+	_, err := fmt.Sscanf(authHeader, "Bearer %s", &tokenString)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, model.ErrUnauthorized)
+	}
+
+	if model.IsPersonalAccessToken(tokenString) {
+		return i.authenticatePersonalAccessToken(ctx, procedure, permission, tokenString)
+	}
+
+	// トークンを検証し、クレームを取得 (失効済みのトークンもここで拒否される)
+	claims, err := i.tm.Verify(ctx, tokenString)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token verification failed: %w", err))
+	}
+	// 削除されたユーザーのトークンは失効情報も削除されているため、ユーザーの状態を確認する
+	if err := i.users.CheckActiveUser(ctx, claims.UserID); err != nil {
+		switch {
+		case errors.Is(err, model.ErrUserNotFound):
+			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token verification failed: %w", err))
+		case errors.Is(err, model.ErrUserDisabled):
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if !claims.Role.Includes(permission.Role) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%w: %s requires role %q", model.ErrPermissionDenied, procedure, permission.Role))
+	}
+
+	// コンテキストにユーザー ID とトークンを設定
+	ctx = context.WithValue(ctx, "userID", claims.UserID)
+	ctx = context.WithValue(ctx, claimsKey, claims)
+	return ctx, nil
+}
+
+// authenticatePersonalAccessToken はパーソナルアクセストークンを検証し、
+// 呼び出すメソッドに必要なスコープが許可されていればユーザー ID を設定したコンテキストを返します。
+func (i *authInterceptor) authenticatePersonalAccessToken(ctx context.Context, procedure string, permission Permission, tokenString string) (context.Context, error) {
+	pat, user, err := i.pats.AuthenticatePersonalAccessToken(ctx, tokenString)
+	if err != nil {
+		if errors.Is(err, model.ErrUserDisabled) || errors.Is(err, model.ErrEmailNotVerified) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token verification failed: %w", err))
+	}
+
+	if permission.Scope == "" {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%w: %s cannot be called with a personal access token", model.ErrInsufficientScope, procedure))
+	}
+	if !pat.HasScope(permission.Scope) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%w: %s requires %q", model.ErrInsufficientScope, procedure, permission.Scope))
+	}
+
+	// JWT の場合と同じくクレームも設定する
+	claims := &token.Claims{
+		UserID:    pat.UserID,
+		TokenID:   pat.ID,
+		Role:      user.Role,
+		IssuedAt:  pat.CreatedAt,
+		ExpiresAt: pat.ExpiresAt,
+	}
+	ctx = context.WithValue(ctx, "userID", pat.UserID)
+	ctx = context.WithValue(ctx, claimsKey, claims)
+	return ctx, nil
+}
+
+// authorizeWorkspace はリクエストの workspace_id のワークスペースで、ユーザーが必要な役割を持つことを確認します。
+// メンバーでないワークスペースは存在を明かさないよう NotFound にします。
+func (i *authInterceptor) authorizeWorkspace(ctx context.Context, procedure string, permission Permission, msg any) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected request type %T", msg))
+	}
+	field := m.ProtoReflect().Descriptor().Fields().ByName(workspaceIDField)
+	if field == nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("%s has no %s field", procedure, workspaceIDField))
+	}
+	workspaceID := m.ProtoReflect().Get(field).String()
+	if workspaceID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is required", workspaceIDField))
+	}
+
+	userID, _ := ctx.Value("userID").(string)
+	role, err := i.workspaces.WorkspaceRole(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrWorkspaceNotFound) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if !role.Includes(permission.WorkspaceRole) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%w: %s requires workspace role %q", model.ErrPermissionDenied, procedure, permission.WorkspaceRole))
 	}
-	return connect.UnaryInterceptorFunc(interceptor) // connect.UnaryInterceptorFunc 型の値を返す
+	return nil
 }
@@ -0,0 +1,303 @@
+package authorization
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/a-s/connect-task-manage/internal/adapter/token"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// テスト用のストリーミングのメソッド。ハンドラーはコンテキストのユーザー ID を返す。
+const (
+	serverStreamProcedure = "/test.v1.TestService/ServerStream"
+	clientStreamProcedure = "/test.v1.TestService/ClientStream"
+	publicProcedure       = "/test.v1.TestService/Public"
+	claimsProcedure       = "/test.v1.TestService/Claims"
+
+	validToken        = "valid-token"
+	deletedUserToken  = "deleted-user-token"
+	disabledUserToken = "disabled-user-token"
+	testUserID        = "user-1"
+	deletedUserID     = "user-deleted"
+	disabledUserID    = "user-disabled"
+
+	validPAT    = model.PersonalAccessTokenPrefix + "valid"
+	readOnlyPAT = model.PersonalAccessTokenPrefix + "read-only"
+	disabledPAT = model.PersonalAccessTokenPrefix + "disabled"
+)
+
+// stubTokenManager は validToken・deletedUserToken・disabledUserToken を有効なトークンとして扱う TokenManager です。
+type stubTokenManager struct{}
+
+func (stubTokenManager) Generate(*model.User) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (stubTokenManager) Verify(_ context.Context, tokenString string) (*token.Claims, error) {
+	userID, ok := map[string]string{
+		validToken:        testUserID,
+		deletedUserToken:  deletedUserID,
+		disabledUserToken: disabledUserID,
+	}[tokenString]
+	if !ok {
+		return nil, model.ErrUnauthorized
+	}
+	return &token.Claims{UserID: userID, TokenID: "jti", Role: model.RoleMember}, nil
+}
+
+func (stubTokenManager) Revoke(context.Context, *token.Claims) error { return nil }
+
+func (stubTokenManager) RevokeAll(context.Context, string) error { return nil }
+
+func (stubTokenManager) GenerateChallenge(*model.User) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (stubTokenManager) VerifyChallenge(context.Context, string) (*token.Claims, error) {
+	return nil, model.ErrInvalidChallengeToken
+}
+
+// stubUserChecker は deletedUserID を削除されたユーザー、disabledUserID を無効にされたユーザーとして扱います。
+type stubUserChecker struct{}
+
+func (stubUserChecker) CheckActiveUser(_ context.Context, userID string) error {
+	switch userID {
+	case deletedUserID:
+		return model.ErrUserNotFound
+	case disabledUserID:
+		return model.ErrUserDisabled
+	default:
+		return nil
+	}
+}
+
+// stubPATAuthenticator は validPAT / readOnlyPAT を有効なトークン、disabledPAT を無効にされたユーザーのトークンとして扱います。
+type stubPATAuthenticator struct{}
+
+func (stubPATAuthenticator) AuthenticatePersonalAccessToken(_ context.Context, rawToken string) (*model.PersonalAccessToken, *model.User, error) {
+	user := &model.User{ID: testUserID, Role: model.RoleMember}
+	switch rawToken {
+	case validPAT:
+		return &model.PersonalAccessToken{ID: "pat-1", UserID: testUserID, Scopes: []model.Scope{model.ScopeTasksRead}}, user, nil
+	case readOnlyPAT:
+		return &model.PersonalAccessToken{ID: "pat-2", UserID: testUserID, Scopes: []model.Scope{model.ScopeUserRead}}, user, nil
+	case disabledPAT:
+		return nil, nil, model.ErrUserDisabled
+	default:
+		return nil, nil, model.ErrInvalidPersonalAccessToken
+	}
+}
+
+// newStreamingServer は認証インターセプターを設定したテスト用のサービスを起動します。
+// Public のメソッドは受け取った Authorization ヘッダーを、Claims のメソッドはコンテキストのクレームの TokenID を返します。
+func newStreamingServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	interceptor := connect.WithInterceptors(NewAuthInterceptor(stubTokenManager{}, stubUserChecker{}, stubPATAuthenticator{}, nil, PermissionTable{
+		publicProcedure: {Public: true},
+		claimsProcedure: {Role: model.RoleMember, Scope: model.ScopeTasksRead},
+	}))
+
+	mux := http.NewServeMux()
+	mux.Handle(serverStreamProcedure, connect.NewServerStreamHandler(serverStreamProcedure,
+		func(ctx context.Context, _ *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+			userID, _ := ctx.Value("userID").(string)
+			for range 2 {
+				if err := stream.Send(wrapperspb.String(userID)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, interceptor))
+	mux.Handle(clientStreamProcedure, connect.NewClientStreamHandler(clientStreamProcedure,
+		func(ctx context.Context, stream *connect.ClientStream[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+			for stream.Receive() {
+			}
+			if err := stream.Err(); err != nil {
+				return nil, err
+			}
+			userID, _ := ctx.Value("userID").(string)
+			return connect.NewResponse(wrapperspb.String(userID)), nil
+		}, interceptor))
+	mux.Handle(publicProcedure, connect.NewUnaryHandler(publicProcedure,
+		func(_ context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+			return connect.NewResponse(wrapperspb.String(req.Header().Get("Authorization"))), nil
+		}, interceptor))
+
+	mux.Handle(claimsProcedure, connect.NewUnaryHandler(claimsProcedure,
+		func(ctx context.Context, _ *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+			claims, ok := ClaimsFromContext(ctx)
+			if !ok {
+				return nil, connect.NewError(connect.CodeInternal, errors.New("claims not found in context"))
+			}
+			return connect.NewResponse(wrapperspb.String(claims.TokenID)), nil
+		}, interceptor))
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// パーソナルアクセストークンでも JWT と同じくクレームがコンテキストに設定され、スコープとユーザーの状態が確認される
+func TestAuthInterceptorPersonalAccessToken(t *testing.T) {
+	server := newStreamingServer(t)
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+claimsProcedure)
+
+	tests := []struct {
+		name        string
+		token       string
+		wantCode    connect.Code // 0 の場合は成功
+		wantTokenID string
+	}{
+		{name: "JWT", token: validToken, wantTokenID: "jti"},
+		{name: "パーソナルアクセストークン", token: validPAT, wantTokenID: "pat-1"},
+		{name: "スコープが足りない", token: readOnlyPAT, wantCode: connect.CodePermissionDenied},
+		{name: "無効にされたユーザー", token: disabledPAT, wantCode: connect.CodePermissionDenied},
+		{name: "無効なトークン", token: model.PersonalAccessTokenPrefix + "unknown", wantCode: connect.CodeUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := connect.NewRequest(wrapperspb.String(""))
+			req.Header().Set("Authorization", "Bearer "+tt.token)
+			res, err := client.CallUnary(context.Background(), req)
+			checkCode(t, err, tt.wantCode)
+			if tt.wantCode == 0 && res.Msg.GetValue() != tt.wantTokenID {
+				t.Errorf("token id = %q, want %q", res.Msg.GetValue(), tt.wantTokenID)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptorStreamingHandler(t *testing.T) {
+	server := newStreamingServer(t)
+
+	tests := []struct {
+		name     string
+		header   string
+		wantCode connect.Code // 0 の場合は成功
+	}{
+		{name: "トークンなし", wantCode: connect.CodeUnauthenticated},
+		{name: "Bearer 形式でない", header: validToken, wantCode: connect.CodeUnauthenticated},
+		{name: "無効なトークン", header: "Bearer invalid-token", wantCode: connect.CodeUnauthenticated},
+		{name: "有効なトークン", header: "Bearer " + validToken},
+	}
+	for _, tt := range tests {
+		t.Run("server stream/"+tt.name, func(t *testing.T) {
+			client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+serverStreamProcedure)
+			req := connect.NewRequest(wrapperspb.String("ping"))
+			if tt.header != "" {
+				req.Header().Set("Authorization", tt.header)
+			}
+			stream, err := client.CallServerStream(context.Background(), req)
+			if err != nil {
+				t.Fatalf("CallServerStream: %v", err)
+			}
+			defer stream.Close()
+
+			var received []string
+			for stream.Receive() {
+				received = append(received, stream.Msg().GetValue())
+			}
+			checkCode(t, stream.Err(), tt.wantCode)
+			if tt.wantCode == 0 && (len(received) != 2 || received[0] != testUserID) {
+				t.Errorf("received = %v, want 2 messages of %q", received, testUserID)
+			}
+		})
+
+		t.Run("client stream/"+tt.name, func(t *testing.T) {
+			client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+clientStreamProcedure)
+			stream := client.CallClientStream(context.Background())
+			if tt.header != "" {
+				stream.RequestHeader().Set("Authorization", tt.header)
+			}
+			for range 3 {
+				if err := stream.Send(wrapperspb.String("ping")); err != nil {
+					break // 拒否された場合はエラーを CloseAndReceive で受け取る
+				}
+			}
+			res, err := stream.CloseAndReceive()
+			checkCode(t, err, tt.wantCode)
+			if tt.wantCode == 0 && res.Msg.GetValue() != testUserID {
+				t.Errorf("user id = %q, want %q", res.Msg.GetValue(), testUserID)
+			}
+		})
+	}
+}
+
+// ハンドラーで検証したトークンが、そこから呼び出すサービスへのリクエストに送られないことを確認する
+func TestAuthInterceptorDoesNotForwardToken(t *testing.T) {
+	server := newStreamingServer(t)
+	downstream := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+publicProcedure,
+		connect.WithInterceptors(NewAuthInterceptor(stubTokenManager{}, stubUserChecker{}, nil, nil, PermissionTable{})))
+
+	interceptor := NewAuthInterceptor(stubTokenManager{}, stubUserChecker{}, nil, nil, PermissionTable{})
+	mux := http.NewServeMux()
+	mux.Handle(serverStreamProcedure, connect.NewServerStreamHandler(serverStreamProcedure,
+		func(ctx context.Context, _ *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+			res, err := downstream.CallUnary(ctx, connect.NewRequest(wrapperspb.String("")))
+			if err != nil {
+				return err
+			}
+			return stream.Send(res.Msg)
+		}, connect.WithInterceptors(interceptor)))
+	upstream := httptest.NewServer(mux)
+	defer upstream.Close()
+
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](upstream.Client(), upstream.URL+serverStreamProcedure)
+	req := connect.NewRequest(wrapperspb.String("ping"))
+	req.Header().Set("Authorization", "Bearer "+validToken)
+	stream, err := client.CallServerStream(context.Background(), req)
+	if err != nil {
+		t.Fatalf("CallServerStream: %v", err)
+	}
+	defer stream.Close()
+
+	if !stream.Receive() {
+		t.Fatalf("Receive: %v", stream.Err())
+	}
+	if got := stream.Msg().GetValue(); got != "" {
+		t.Errorf("downstream Authorization = %q, want empty", got)
+	}
+}
+
+// 署名と失効情報の確認を通った JWT でも、削除または無効にされたユーザーのトークンは拒否する
+func TestAuthInterceptorRejectsInactiveUser(t *testing.T) {
+	server := newStreamingServer(t)
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+claimsProcedure)
+
+	tests := []struct {
+		name     string
+		token    string
+		wantCode connect.Code
+	}{
+		{name: "削除されたユーザー", token: deletedUserToken, wantCode: connect.CodeUnauthenticated},
+		{name: "無効にされたユーザー", token: disabledUserToken, wantCode: connect.CodePermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := connect.NewRequest(wrapperspb.String(""))
+			req.Header().Set("Authorization", "Bearer "+tt.token)
+			_, err := client.CallUnary(context.Background(), req)
+			checkCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+// checkCode は wantCode が 0 の場合は err が nil、それ以外の場合は err のコードが wantCode であることを確認します。
+func checkCode(t *testing.T, err error, wantCode connect.Code) {
+	t.Helper()
+	if wantCode == 0 {
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		return
+	}
+	if got := connect.CodeOf(err); got != wantCode {
+		t.Fatalf("code = %v (err = %v), want %v", got, err, wantCode)
+	}
+}
@@ -0,0 +1,147 @@
+package authorization
+
+import (
+	"fmt"
+
+	authv1 "github.com/a-s/connect-task-manage/gen/api/auth/v1"
+	"github.com/a-s/connect-task-manage/internal/domain/model"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Permission はメソッドを呼び出すために必要な権限です。
+type Permission struct {
+	Public        bool                // 認証が不要
+	Role          model.Role          // 呼び出すために必要な役割
+	Scope         model.Scope         // パーソナルアクセストークンで呼び出す場合に必要なスコープ (空の場合は呼び出せない)
+	WorkspaceRole model.WorkspaceRole // リクエストの workspace_id のワークスペースで必要な役割 (空の場合は確認しない)
+}
+
+// defaultPermission は (auth.v1.policy) を指定していないメソッドの権限です。
+var defaultPermission = Permission{Role: model.RoleMember}
+
+// PermissionTable は手続き名 ("/user.v1.UserService/Login" など) ごとの権限の一覧です。
+type PermissionTable map[string]Permission
+
+// NewPermissionTable は登録済みの proto ファイルのメソッドオプション (auth.v1.policy) から権限の一覧を作成します。
+// サービスの生成コードを import したパッケージから呼び出してください (未登録のサービスは一覧に含まれません)。
+func NewPermissionTable() (PermissionTable, error) {
+	return LoadPermissionTable(protoregistry.GlobalFiles)
+}
+
+// LoadPermissionTable は proto ファイルのメソッドオプションから権限の一覧を作成します。
+// 指定できないスコープや、矛盾する組み合わせ (public と役割など) がある場合はエラーを返します。
+func LoadPermissionTable(files *protoregistry.Files) (PermissionTable, error) {
+	table := make(PermissionTable)
+	var err error
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				procedure := fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+
+				var permission Permission
+				permission, err = permissionFromOptions(method)
+				if err != nil {
+					err = fmt.Errorf("invalid (auth.v1.policy) on %s: %w", procedure, err)
+					return false
+				}
+				table[procedure] = permission
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// permissionFromOptions はメソッドオプションの (auth.v1.policy) を Permission に変換します。
+func permissionFromOptions(method protoreflect.MethodDescriptor) (Permission, error) {
+	options := method.Options()
+	if options == nil || !proto.HasExtension(options, authv1.E_Policy) {
+		return defaultPermission, nil
+	}
+	policy, ok := proto.GetExtension(options, authv1.E_Policy).(*authv1.MethodPolicy)
+	if !ok || policy == nil {
+		return defaultPermission, nil
+	}
+
+	permission := Permission{Public: policy.Public, Role: model.RoleMember}
+	switch policy.RequiredRole {
+	case authv1.Role_ROLE_UNSPECIFIED, authv1.Role_ROLE_MEMBER:
+	case authv1.Role_ROLE_ADMIN:
+		permission.Role = model.RoleAdmin
+	default:
+		return Permission{}, fmt.Errorf("unknown role %v", policy.RequiredRole)
+	}
+
+	if policy.PersonalAccessTokenScope != "" {
+		scopes, err := model.ParseScopes([]string{policy.PersonalAccessTokenScope})
+		if err != nil {
+			return Permission{}, err
+		}
+		permission.Scope = scopes[0]
+	}
+
+	if policy.RequiredWorkspaceRole != authv1.WorkspaceRole_WORKSPACE_ROLE_UNSPECIFIED {
+		role, err := workspaceRoleFromProto(policy.RequiredWorkspaceRole)
+		if err != nil {
+			return Permission{}, err
+		}
+		if err := checkWorkspaceIDField(method); err != nil {
+			return Permission{}, err
+		}
+		permission.WorkspaceRole = role
+	}
+
+	switch {
+	case permission.Public && (policy.RequiredRole != authv1.Role_ROLE_UNSPECIFIED || permission.Scope != "" || permission.WorkspaceRole != ""):
+		return Permission{}, fmt.Errorf("public cannot be combined with required_role, personal_access_token_scope or required_workspace_role")
+	case permission.Role != model.RoleMember && permission.Scope != "":
+		// パーソナルアクセストークンにはユーザーの役割が含まれないため
+		return Permission{}, fmt.Errorf("personal_access_token_scope cannot be combined with required_role %v", policy.RequiredRole)
+	}
+	return permission, nil
+}
+
+// workspaceRoleFromProto は authv1.WorkspaceRole を model.WorkspaceRole に変換します。
+func workspaceRoleFromProto(role authv1.WorkspaceRole) (model.WorkspaceRole, error) {
+	switch role {
+	case authv1.WorkspaceRole_WORKSPACE_ROLE_VIEWER:
+		return model.WorkspaceRoleViewer, nil
+	case authv1.WorkspaceRole_WORKSPACE_ROLE_MEMBER:
+		return model.WorkspaceRoleMember, nil
+	case authv1.WorkspaceRole_WORKSPACE_ROLE_ADMIN:
+		return model.WorkspaceRoleAdmin, nil
+	case authv1.WorkspaceRole_WORKSPACE_ROLE_OWNER:
+		return model.WorkspaceRoleOwner, nil
+	default:
+		return "", fmt.Errorf("unknown workspace role %v", role)
+	}
+}
+
+// checkWorkspaceIDField はワークスペースの役割を確認できるメソッドか (リクエストの workspace_id を読めるか) を確認します。
+// ストリーミングのメソッドはリクエストを受信する前に確認できないため指定できません。
+func checkWorkspaceIDField(method protoreflect.MethodDescriptor) error {
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		return fmt.Errorf("required_workspace_role cannot be used on streaming methods")
+	}
+	field := method.Input().Fields().ByName(workspaceIDField)
+	if field == nil || field.Kind() != protoreflect.StringKind || field.Cardinality() == protoreflect.Repeated {
+		return fmt.Errorf("required_workspace_role requires a string %s field in %s", workspaceIDField, method.Input().FullName())
+	}
+	return nil
+}
+
+// lookup は手続きの権限を返します。一覧にない手続きは (auth.v1.policy) を指定していないメソッドと同じ扱いにします。
+func (t PermissionTable) lookup(procedure string) Permission {
+	if permission, ok := t[procedure]; ok {
+		return permission
+	}
+	return defaultPermission
+}
@@ -2,6 +2,11 @@ package logging
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/connect"
@@ -10,56 +15,239 @@ import (
 	"go.uber.org/zap"
 )
 
+// requestIDHeader はリクエスト ID を受け渡すヘッダー
+const requestIDHeader = "X-Request-ID"
+
+// loggingInterceptor は unary とストリーミングの両方の RPC に対応したロギングインターセプターです。
+//
+//   - ハンドラー側: リクエスト ID 付きのロガーをコンテキストに設定し、開始・終了を記録する
+//   - クライアント側: コンテキストのリクエスト ID を送信するリクエストに引き継ぎ、開始・終了を記録する
+type loggingInterceptor struct {
+	log *zap.Logger
+}
+
 // NewLoggingInterceptor はロギングインターセプターを作成します。
-func NewLoggingInterceptor(log *zap.Logger) connect.UnaryInterceptorFunc {
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(
-			ctx context.Context,
-			req connect.AnyRequest,
-		) (connect.AnyResponse, error) {
-			// リクエストIDの生成 (存在しない場合)
-			requestID := req.Header().Get("X-Request-ID")
-			if requestID == "" {
-				requestID = uuid.New().String()
-			}
-
-			// リクエストID付きのロガーをコンテキストに追加
-			ctx = context.WithValue(ctx, logger.LoggerKey, logger.WithRequestID(log, requestID)) // LoggerKey を使用
-			log = logger.WithRequestID(log, requestID)                                           //リクエストID付きのロガーを作成
-
-			start := time.Now()
-
-			// リクエスト情報のログ出力
-			log.Info("request started",
+func NewLoggingInterceptor(log *zap.Logger) connect.Interceptor {
+	return &loggingInterceptor{log: log}
+}
+
+// Order はインターセプターの適用順を返します (小さいほど外側)。
+// 認証エラーなども記録できるよう最も外側に置きます。
+func (i *loggingInterceptor) Order() int {
+	return 0
+}
+
+func (i *loggingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		var log *zap.Logger
+		if req.Spec().IsClient {
+			log = i.propagateRequestID(ctx, req.Header())
+		} else {
+			ctx, log = i.withRequestLogger(ctx, req.Header().Get(requestIDHeader))
+		}
+
+		start := time.Now()
+
+		// リクエスト情報のログ出力
+		log.Info("request started",
+			zap.String("method", req.Spec().Procedure),
+			zap.Bool("client", req.Spec().IsClient),
+			zap.Any("headers", req.Header()), // ヘッダーもログ出力
+		)
+
+		// リクエストの実行
+		res, err := next(ctx, req)
+
+		duration := time.Since(start)
+
+		// レスポンス情報のログ出力 (エラーの有無でレベルを分ける)
+		if err != nil {
+			log.Error("request failed",
 				zap.String("method", req.Spec().Procedure),
-				zap.String("request_id", requestID),
-				zap.Any("headers", req.Header()), // ヘッダーもログ出力
+				zap.Bool("client", req.Spec().IsClient),
+				zap.Duration("duration", duration),
+				zap.Error(err),
 			)
+		} else {
+			log.Info("request completed",
+				zap.String("method", req.Spec().Procedure),
+				zap.Bool("client", req.Spec().IsClient),
+				zap.Duration("duration", duration),
+				zap.Any("headers", res.Header()), //レスポンスヘッダー
+			)
+		}
+
+		return res, err
+	})
+}
+
+func (i *loggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return connect.StreamingClientFunc(func(
+		ctx context.Context,
+		spec connect.Spec,
+	) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		log := i.propagateRequestID(ctx, conn.RequestHeader())
+
+		log.Info("stream started",
+			zap.String("method", spec.Procedure),
+			zap.Bool("client", true),
+		)
+
+		return &loggingClientConn{
+			StreamingClientConn: conn,
+			log:                 log,
+			start:               time.Now(),
+		}
+	})
+}
+
+func (i *loggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		ctx, log := i.withRequestLogger(ctx, conn.RequestHeader().Get(requestIDHeader))
+
+		start := time.Now()
+
+		log.Info("stream started",
+			zap.String("method", conn.Spec().Procedure),
+			zap.Bool("client", false),
+			zap.Any("headers", conn.RequestHeader()),
+		)
+
+		// 送受信したメッセージ数を数える
+		counting := &countingHandlerConn{StreamingHandlerConn: conn}
+		err := next(ctx, counting)
+
+		logStreamEnd(log, conn.Spec(), time.Since(start), &counting.counter, err)
+		return err
+	})
+}
+
+// withRequestLogger はリクエスト ID 付きのロガーを作成し、コンテキストに設定します。
+// requestID が空の場合は新しく採番します。
+func (i *loggingInterceptor) withRequestLogger(ctx context.Context, requestID string) (context.Context, *zap.Logger) {
+	// リクエストIDの生成 (存在しない場合)
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// リクエストID付きのロガーをコンテキストに追加
+	log := logger.WithRequestID(i.log, requestID)
+	ctx = context.WithValue(ctx, logger.LoggerKey, log)
+	ctx = context.WithValue(ctx, logger.RequestIDKey, requestID)
+	return ctx, log
+}
+
+// propagateRequestID はコンテキストのリクエスト ID を送信するリクエストのヘッダーに設定し、
+// クライアント側で使うロガーを返します。
+func (i *loggingInterceptor) propagateRequestID(ctx context.Context, header http.Header) *zap.Logger {
+	requestID, ok := ctx.Value(logger.RequestIDKey).(string)
+	if !ok {
+		return i.log
+	}
+	if header.Get(requestIDHeader) == "" {
+		header.Set(requestIDHeader, requestID)
+	}
+	return logger.WithRequestID(i.log, requestID)
+}
+
+// logStreamEnd はストリームの終了を記録します。
+func logStreamEnd(log *zap.Logger, spec connect.Spec, duration time.Duration, c *counter, err error) {
+	if err != nil {
+		log.Error("stream failed",
+			zap.String("method", spec.Procedure),
+			zap.Bool("client", spec.IsClient),
+			zap.Duration("duration", duration),
+			zap.Int64("received", c.received.Load()),
+			zap.Int64("sent", c.sent.Load()),
+			zap.Error(err),
+		)
+		return
+	}
+	log.Info("stream completed",
+		zap.String("method", spec.Procedure),
+		zap.Bool("client", spec.IsClient),
+		zap.Duration("duration", duration),
+		zap.Int64("received", c.received.Load()),
+		zap.Int64("sent", c.sent.Load()),
+	)
+}
+
+// counter は送受信したメッセージ数を数えます。Send と Receive は並行に呼ばれることがあります。
+type counter struct {
+	received atomic.Int64
+	sent     atomic.Int64
+}
+
+// countingHandlerConn は送受信したメッセージ数を数える StreamingHandlerConn のラッパーです。
+type countingHandlerConn struct {
+	connect.StreamingHandlerConn
+	counter
+}
+
+func (c *countingHandlerConn) Receive(msg any) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err == nil {
+		c.received.Add(1)
+	}
+	return err
+}
+
+func (c *countingHandlerConn) Send(msg any) error {
+	err := c.StreamingHandlerConn.Send(msg)
+	if err == nil && msg != nil { // nil はヘッダーのみの送信
+		c.sent.Add(1)
+	}
+	return err
+}
+
+// loggingClientConn はメッセージ数を数え、CloseResponse でストリームの終了を記録する StreamingClientConn のラッパーです。
+type loggingClientConn struct {
+	connect.StreamingClientConn
+	counter
+	log   *zap.Logger
+	start time.Time
+
+	mu     sync.Mutex
+	err    error // Receive が返した io.EOF 以外のエラー
+	closed bool
+}
+
+func (c *loggingClientConn) Receive(msg any) error {
+	err := c.StreamingClientConn.Receive(msg)
+	switch {
+	case err == nil:
+		c.received.Add(1)
+	case !errors.Is(err, io.EOF):
+		c.mu.Lock()
+		c.err = err
+		c.mu.Unlock()
+	}
+	return err
+}
+
+func (c *loggingClientConn) Send(msg any) error {
+	err := c.StreamingClientConn.Send(msg)
+	if err == nil {
+		c.sent.Add(1)
+	}
+	return err
+}
+
+func (c *loggingClientConn) CloseResponse() error {
+	err := c.StreamingClientConn.CloseResponse()
 
-			// リクエストの実行
-			res, err := next(ctx, req)
-
-			duration := time.Since(start)
-
-			// レスポンス情報のログ出力 (エラーの有無でレベルを分ける)
-			if err != nil {
-				log.Error("request failed",
-					zap.String("method", req.Spec().Procedure),
-					zap.String("request_id", requestID),
-					zap.Duration("duration", duration),
-					zap.Error(err),
-				)
-			} else {
-				log.Info("request completed",
-					zap.String("method", req.Spec().Procedure),
-					zap.String("request_id", requestID),
-					zap.Duration("duration", duration),
-					zap.Any("headers", res.Header()), //レスポンスヘッダー
-				)
-			}
-
-			return res, err
-		})
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.closed = true
+		logStreamEnd(c.log, c.Spec(), time.Since(c.start), &c.counter, c.err)
 	}
-	return connect.UnaryInterceptorFunc(interceptor)
+	return err
 }
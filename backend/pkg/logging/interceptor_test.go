@@ -0,0 +1,171 @@
+package logging
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/a-s/connect-task-manage/internal/infrastructure/logger"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// テスト用のストリーミングのメソッド。ハンドラーはコンテキストのリクエスト ID を返す。
+const (
+	serverStreamProcedure = "/test.v1.TestService/ServerStream"
+	clientStreamProcedure = "/test.v1.TestService/ClientStream"
+
+	testRequestID = "req-1"
+)
+
+// newStreamingServer はロギングインターセプターを設定したテスト用のサービスを起動します。
+func newStreamingServer(t *testing.T, log *zap.Logger) *httptest.Server {
+	t.Helper()
+	interceptor := connect.WithInterceptors(NewLoggingInterceptor(log))
+
+	mux := http.NewServeMux()
+	mux.Handle(serverStreamProcedure, connect.NewServerStreamHandler(serverStreamProcedure,
+		func(ctx context.Context, _ *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+			requestID, _ := ctx.Value(logger.RequestIDKey).(string)
+			for range 2 {
+				if err := stream.Send(wrapperspb.String(requestID)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, interceptor))
+	mux.Handle(clientStreamProcedure, connect.NewClientStreamHandler(clientStreamProcedure,
+		func(ctx context.Context, stream *connect.ClientStream[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+			for stream.Receive() {
+			}
+			if err := stream.Err(); err != nil {
+				return nil, err
+			}
+			requestID, _ := ctx.Value(logger.RequestIDKey).(string)
+			return connect.NewResponse(wrapperspb.String(requestID)), nil
+		}, interceptor))
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestLoggingInterceptorServerStream(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	log := zap.New(core)
+	server := newStreamingServer(t, log)
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+serverStreamProcedure,
+		connect.WithInterceptors(NewLoggingInterceptor(log)))
+
+	ctx := context.WithValue(context.Background(), logger.RequestIDKey, testRequestID)
+	stream, err := client.CallServerStream(ctx, connect.NewRequest(wrapperspb.String("ping")))
+	if err != nil {
+		t.Fatalf("CallServerStream: %v", err)
+	}
+	var received []string
+	for stream.Receive() {
+		received = append(received, stream.Msg().GetValue())
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatalf("stream: %v", err)
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if len(received) != 2 || received[0] != testRequestID {
+		t.Errorf("received = %v, want 2 messages of %q", received, testRequestID)
+	}
+	checkStreamLogs(t, logs, serverStreamProcedure, map[bool]streamCounts{
+		false: {received: 1, sent: 2},
+		true:  {received: 2, sent: 1},
+	})
+}
+
+func TestLoggingInterceptorClientStream(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	log := zap.New(core)
+	server := newStreamingServer(t, log)
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+clientStreamProcedure,
+		connect.WithInterceptors(NewLoggingInterceptor(log)))
+
+	ctx := context.WithValue(context.Background(), logger.RequestIDKey, testRequestID)
+	stream := client.CallClientStream(ctx)
+	for range 3 {
+		if err := stream.Send(wrapperspb.String("ping")); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+	}
+	res, err := stream.CloseAndReceive()
+	if err != nil {
+		t.Fatalf("CloseAndReceive: %v", err)
+	}
+
+	if res.Msg.GetValue() != testRequestID {
+		t.Errorf("request id = %q, want %q", res.Msg.GetValue(), testRequestID)
+	}
+	checkStreamLogs(t, logs, clientStreamProcedure, map[bool]streamCounts{
+		false: {received: 3, sent: 1},
+		true:  {received: 1, sent: 3},
+	})
+}
+
+// リクエスト ID のないリクエストには、ハンドラー側で新しいリクエスト ID を採番する
+func TestLoggingInterceptorGeneratesRequestID(t *testing.T) {
+	core, _ := observer.New(zapcore.InfoLevel)
+	server := newStreamingServer(t, zap.New(core))
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+serverStreamProcedure)
+
+	stream, err := client.CallServerStream(context.Background(), connect.NewRequest(wrapperspb.String("ping")))
+	if err != nil {
+		t.Fatalf("CallServerStream: %v", err)
+	}
+	defer stream.Close()
+	if !stream.Receive() {
+		t.Fatalf("Receive: %v", stream.Err())
+	}
+	if got := stream.Msg().GetValue(); got == "" || got == testRequestID {
+		t.Errorf("request id = %q, want a generated id", got)
+	}
+}
+
+// streamCounts はストリームの終了時に記録されるメッセージ数です。
+type streamCounts struct {
+	received int64
+	sent     int64
+}
+
+// checkStreamLogs はクライアント側とハンドラー側の両方で、ストリームの開始と終了が
+// testRequestID 付きで記録されたことを確認します。want はクライアント側かどうかごとのメッセージ数です。
+func checkStreamLogs(t *testing.T, logs *observer.ObservedLogs, procedure string, want map[bool]streamCounts) {
+	t.Helper()
+	for client, counts := range want {
+		var started, completed bool
+		for _, entry := range logs.All() {
+			fields := entry.ContextMap()
+			if fields["method"] != procedure || fields["client"] != client {
+				continue
+			}
+			if fields["request_id"] != testRequestID {
+				t.Errorf("%q (client=%v) request_id = %v, want %q", entry.Message, client, fields["request_id"], testRequestID)
+			}
+			switch entry.Message {
+			case "stream started":
+				started = true
+			case "stream completed":
+				completed = true
+				if fields["received"] != counts.received || fields["sent"] != counts.sent {
+					t.Errorf("stream completed (client=%v) received=%v sent=%v, want %d/%d",
+						client, fields["received"], fields["sent"], counts.received, counts.sent)
+				}
+			}
+		}
+		if !started || !completed {
+			t.Errorf("client=%v: started=%v completed=%v, want both logged", client, started, completed)
+		}
+	}
+}
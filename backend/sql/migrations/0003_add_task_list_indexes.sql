@@ -0,0 +1,22 @@
+-- +goose Up
+-- 一覧のキーセットページネーション用に並び替えキーを生成列として持たせる
+ALTER TABLE tasks
+    ADD COLUMN priority_rank TINYINT AS (CASE priority WHEN 'high' THEN 3 WHEN 'medium' THEN 2 WHEN 'low' THEN 1 ELSE 0 END) STORED NOT NULL,
+    ADD COLUMN due_date_sort DATE AS (COALESCE(due_date, '9999-12-31')) STORED NOT NULL;
+
+CREATE INDEX idx_tasks_user_created_at ON tasks (user_id, created_at, id);
+CREATE INDEX idx_tasks_user_updated_at ON tasks (user_id, updated_at, id);
+CREATE INDEX idx_tasks_user_due_date ON tasks (user_id, due_date_sort, id);
+CREATE INDEX idx_tasks_user_priority ON tasks (user_id, priority_rank, id);
+
+-- +goose Down
+-- 外部キー用のインデックスが複合インデックスに置き換えられているため先に作り直す
+CREATE INDEX idx_tasks_user_id ON tasks (user_id);
+DROP INDEX idx_tasks_user_priority ON tasks;
+DROP INDEX idx_tasks_user_due_date ON tasks;
+DROP INDEX idx_tasks_user_updated_at ON tasks;
+DROP INDEX idx_tasks_user_created_at ON tasks;
+
+ALTER TABLE tasks
+    DROP COLUMN due_date_sort,
+    DROP COLUMN priority_rank;
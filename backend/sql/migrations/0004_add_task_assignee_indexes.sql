@@ -0,0 +1,14 @@
+-- +goose Up
+-- 担当者として割り当てられたタスクの一覧用インデックス
+CREATE INDEX idx_tasks_assignee_created_at ON tasks (assignee_id, created_at, id);
+CREATE INDEX idx_tasks_assignee_updated_at ON tasks (assignee_id, updated_at, id);
+CREATE INDEX idx_tasks_assignee_due_date ON tasks (assignee_id, due_date_sort, id);
+CREATE INDEX idx_tasks_assignee_priority ON tasks (assignee_id, priority_rank, id);
+
+-- +goose Down
+-- 外部キー用のインデックスが複合インデックスに置き換えられているため先に作り直す
+CREATE INDEX idx_tasks_assignee_id ON tasks (assignee_id);
+DROP INDEX idx_tasks_assignee_priority ON tasks;
+DROP INDEX idx_tasks_assignee_due_date ON tasks;
+DROP INDEX idx_tasks_assignee_updated_at ON tasks;
+DROP INDEX idx_tasks_assignee_created_at ON tasks;
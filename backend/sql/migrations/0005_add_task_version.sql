@@ -0,0 +1,6 @@
+-- +goose Up
+-- 楽観的排他制御用のバージョン (更新のたびに +1)
+ALTER TABLE tasks ADD COLUMN version BIGINT NOT NULL DEFAULT 1 AFTER updated_at;
+
+-- +goose Down
+ALTER TABLE tasks DROP COLUMN version;
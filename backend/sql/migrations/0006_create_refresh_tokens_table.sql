@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    family_id VARCHAR(36) NOT NULL, -- ログインごとに発行し、ローテーション後も引き継ぐ
+    token_hash CHAR(64) NOT NULL UNIQUE, -- トークン本体の SHA-256 (16 進数)
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL, -- ローテーション済みの場合に設定
+    revoked_at TIMESTAMP NULL, -- ログアウトまたは再利用の検知で失効した場合に設定
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_refresh_tokens_family_id (family_id)
+);
+
+-- +goose Down
+DROP TABLE refresh_tokens;
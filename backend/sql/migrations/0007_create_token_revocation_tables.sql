@@ -0,0 +1,22 @@
+-- +goose Up
+-- 失効させたアクセストークン (jti)。expires_at を過ぎた行は削除してよい
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_revoked_tokens_expires_at (expires_at)
+);
+
+-- ユーザーごとの失効基準時刻。これより前に発行されたアクセストークンはすべて無効
+CREATE TABLE IF NOT EXISTS user_token_revocations (
+    user_id VARCHAR(36) PRIMARY KEY,
+    revoked_before TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE user_token_revocations;
+DROP TABLE revoked_tokens;
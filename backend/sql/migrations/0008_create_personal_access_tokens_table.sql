@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    token_prefix VARCHAR(16) NOT NULL, -- 一覧で識別するためのトークンの先頭部分
+    token_hash CHAR(64) NOT NULL UNIQUE, -- トークン本体の SHA-256 (16 進数)
+    scopes VARCHAR(255) NOT NULL, -- スペース区切り (例: "tasks:read tasks:write")
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_personal_access_tokens_user_created_at (user_id, created_at)
+);
+
+-- +goose Down
+DROP TABLE personal_access_tokens;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE, -- トークン本体の SHA-256 (16 進数)
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL, -- 使用済み (または新しいトークンの発行・パスワードの再設定で無効化) の場合に設定
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE password_reset_tokens;
@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMP NULL AFTER updated_at;
+
+-- 既存のユーザーはログインできなくならないよう確認済みとして扱う
+UPDATE users SET email_verified_at = created_at;
+
+CREATE TABLE IF NOT EXISTS email_verification_tokens (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    email VARCHAR(255) NOT NULL, -- 確認するメールアドレス (発行後に変更された場合は使用できない)
+    token_hash CHAR(64) NOT NULL UNIQUE, -- トークン本体の SHA-256 (16 進数)
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL, -- 使用済み (または新しいトークンの発行で無効化) の場合に設定
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE email_verification_tokens;
+ALTER TABLE users DROP COLUMN email_verified_at;
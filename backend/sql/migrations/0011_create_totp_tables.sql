@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS totp_credentials (
+    user_id VARCHAR(36) PRIMARY KEY,
+    secret_ciphertext VARBINARY(255) NOT NULL, -- AES-256-GCM で暗号化したシークレット (先頭に nonce を付加)
+    confirmed_at TIMESTAMP NULL, -- 最初のコードで確認するまでは NULL (ログインでは使用しない)
+    last_used_step BIGINT NOT NULL DEFAULT 0, -- 最後に使用したコードのタイムステップ (同じコードの再使用を防ぐ)
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS totp_recovery_codes (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    code_hash CHAR(64) NOT NULL, -- リカバリーコードの SHA-256 (16 進数)
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE KEY uq_totp_recovery_codes_user_code (user_id, code_hash)
+);
+
+-- +goose Down
+DROP TABLE totp_recovery_codes;
+DROP TABLE totp_credentials;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS login_throttles (
+    throttle_key VARCHAR(320) PRIMARY KEY, -- "account:<メールアドレス>" または "ip:<クライアント IP>"
+    failures INT NOT NULL, -- 続けて失敗した回数
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP NULL, -- この時刻まではパスワードを確認せずに拒否する
+    INDEX idx_login_throttles_last_failure_at (last_failure_at)
+);
+
+CREATE TABLE IF NOT EXISTS login_events (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    result VARCHAR(32) NOT NULL, -- "success", "invalid_password", "invalid_second_factor" または "locked_out"
+    ip_address VARCHAR(45) NOT NULL,
+    user_agent VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_login_events_user_created_at (user_id, created_at)
+);
+
+-- +goose Down
+DROP TABLE login_events;
+DROP TABLE login_throttles;
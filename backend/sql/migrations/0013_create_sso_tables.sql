@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS user_identities (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    issuer VARCHAR(255) NOT NULL, -- ID プロバイダーの issuer
+    subject VARCHAR(255) NOT NULL, -- ID プロバイダーでのユーザーの識別子 (sub)
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE KEY uq_user_identities_issuer_subject (issuer, subject)
+);
+
+CREATE TABLE IF NOT EXISTS oidc_auth_requests (
+    state_hash CHAR(64) PRIMARY KEY, -- state の SHA-256 (16 進数)
+    nonce VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL, -- PKCE のコード検証子 (認可コードの交換時に送信する)
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_oidc_auth_requests_expires_at (expires_at)
+);
+
+-- +goose Down
+DROP TABLE oidc_auth_requests;
+DROP TABLE user_identities;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- 既存のユーザーはすべて member とする (管理者は UPDATE で個別に設定する)
+ALTER TABLE users ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'member' AFTER email_verified_at;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN role;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN disabled_at TIMESTAMP NULL AFTER role;
+
+-- 管理者向けのユーザー一覧のキーセットページネーション用
+CREATE INDEX idx_users_created_at ON users (created_at, id);
+
+-- +goose Down
+DROP INDEX idx_users_created_at ON users;
+ALTER TABLE users DROP COLUMN disabled_at;
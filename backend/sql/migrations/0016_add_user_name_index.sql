@@ -0,0 +1,6 @@
+-- +goose Up
+-- ユーザーの検索 (名前の前方一致) 用。メールアドレスは UNIQUE 制約のインデックスを使う
+CREATE INDEX idx_users_name ON users (name, id);
+
+-- +goose Down
+DROP INDEX idx_users_name ON users;
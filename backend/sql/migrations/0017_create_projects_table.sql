@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS projects (
+    id VARCHAR(36) PRIMARY KEY,
+    owner_id VARCHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    archived_at TIMESTAMP NULL, -- アーカイブした日時 (アーカイブしていない場合は NULL)
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_projects_owner_created_at (owner_id, created_at, id)
+);
+
+-- プロジェクトの削除時のタスクの扱いはアプリケーションで決める (外部キーは取りこぼし防止のため NULL にする)
+ALTER TABLE tasks
+    ADD COLUMN project_id VARCHAR(36) NULL AFTER assignee_id,
+    ADD CONSTRAINT fk_tasks_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE tasks DROP FOREIGN KEY fk_tasks_project;
+ALTER TABLE tasks DROP COLUMN project_id;
+DROP TABLE projects;
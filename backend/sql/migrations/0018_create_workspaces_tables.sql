@@ -0,0 +1,56 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS workspaces (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS workspace_members (
+    workspace_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    role VARCHAR(16) NOT NULL, -- 'owner', 'admin', 'member' または 'viewer'
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (workspace_id, user_id),
+    FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_workspace_members_user_id (user_id)
+);
+
+CREATE TABLE IF NOT EXISTS workspace_invitations (
+    id VARCHAR(36) PRIMARY KEY,
+    workspace_id VARCHAR(36) NOT NULL,
+    email VARCHAR(255) NOT NULL, -- 招待したメールアドレス (このメールアドレスのユーザーのみが承諾・辞退できる)
+    role VARCHAR(16) NOT NULL, -- 承諾したときの役割 ('owner' 以外)
+    invited_by VARCHAR(36) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending', -- 'pending', 'accepted', 'declined' または 'revoked'
+    expires_at TIMESTAMP NOT NULL,
+    responded_at TIMESTAMP NULL, -- 承諾・辞退・取り消しの日時
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_workspace_invitations_workspace_id (workspace_id, status),
+    INDEX idx_workspace_invitations_email (email, status)
+);
+
+-- 既存のタスクはワークスペースに属さない個人のタスクとして残す (workspace_id は NULL)
+ALTER TABLE tasks
+    ADD COLUMN workspace_id VARCHAR(36) NULL AFTER user_id,
+    ADD CONSTRAINT fk_tasks_workspace FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    ADD INDEX idx_tasks_workspace_created_at (workspace_id, created_at, id),
+    ADD INDEX idx_tasks_workspace_updated_at (workspace_id, updated_at, id),
+    ADD INDEX idx_tasks_workspace_due_date (workspace_id, due_date_sort, id),
+    ADD INDEX idx_tasks_workspace_priority (workspace_id, priority_rank, id);
+
+-- +goose Down
+ALTER TABLE tasks
+    DROP FOREIGN KEY fk_tasks_workspace,
+    DROP INDEX idx_tasks_workspace_created_at,
+    DROP INDEX idx_tasks_workspace_updated_at,
+    DROP INDEX idx_tasks_workspace_due_date,
+    DROP INDEX idx_tasks_workspace_priority;
+ALTER TABLE tasks DROP COLUMN workspace_id;
+DROP TABLE workspace_invitations;
+DROP TABLE workspace_members;
+DROP TABLE workspaces;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- is_completed の真偽値を、既定のワークフローの状態 (todo / done) に置き換える。
+-- is_completed は互換性のため残し、以後はアプリケーションが status から導出して更新する。
+ALTER TABLE tasks
+    ADD COLUMN status VARCHAR(32) NOT NULL DEFAULT 'todo' AFTER description,
+    ADD INDEX idx_tasks_project_status (project_id, status);
+
+UPDATE tasks SET status = IF(is_completed, 'done', 'todo');
+
+-- プロジェクトごとのワークフロー (NULL の場合は既定のワークフロー)
+ALTER TABLE projects ADD COLUMN workflow JSON NULL AFTER archived_at;
+
+-- +goose Down
+ALTER TABLE projects DROP COLUMN workflow;
+
+-- 完了として扱う状態かどうかは is_completed に反映済みのため、status を削除するだけで戻せる
+ALTER TABLE tasks
+    DROP INDEX idx_tasks_project_status,
+    DROP COLUMN status;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- ボードの列の中の並び順のキー (辞書順で比較するため ascii_bin にする)
+ALTER TABLE tasks
+    ADD COLUMN board_rank VARCHAR(32) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '' AFTER project_id,
+    DROP INDEX idx_tasks_project_status,
+    ADD INDEX idx_tasks_project_status (project_id, status, board_rank, id);
+
+-- 既存のタスクは作成日時の古い順に並べる。キーは 36 進数 8 桁の "9" (範囲の 1/4) から 36^4 ずつ増やし、
+-- 末尾の "0" を取り除く (model.SpreadRanks と同じ形式)。updated_at は変更しない
+UPDATE tasks t
+JOIN (SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS n FROM tasks) r ON t.id = r.id
+SET t.board_rank = TRIM(TRAILING '0' FROM LPAD(LOWER(CONV(705277476864 + r.n * 1679616, 10, 36)), 8, '0')),
+    t.updated_at = t.updated_at;
+
+-- +goose Down
+ALTER TABLE tasks
+    DROP INDEX idx_tasks_project_status,
+    ADD INDEX idx_tasks_project_status (project_id, status),
+    DROP COLUMN board_rank;
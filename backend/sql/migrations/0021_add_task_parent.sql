@@ -0,0 +1,12 @@
+-- +goose Up
+-- サブタスクの親。親を削除するとサブタスクも削除される (DeleteTask では付け替えるか削除するかをアプリケーションで選ぶ)
+ALTER TABLE tasks
+    ADD COLUMN parent_id VARCHAR(36) NULL AFTER project_id,
+    ADD CONSTRAINT fk_tasks_parent FOREIGN KEY (parent_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    ADD INDEX idx_tasks_parent_created_at (parent_id, created_at, id);
+
+-- +goose Down
+ALTER TABLE tasks
+    DROP FOREIGN KEY fk_tasks_parent,
+    DROP INDEX idx_tasks_parent_created_at,
+    DROP COLUMN parent_id;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- ボードの並び順 (TASK_SORT_KEY_RANK) の一覧も、範囲 (作成者・担当者・ワークスペース) ごとにインデックスの順に読めるようにする
+CREATE INDEX idx_tasks_user_rank ON tasks (user_id, board_rank, id);
+CREATE INDEX idx_tasks_assignee_rank ON tasks (assignee_id, board_rank, id);
+CREATE INDEX idx_tasks_workspace_rank ON tasks (workspace_id, board_rank, id);
+
+-- +goose Down
+DROP INDEX idx_tasks_workspace_rank ON tasks;
+DROP INDEX idx_tasks_assignee_rank ON tasks;
+DROP INDEX idx_tasks_user_rank ON tasks;
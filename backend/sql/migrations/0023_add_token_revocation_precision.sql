@@ -0,0 +1,7 @@
+-- +goose Up
+-- 失効基準時刻をマイクロ秒まで保持する (アクセストークンの発行日時 iat_us と比較するため。
+-- 秒単位では、失効させた秒の中で失効の前後どちらに発行されたトークンかを区別できない)
+ALTER TABLE user_token_revocations MODIFY revoked_before TIMESTAMP(6) NOT NULL;
+
+-- +goose Down
+ALTER TABLE user_token_revocations MODIFY revoked_before TIMESTAMP NOT NULL;
@@ -0,0 +1,14 @@
+-- sql/queries/email_verification_tokens.sql
+
+-- name: CreateEmailVerificationToken :exec
+INSERT INTO email_verification_tokens (id, user_id, email, token_hash, expires_at) VALUES (?, ?, ?, ?, ?);
+
+-- name: GetEmailVerificationTokenByHash :one
+SELECT * FROM email_verification_tokens WHERE token_hash = ? LIMIT 1;
+
+-- name: MarkEmailVerificationTokenUsed :execrows
+-- 未使用の場合のみ使用済みにする (0 行の場合は同時に使用された)
+UPDATE email_verification_tokens SET used_at = CURRENT_TIMESTAMP WHERE id = ? AND used_at IS NULL;
+
+-- name: InvalidateUserEmailVerificationTokens :exec
+UPDATE email_verification_tokens SET used_at = CURRENT_TIMESTAMP WHERE user_id = ? AND used_at IS NULL;
@@ -0,0 +1,7 @@
+-- sql/queries/login_events.sql
+
+-- name: CreateLoginEvent :exec
+INSERT INTO login_events (id, user_id, result, ip_address, user_agent) VALUES (?, ?, ?, ?, ?);
+
+-- name: ListLoginEventsByUser :many
+SELECT * FROM login_events WHERE user_id = ? ORDER BY created_at DESC, id DESC LIMIT ?;
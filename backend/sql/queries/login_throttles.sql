@@ -0,0 +1,26 @@
+-- sql/queries/login_throttles.sql
+
+-- name: RecordLoginFailure :execresult
+-- 前回の失敗 (ロック中の場合はロックの解除) から一定時間が経過している場合は 1 からやり直す
+-- 加算後の回数を LAST_INSERT_ID(expr) で設定し、同じ文の結果 (LastInsertId) として返す (他の接続の加算が混ざらない)
+INSERT INTO login_throttles (throttle_key, failures, last_failure_at) VALUES (sqlc.arg(throttle_key), LAST_INSERT_ID(1), sqlc.arg(failed_at))
+ON DUPLICATE KEY UPDATE
+    failures = LAST_INSERT_ID(IF(GREATEST(last_failure_at, COALESCE(locked_until, last_failure_at)) < sqlc.arg(window_start), 1, failures + 1)),
+    last_failure_at = sqlc.arg(failed_at);
+
+-- name: GetLoginThrottle :one
+SELECT * FROM login_throttles WHERE throttle_key = ? LIMIT 1;
+
+-- name: LockLogin :execrows
+-- ロックされていない場合のみロックする (影響を受けた行数が 0 の場合は他の試行がロック済み)
+UPDATE login_throttles SET locked_until = sqlc.arg(locked_until)
+WHERE throttle_key = sqlc.arg(throttle_key) AND (locked_until IS NULL OR locked_until <= sqlc.arg(now));
+
+-- name: CancelLoginFailure :exec
+UPDATE login_throttles SET failures = GREATEST(failures - 1, 0) WHERE throttle_key = ?;
+
+-- name: UnlockLogin :exec
+UPDATE login_throttles SET locked_until = NULL WHERE throttle_key = ?;
+
+-- name: DeleteLoginThrottle :exec
+DELETE FROM login_throttles WHERE throttle_key = ?;
@@ -0,0 +1,14 @@
+-- sql/queries/oidc_auth_requests.sql
+
+-- name: CreateOIDCAuthRequest :exec
+INSERT INTO oidc_auth_requests (state_hash, nonce, code_verifier, expires_at) VALUES (?, ?, ?, ?);
+
+-- name: GetOIDCAuthRequest :one
+SELECT * FROM oidc_auth_requests WHERE state_hash = ? LIMIT 1;
+
+-- name: DeleteOIDCAuthRequest :execrows
+-- 0 行の場合は同じ state が同時に使用された
+DELETE FROM oidc_auth_requests WHERE state_hash = ?;
+
+-- name: DeleteExpiredOIDCAuthRequests :exec
+DELETE FROM oidc_auth_requests WHERE expires_at < ?;
@@ -0,0 +1,14 @@
+-- sql/queries/password_reset_tokens.sql
+
+-- name: CreatePasswordResetToken :exec
+INSERT INTO password_reset_tokens (id, user_id, token_hash, expires_at) VALUES (?, ?, ?, ?);
+
+-- name: GetPasswordResetTokenByHash :one
+SELECT * FROM password_reset_tokens WHERE token_hash = ? LIMIT 1;
+
+-- name: MarkPasswordResetTokenUsed :execrows
+-- 未使用の場合のみ使用済みにする (0 行の場合は同時に使用された)
+UPDATE password_reset_tokens SET used_at = CURRENT_TIMESTAMP WHERE id = ? AND used_at IS NULL;
+
+-- name: InvalidateUserPasswordResetTokens :exec
+UPDATE password_reset_tokens SET used_at = CURRENT_TIMESTAMP WHERE user_id = ? AND used_at IS NULL;
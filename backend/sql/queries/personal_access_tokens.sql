@@ -0,0 +1,18 @@
+-- sql/queries/personal_access_tokens.sql
+
+-- name: CreatePersonalAccessToken :exec
+INSERT INTO personal_access_tokens (id, user_id, name, token_prefix, token_hash, scopes, expires_at) VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetPersonalAccessTokenByHash :one
+SELECT * FROM personal_access_tokens WHERE token_hash = ? LIMIT 1;
+
+-- name: ListPersonalAccessTokensByUser :many
+-- 失効済みのトークンは含めない (期限切れのトークンは含める)
+SELECT * FROM personal_access_tokens WHERE user_id = ? AND revoked_at IS NULL ORDER BY created_at DESC, id DESC;
+
+-- name: RevokePersonalAccessToken :execrows
+UPDATE personal_access_tokens SET revoked_at = CURRENT_TIMESTAMP WHERE id = ? AND user_id = ? AND revoked_at IS NULL;
+
+
+-- name: RevokeUserPersonalAccessTokens :exec
+UPDATE personal_access_tokens SET revoked_at = CURRENT_TIMESTAMP WHERE user_id = ? AND revoked_at IS NULL;
\ No newline at end of file
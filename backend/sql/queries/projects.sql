@@ -0,0 +1,37 @@
+-- sql/queries/projects.sql
+
+-- name: CreateProject :exec
+INSERT INTO projects (id, owner_id, name, description) VALUES (?, ?, ?, ?);
+
+-- name: GetProjectByID :one
+SELECT * FROM projects WHERE id = ? LIMIT 1;
+
+-- name: ListProjectsByOwner :many
+-- 作成日時の新しい順にキーセット方式で 1 ページ分のプロジェクトを返す (最初のページは cursor_created_at に NULL を渡す)。
+-- include_archived が偽の場合はアーカイブしたプロジェクトを含めない
+SELECT * FROM projects
+WHERE owner_id = sqlc.arg(owner_id)
+  AND (archived_at IS NULL OR sqlc.arg(include_archived) = TRUE)
+  AND (sqlc.narg(cursor_created_at) IS NULL
+    OR created_at < sqlc.narg(cursor_created_at)
+    OR (created_at = sqlc.narg(cursor_created_at) AND id < sqlc.arg(cursor_id)))
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
+
+-- name: UpdateProject :exec
+UPDATE projects SET name = ?, description = ?, archived_at = ? WHERE id = ?;
+
+-- name: UpdateProjectWorkflow :exec
+-- workflow に NULL を渡すと既定のワークフローに戻す
+UPDATE projects SET workflow = ? WHERE id = ?;
+
+-- name: DeleteProject :exec
+DELETE FROM projects WHERE id = ?;
+
+-- name: ListProjectIDsOwnedBy :many
+-- ユーザーの削除時に、削除するユーザーのプロジェクト (アーカイブしたものを含む) の ID を返す
+SELECT id FROM projects WHERE owner_id = ?;
+
+-- name: ReassignProjectsOwnedBy :exec
+-- ユーザーの削除時に、削除するユーザーのプロジェクトを引き継ぎ先に移す
+UPDATE projects SET owner_id = sqlc.arg(new_owner_id) WHERE owner_id = sqlc.arg(owner_id);
@@ -1,18 +1,454 @@
 -- sql/queries/tasks.sql
 
 -- name: CreateTask :exec
-INSERT INTO tasks (id, title, description, is_completed, user_id, assignee_id, priority, due_date)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+INSERT INTO tasks (id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
--- name: UpdateTask :exec
-UPDATE tasks SET title = ?, description = ?, is_completed = ?, assignee_id = ?, priority = ?, due_date = ?
-WHERE id = ?;
+-- UpdateTask / DeleteTask は読み込んだ時点の version と一致する場合のみ行を変更する (0 行なら競合)
 
--- name: ListTasks :many
-SELECT * FROM tasks WHERE user_id = ? ORDER BY created_at DESC;
+-- name: UpdateTask :execrows
+UPDATE tasks SET title = ?, description = ?, status = ?, is_completed = ?, assignee_id = ?, project_id = ?, parent_id = ?, board_rank = ?, priority = ?, due_date = ?, version = version + 1
+WHERE id = ? AND version = ?;
 
--- name: DeleteTask :exec
-DELETE FROM tasks WHERE id = ?;
+-- ListTasksBy* はキーセット方式で 1 ページ分のタスクを返す。
+-- cursor_* には直前のページの最後のタスクの値を渡す (最初のページは NULL)。
+-- 一覧の範囲 (作成者・担当者・ワークスペース) ごとに、範囲の列から始まるインデックスの順に 1 ページ分を読む副問い合わせを
+-- UNION ALL でまとめ、外側で並べ直して 1 ページ分に絞る (OR で範囲をまとめるとインデックスで並べられず filesort になるため)。
+-- LIMIT の ? (Limit, Limit_2, ...) にはすべて同じページの件数を渡す。
+-- creator_id / assigned_to / in_workspace は一覧の範囲を表し、対象外のものには NULL を渡す (NULL との比較は常に偽になるため、
+-- その副問い合わせは行を返さない)。作成者と担当者の両方を指定した場合、自分が作成して担当しているタスクは作成者の側だけで返す。
+-- ワークスペースのタスクは member_id のユーザーがメンバーであるワークスペースのものだけを返す
+-- (副問い合わせでは workspace_members と区別するため、列名に tasks. を付ける)。
+-- 範囲・メンバーの確認・絞り込みの条件は 5 つのクエリで同じ行にし、カーソルの条件と ORDER BY だけを変える
+-- (internal/adapter/repository/mysql/task_list_test.go で確認する。TEST_DB_DSN を指定すると同じタスクでの結果も確認する)。
+
+-- name: ListTasksByCreatedAt :many
+SELECT * FROM (
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.user_id = sqlc.narg(creator_id)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_created_at) IS NULL
+    OR tasks.created_at < sqlc.narg(cursor_created_at)
+    OR (tasks.created_at = sqlc.narg(cursor_created_at) AND tasks.id < sqlc.arg(cursor_id)))
+ORDER BY tasks.created_at DESC, tasks.id DESC
+LIMIT ?) AS created
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.assignee_id = sqlc.narg(assigned_to) AND (sqlc.narg(creator_id) IS NULL OR tasks.user_id <> sqlc.narg(creator_id))
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_created_at) IS NULL
+    OR tasks.created_at < sqlc.narg(cursor_created_at)
+    OR (tasks.created_at = sqlc.narg(cursor_created_at) AND tasks.id < sqlc.arg(cursor_id)))
+ORDER BY tasks.created_at DESC, tasks.id DESC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.workspace_id = sqlc.narg(in_workspace)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_created_at) IS NULL
+    OR tasks.created_at < sqlc.narg(cursor_created_at)
+    OR (tasks.created_at = sqlc.narg(cursor_created_at) AND tasks.id < sqlc.arg(cursor_id)))
+ORDER BY tasks.created_at DESC, tasks.id DESC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.created_at DESC, scoped.id DESC
+LIMIT ?;
+
+-- name: ListTasksByUpdatedAt :many
+SELECT * FROM (
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.user_id = sqlc.narg(creator_id)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_updated_at) IS NULL
+    OR tasks.updated_at < sqlc.narg(cursor_updated_at)
+    OR (tasks.updated_at = sqlc.narg(cursor_updated_at) AND tasks.id < sqlc.arg(cursor_id)))
+ORDER BY tasks.updated_at DESC, tasks.id DESC
+LIMIT ?) AS created
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.assignee_id = sqlc.narg(assigned_to) AND (sqlc.narg(creator_id) IS NULL OR tasks.user_id <> sqlc.narg(creator_id))
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_updated_at) IS NULL
+    OR tasks.updated_at < sqlc.narg(cursor_updated_at)
+    OR (tasks.updated_at = sqlc.narg(cursor_updated_at) AND tasks.id < sqlc.arg(cursor_id)))
+ORDER BY tasks.updated_at DESC, tasks.id DESC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.workspace_id = sqlc.narg(in_workspace)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_updated_at) IS NULL
+    OR tasks.updated_at < sqlc.narg(cursor_updated_at)
+    OR (tasks.updated_at = sqlc.narg(cursor_updated_at) AND tasks.id < sqlc.arg(cursor_id)))
+ORDER BY tasks.updated_at DESC, tasks.id DESC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.updated_at DESC, scoped.id DESC
+LIMIT ?;
+
+-- name: ListTasksByDueDate :many
+SELECT * FROM (
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.user_id = sqlc.narg(creator_id)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_due_date_sort) IS NULL
+    OR tasks.due_date_sort > sqlc.narg(cursor_due_date_sort)
+    OR (tasks.due_date_sort = sqlc.narg(cursor_due_date_sort) AND tasks.id > sqlc.arg(cursor_id)))
+ORDER BY tasks.due_date_sort ASC, tasks.id ASC
+LIMIT ?) AS created
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.assignee_id = sqlc.narg(assigned_to) AND (sqlc.narg(creator_id) IS NULL OR tasks.user_id <> sqlc.narg(creator_id))
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_due_date_sort) IS NULL
+    OR tasks.due_date_sort > sqlc.narg(cursor_due_date_sort)
+    OR (tasks.due_date_sort = sqlc.narg(cursor_due_date_sort) AND tasks.id > sqlc.arg(cursor_id)))
+ORDER BY tasks.due_date_sort ASC, tasks.id ASC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.workspace_id = sqlc.narg(in_workspace)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_due_date_sort) IS NULL
+    OR tasks.due_date_sort > sqlc.narg(cursor_due_date_sort)
+    OR (tasks.due_date_sort = sqlc.narg(cursor_due_date_sort) AND tasks.id > sqlc.arg(cursor_id)))
+ORDER BY tasks.due_date_sort ASC, tasks.id ASC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.due_date_sort ASC, scoped.id ASC
+LIMIT ?;
+
+-- name: ListTasksByPriority :many
+SELECT * FROM (
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.user_id = sqlc.narg(creator_id)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_priority_rank) IS NULL
+    OR tasks.priority_rank < sqlc.narg(cursor_priority_rank)
+    OR (tasks.priority_rank = sqlc.narg(cursor_priority_rank) AND tasks.id < sqlc.arg(cursor_id)))
+ORDER BY tasks.priority_rank DESC, tasks.id DESC
+LIMIT ?) AS created
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.assignee_id = sqlc.narg(assigned_to) AND (sqlc.narg(creator_id) IS NULL OR tasks.user_id <> sqlc.narg(creator_id))
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_priority_rank) IS NULL
+    OR tasks.priority_rank < sqlc.narg(cursor_priority_rank)
+    OR (tasks.priority_rank = sqlc.narg(cursor_priority_rank) AND tasks.id < sqlc.arg(cursor_id)))
+ORDER BY tasks.priority_rank DESC, tasks.id DESC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.workspace_id = sqlc.narg(in_workspace)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_priority_rank) IS NULL
+    OR tasks.priority_rank < sqlc.narg(cursor_priority_rank)
+    OR (tasks.priority_rank = sqlc.narg(cursor_priority_rank) AND tasks.id < sqlc.arg(cursor_id)))
+ORDER BY tasks.priority_rank DESC, tasks.id DESC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.priority_rank DESC, scoped.id DESC
+LIMIT ?;
+
+-- name: ListTasksByRank :many
+SELECT * FROM (
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.user_id = sqlc.narg(creator_id)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_rank) IS NULL
+    OR tasks.board_rank > sqlc.narg(cursor_rank)
+    OR (tasks.board_rank = sqlc.narg(cursor_rank) AND tasks.id > sqlc.arg(cursor_id)))
+ORDER BY tasks.board_rank ASC, tasks.id ASC
+LIMIT ?) AS created
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.assignee_id = sqlc.narg(assigned_to) AND (sqlc.narg(creator_id) IS NULL OR tasks.user_id <> sqlc.narg(creator_id))
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_rank) IS NULL
+    OR tasks.board_rank > sqlc.narg(cursor_rank)
+    OR (tasks.board_rank = sqlc.narg(cursor_rank) AND tasks.id > sqlc.arg(cursor_id)))
+ORDER BY tasks.board_rank ASC, tasks.id ASC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT * FROM (SELECT * FROM tasks
+WHERE tasks.workspace_id = sqlc.narg(in_workspace)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(member_id)))
+  AND (sqlc.narg(is_completed) IS NULL OR tasks.is_completed = sqlc.narg(is_completed))
+  AND (sqlc.narg(status) IS NULL OR tasks.status = sqlc.narg(status))
+  AND (sqlc.narg(priority) IS NULL OR tasks.priority = sqlc.narg(priority))
+  AND (sqlc.narg(assignee_id) IS NULL OR tasks.assignee_id = sqlc.narg(assignee_id))
+  AND (sqlc.narg(project_id) IS NULL OR tasks.project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(due_from) IS NULL OR tasks.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to) IS NULL OR tasks.due_date < sqlc.narg(due_to))
+  AND (sqlc.narg(cursor_rank) IS NULL
+    OR tasks.board_rank > sqlc.narg(cursor_rank)
+    OR (tasks.board_rank = sqlc.narg(cursor_rank) AND tasks.id > sqlc.arg(cursor_id)))
+ORDER BY tasks.board_rank ASC, tasks.id ASC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.board_rank ASC, scoped.id ASC
+LIMIT ?;
+
+-- name: DeleteTask :execrows
+DELETE FROM tasks WHERE id = ? AND version = ?;
 
 -- name: GetTaskByID :one
-SELECT * FROM tasks WHERE id = ? LIMIT 1;
\ No newline at end of file
+SELECT * FROM tasks WHERE id = ? LIMIT 1;
+
+
+-- 以下はユーザーの削除時に、削除するユーザーを参照しているタスクを整理する (version を進めて編集中のクライアントに競合を伝える)
+
+-- name: ReassignTasksCreatedBy :exec
+-- 個人のタスクと、new_user_id のユーザーがメンバーであるワークスペースのタスクの作成者を new_user_id のユーザーにする
+-- (それ以外のワークスペースのタスクは ReassignWorkspaceTasksCreatedByToOwner で引き継ぐ)
+UPDATE tasks SET user_id = sqlc.arg(new_user_id), version = tasks.version + 1
+WHERE tasks.user_id = sqlc.arg(user_id)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.arg(new_user_id)));
+
+-- name: ReassignTasksAssignedTo :exec
+-- new_assignee_id に NULL を渡すと担当者なしにする。new_assignee_id のユーザーがメンバーでないワークスペースのタスクは変更しない
+UPDATE tasks SET assignee_id = sqlc.narg(new_assignee_id), version = tasks.version + 1
+WHERE tasks.assignee_id = sqlc.arg(assignee_id)
+  AND (sqlc.narg(new_assignee_id) IS NULL OR tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = sqlc.narg(new_assignee_id)));
+
+-- name: ReassignWorkspaceTasksCreatedByToOwner :exec
+-- ワークスペースのタスクの作成者を、そのワークスペースに残る owner のうち最も古くから参加しているユーザーにする
+-- (残る owner がいない場合は user_id が NULL になりエラーにするため、呼び出し前に確認する)
+UPDATE tasks
+SET user_id = (
+        SELECT wm.user_id FROM workspace_members wm
+        WHERE wm.workspace_id = tasks.workspace_id AND wm.role = 'owner' AND wm.user_id <> sqlc.arg(user_id)
+        ORDER BY wm.created_at, wm.user_id
+        LIMIT 1
+    ),
+    version = tasks.version + 1
+WHERE tasks.user_id = sqlc.arg(user_id) AND tasks.workspace_id IS NOT NULL;
+
+-- name: DeleteTasksCreatedBy :exec
+-- ワークスペースに属さない個人のタスクのみ削除する (ワークスペースのタスクは ReassignWorkspaceTasksCreatedByToOwner で引き継ぐ)
+DELETE FROM tasks WHERE user_id = ? AND workspace_id IS NULL;
+
+-- 以下はプロジェクトの削除時に、削除するプロジェクトのタスクを整理する
+
+-- name: MoveTasksToProject :exec
+-- new_project_id に NULL を渡すとプロジェクトから外す
+UPDATE tasks SET project_id = sqlc.narg(new_project_id), version = version + 1 WHERE project_id = sqlc.arg(project_id);
+
+-- name: DeleteTasksInProject :exec
+DELETE FROM tasks WHERE project_id = ?;
+
+-- name: FallbackTaskStatusesInProject :exec
+-- 移動先のワークフローにない状態 (statuses に含まれない状態) のタスクを、完了していたかどうかに応じて
+-- done_status または initial_status にする (is_completed は変わらない)
+UPDATE tasks SET status = IF(is_completed, sqlc.arg(done_status), sqlc.arg(initial_status)), version = version + 1
+WHERE project_id = sqlc.arg(project_id) AND status NOT IN (sqlc.slice(statuses));
+
+-- 以下はプロジェクトのワークフローの変更時に使用する
+
+-- name: CountTasksInProjectOutsideStatuses :one
+-- 新しいワークフローにない状態 (statuses に含まれない状態) のタスクの数
+SELECT COUNT(*) FROM tasks WHERE project_id = sqlc.arg(project_id) AND status NOT IN (sqlc.slice(statuses));
+
+-- name: SyncTaskCompletionInProject :exec
+-- 完了として扱う状態が変わったタスクの is_completed を反転する
+UPDATE tasks SET is_completed = NOT is_completed, version = version + 1
+WHERE project_id = sqlc.arg(project_id) AND is_completed <> (status IN (sqlc.slice(done_statuses)));
+
+-- 以下はボードの列 (状態が同じで、プロジェクト、プロジェクトなしの場合はワークスペースか作成者が同じタスク) の
+-- 並び順を扱う。列に含まない側の project_id / workspace_id / owner_id には NULL を渡す (<=> で NULL 同士も一致させる)。
+-- exclude_id のタスク (移動するタスク) は列に含めない。
+
+-- name: GetLastRankInColumn :one
+SELECT board_rank FROM tasks
+WHERE status = sqlc.arg(status) AND project_id <=> sqlc.narg(project_id) AND workspace_id <=> sqlc.narg(workspace_id)
+  AND (sqlc.narg(owner_id) IS NULL OR user_id = sqlc.narg(owner_id))
+  AND id <> sqlc.arg(exclude_id)
+ORDER BY board_rank DESC, id DESC
+LIMIT 1;
+
+-- name: GetRankBeforeInColumn :one
+-- (board_rank, id) の位置より前にある、最も近いタスクのキー
+SELECT board_rank FROM tasks
+WHERE status = sqlc.arg(status) AND project_id <=> sqlc.narg(project_id) AND workspace_id <=> sqlc.narg(workspace_id)
+  AND (sqlc.narg(owner_id) IS NULL OR user_id = sqlc.narg(owner_id))
+  AND id <> sqlc.arg(exclude_id)
+  AND (board_rank < sqlc.arg(anchor_rank) OR (board_rank = sqlc.arg(anchor_rank) AND id < sqlc.arg(anchor_id)))
+ORDER BY board_rank DESC, id DESC
+LIMIT 1;
+
+-- name: GetRankAfterInColumn :one
+-- (board_rank, id) の位置より後ろにある、最も近いタスクのキー
+SELECT board_rank FROM tasks
+WHERE status = sqlc.arg(status) AND project_id <=> sqlc.narg(project_id) AND workspace_id <=> sqlc.narg(workspace_id)
+  AND (sqlc.narg(owner_id) IS NULL OR user_id = sqlc.narg(owner_id))
+  AND id <> sqlc.arg(exclude_id)
+  AND (board_rank > sqlc.arg(anchor_rank) OR (board_rank = sqlc.arg(anchor_rank) AND id > sqlc.arg(anchor_id)))
+ORDER BY board_rank ASC, id ASC
+LIMIT 1;
+
+-- name: ListTaskIDsInColumn :many
+-- キーを振り直すため、列のタスクを並び順にロックして返す
+SELECT id FROM tasks
+WHERE status = sqlc.arg(status) AND project_id <=> sqlc.narg(project_id) AND workspace_id <=> sqlc.narg(workspace_id)
+  AND (sqlc.narg(owner_id) IS NULL OR user_id = sqlc.narg(owner_id))
+  AND id <> sqlc.arg(exclude_id)
+ORDER BY board_rank ASC, id ASC
+FOR UPDATE;
+
+-- name: UpdateTaskRank :exec
+UPDATE tasks SET board_rank = ?, version = version + 1 WHERE id = ?;
+
+-- 以下はサブタスク (parent_id による階層) を扱う。階層は MaxTaskDepth 段までのため再帰の回数は限られる
+
+-- name: ListSubtasks :many
+SELECT * FROM tasks WHERE parent_id = ? ORDER BY created_at ASC, id ASC;
+
+-- name: ListTaskDescendants :many
+-- root_id のタスクの子孫すべて (root_id のタスク自身は含まない)
+WITH RECURSIVE descendants AS (
+  SELECT * FROM tasks WHERE tasks.parent_id = sqlc.arg(root_id)
+  UNION ALL
+  SELECT t.* FROM tasks t JOIN descendants d ON t.parent_id = d.id
+)
+SELECT * FROM descendants ORDER BY created_at ASC, id ASC;
+
+-- name: CountSubtaskProgress :many
+-- ids のタスクごとの、子孫の数と完了している子孫の数 (子孫がないタスクは含まない)
+WITH RECURSIVE descendants AS (
+  SELECT tasks.parent_id AS root_id, tasks.id, tasks.is_completed FROM tasks WHERE tasks.parent_id IN (sqlc.slice(ids))
+  UNION ALL
+  SELECT d.root_id, t.id, t.is_completed FROM tasks t JOIN descendants d ON t.parent_id = d.id
+)
+SELECT root_id, CAST(COUNT(*) AS SIGNED) AS total, CAST(COALESCE(SUM(is_completed), 0) AS SIGNED) AS completed
+FROM descendants GROUP BY root_id;
+
+-- name: GetTaskByIDForUpdate :one
+-- 親の変更やサブタスクのあるタスクの削除の間、タスクの階層が変わらないよう行をロックする
+SELECT * FROM tasks WHERE id = ? LIMIT 1 FOR UPDATE;
+
+-- name: ListSubtasksForUpdate :many
+-- parent_ids のタスクの子をロックして返す (parent_id のインデックスもロックするため、子の追加も待たせる)
+SELECT * FROM tasks WHERE parent_id IN (sqlc.slice(parent_ids)) ORDER BY created_at ASC, id ASC FOR UPDATE;
+
+-- name: ReparentSubtasks :exec
+-- new_parent_id に NULL を渡すと親なしにする
+UPDATE tasks SET parent_id = sqlc.narg(new_parent_id), version = version + 1 WHERE parent_id = sqlc.arg(parent_id);
+
+-- name: UnassignWorkspaceTasks :exec
+-- ワークスペースから外れたメンバーを、そのワークスペースのタスクの担当者から外す
+UPDATE tasks SET assignee_id = NULL, version = version + 1 WHERE workspace_id = ? AND assignee_id = ?;
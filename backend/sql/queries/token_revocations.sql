@@ -0,0 +1,17 @@
+-- sql/queries/token_revocations.sql
+
+-- name: RevokeToken :exec
+INSERT IGNORE INTO revoked_tokens (jti, user_id, expires_at) VALUES (?, ?, ?);
+
+-- name: IsTokenRevoked :one
+SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE jti = ?) AS revoked;
+
+-- name: DeleteExpiredRevokedTokens :exec
+DELETE FROM revoked_tokens WHERE expires_at < ?;
+
+-- name: SetUserTokensRevokedBefore :exec
+INSERT INTO user_token_revocations (user_id, revoked_before) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE revoked_before = VALUES(revoked_before);
+
+-- name: GetUserTokensRevokedBefore :one
+SELECT revoked_before FROM user_token_revocations WHERE user_id = ? LIMIT 1;
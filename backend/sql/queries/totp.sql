@@ -0,0 +1,30 @@
+-- sql/queries/totp.sql
+
+-- name: SaveTOTPCredential :exec
+-- 未確認の登録がある場合は新しいシークレットで置き換える
+INSERT INTO totp_credentials (user_id, secret_ciphertext) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE secret_ciphertext = VALUES(secret_ciphertext), confirmed_at = NULL, last_used_step = 0, created_at = CURRENT_TIMESTAMP;
+
+-- name: GetTOTPCredential :one
+SELECT * FROM totp_credentials WHERE user_id = ? LIMIT 1;
+
+-- name: ConfirmTOTPCredential :execrows
+-- 未確認の場合のみ確認済みにする (0 行の場合は同時に確認された)
+UPDATE totp_credentials SET confirmed_at = CURRENT_TIMESTAMP, last_used_step = ? WHERE user_id = ? AND confirmed_at IS NULL;
+
+-- name: UseTOTPStep :execrows
+-- 最後に使用したコードより新しい場合のみ更新する (0 行の場合は同じコードが再使用された)
+UPDATE totp_credentials SET last_used_step = sqlc.arg(step) WHERE user_id = sqlc.arg(user_id) AND last_used_step < sqlc.arg(step);
+
+-- name: DeleteTOTPCredential :exec
+DELETE FROM totp_credentials WHERE user_id = ?;
+
+-- name: CreateTOTPRecoveryCode :exec
+INSERT INTO totp_recovery_codes (id, user_id, code_hash) VALUES (?, ?, ?);
+
+-- name: UseTOTPRecoveryCode :execrows
+-- 未使用の場合のみ使用済みにする (0 行の場合は存在しないか使用済み)
+UPDATE totp_recovery_codes SET used_at = CURRENT_TIMESTAMP WHERE user_id = ? AND code_hash = ? AND used_at IS NULL;
+
+-- name: DeleteUserTOTPRecoveryCodes :exec
+DELETE FROM totp_recovery_codes WHERE user_id = ?;
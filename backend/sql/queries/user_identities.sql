@@ -0,0 +1,7 @@
+-- sql/queries/user_identities.sql
+
+-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (id, user_id, issuer, subject) VALUES (?, ?, ?, ?);
+
+-- name: GetUserIdentity :one
+SELECT * FROM user_identities WHERE issuer = ? AND subject = ? LIMIT 1;
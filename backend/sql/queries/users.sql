@@ -1,7 +1,7 @@
 -- sql/queries/users.sql
 
 -- name: CreateUser :exec
-INSERT INTO users (id, name, email, password) VALUES (?, ?, ?, ?);
+INSERT INTO users (id, name, email, password, role) VALUES (?, ?, ?, ?, ?);
 
 -- name: GetUserByEmail :one
 SELECT * FROM users WHERE email = ? LIMIT 1;
@@ -10,4 +10,36 @@ SELECT * FROM users WHERE email = ? LIMIT 1;
 SELECT * FROM users WHERE id = ? LIMIT 1;
 
 -- name: UpdateUser :exec
-UPDATE users SET name = ?, email = ?, password = ? WHERE id = ?;
\ No newline at end of file
+UPDATE users SET name = ?, email = ?, password = ?, email_verified_at = ? WHERE id = ?;
+
+-- name: MarkUserEmailVerified :execrows
+-- 確認したメールアドレスが現在のものと一致する場合のみ確認済みにする
+UPDATE users SET email_verified_at = CURRENT_TIMESTAMP WHERE id = ? AND email = ?;
+
+-- name: ListUsers :many
+-- 作成日時の新しい順にキーセット方式で 1 ページ分のユーザーを返す (最初のページは cursor_created_at に NULL を渡す)。
+-- pattern は名前またはメールアドレスの部分一致 (LIKE のパターン、絞り込まない場合は NULL)。
+SELECT * FROM users
+WHERE (sqlc.narg(pattern) IS NULL OR name LIKE sqlc.narg(pattern) OR email LIKE sqlc.narg(pattern))
+  AND (sqlc.narg(cursor_created_at) IS NULL
+    OR created_at < sqlc.narg(cursor_created_at)
+    OR (created_at = sqlc.narg(cursor_created_at) AND id < sqlc.arg(cursor_id)))
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
+
+-- name: SetUserDisabledAt :exec
+UPDATE users SET disabled_at = ? WHERE id = ?;
+
+-- name: DeleteUser :exec
+DELETE FROM users WHERE id = ?;
+
+-- name: SearchUsers :many
+-- caller_id のユーザーと同じワークスペースのメンバーのうち、名前が prefix で始まるか、メールアドレスが email と一致する
+-- 有効なユーザーを名前順に返す (prefix には末尾に % を付けた LIKE のパターンを渡す)
+SELECT DISTINCT u.* FROM users u
+JOIN workspace_members theirs ON theirs.user_id = u.id
+JOIN workspace_members mine ON mine.workspace_id = theirs.workspace_id AND mine.user_id = sqlc.arg(caller_id)
+WHERE u.disabled_at IS NULL
+  AND (u.name LIKE sqlc.arg(prefix) OR u.email = sqlc.arg(email))
+ORDER BY u.name, u.id
+LIMIT ?;
\ No newline at end of file
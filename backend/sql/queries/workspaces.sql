@@ -0,0 +1,90 @@
+-- sql/queries/workspaces.sql
+
+-- name: CreateWorkspace :exec
+INSERT INTO workspaces (id, name) VALUES (?, ?);
+
+-- name: GetWorkspaceByID :one
+SELECT * FROM workspaces WHERE id = ? LIMIT 1;
+
+-- name: UpdateWorkspace :exec
+UPDATE workspaces SET name = ? WHERE id = ?;
+
+-- name: DeleteWorkspace :exec
+-- メンバー・招待・タスクはワークスペースの削除に連動して削除される (ON DELETE CASCADE)
+DELETE FROM workspaces WHERE id = ?;
+
+-- name: ListWorkspacesByMember :many
+-- ユーザーがメンバーであるワークスペースを、ユーザーの役割とともに名前順に返す
+SELECT w.id, w.name, w.created_at, w.updated_at, wm.role
+FROM workspaces w
+JOIN workspace_members wm ON wm.workspace_id = w.id
+WHERE wm.user_id = ?
+ORDER BY w.name, w.id;
+
+-- name: CreateWorkspaceMember :exec
+INSERT INTO workspace_members (workspace_id, user_id, role) VALUES (?, ?, ?);
+
+-- name: GetWorkspaceMember :one
+SELECT * FROM workspace_members WHERE workspace_id = ? AND user_id = ? LIMIT 1;
+
+-- name: ListWorkspaceMembers :many
+-- メンバーを参加した順に、ユーザーの名前とメールアドレスとともに返す
+SELECT wm.workspace_id, wm.user_id, wm.role, wm.created_at, wm.updated_at, u.name, u.email
+FROM workspace_members wm
+JOIN users u ON u.id = wm.user_id
+WHERE wm.workspace_id = ?
+ORDER BY wm.created_at, wm.user_id;
+
+-- name: UpdateWorkspaceMemberRole :exec
+UPDATE workspace_members SET role = ? WHERE workspace_id = ? AND user_id = ?;
+
+-- name: DeleteWorkspaceMember :exec
+DELETE FROM workspace_members WHERE workspace_id = ? AND user_id = ?;
+
+-- name: CountWorkspaceOwners :one
+SELECT COUNT(*) FROM workspace_members WHERE workspace_id = ? AND role = 'owner';
+
+-- name: ListWorkspacesSolelyOwnedBy :many
+-- ユーザーが唯一の所有者であるワークスペースの ID を返す (ユーザーの削除前の確認に使う)
+SELECT wm.workspace_id FROM workspace_members wm
+WHERE wm.user_id = ? AND wm.role = 'owner'
+  AND NOT EXISTS (
+    SELECT 1 FROM workspace_members o
+    WHERE o.workspace_id = wm.workspace_id AND o.role = 'owner' AND o.user_id <> wm.user_id
+  );
+
+-- name: AddOwnerToWorkspacesOwnedBy :exec
+-- ユーザーの削除時に、ユーザーが所有者であるワークスペースに new_owner_id のユーザーを所有者として追加する (メンバーの場合は所有者に変更する)
+INSERT INTO workspace_members (workspace_id, user_id, role)
+SELECT wm.workspace_id, sqlc.arg(new_owner_id), 'owner' FROM workspace_members wm
+WHERE wm.user_id = sqlc.arg(owner_id) AND wm.role = 'owner'
+ON DUPLICATE KEY UPDATE role = 'owner';
+
+-- name: CreateWorkspaceInvitation :exec
+INSERT INTO workspace_invitations (id, workspace_id, email, role, invited_by, expires_at) VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetWorkspaceInvitationByID :one
+SELECT * FROM workspace_invitations WHERE id = ? LIMIT 1;
+
+-- name: ListPendingWorkspaceInvitations :many
+-- ワークスペースの有効期限内の未回答の招待を新しい順に返す
+SELECT * FROM workspace_invitations
+WHERE workspace_id = ? AND status = 'pending' AND expires_at > CURRENT_TIMESTAMP
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListPendingWorkspaceInvitationsByEmail :many
+-- メールアドレス宛ての有効期限内の未回答の招待を、ワークスペースの名前とともに新しい順に返す
+SELECT i.id, i.workspace_id, i.email, i.role, i.invited_by, i.status, i.expires_at, i.responded_at, i.created_at, w.name AS workspace_name
+FROM workspace_invitations i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE i.email = ? AND i.status = 'pending' AND i.expires_at > CURRENT_TIMESTAMP
+ORDER BY i.created_at DESC, i.id DESC;
+
+-- name: RespondWorkspaceInvitation :execrows
+-- 未回答の招待のみ状態を変更する (0 行の場合は回答済みか取り消し済み)
+UPDATE workspace_invitations SET status = ?, responded_at = CURRENT_TIMESTAMP WHERE id = ? AND status = 'pending';
+
+-- name: RevokePendingWorkspaceInvitations :exec
+-- 同じメールアドレスへの未回答の招待を取り消す (招待し直すときに使う)
+UPDATE workspace_invitations SET status = 'revoked', responded_at = CURRENT_TIMESTAMP
+WHERE workspace_id = ? AND email = ? AND status = 'pending';
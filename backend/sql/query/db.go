@@ -24,38 +24,414 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.addOwnerToWorkspacesOwnedByStmt, err = db.PrepareContext(ctx, addOwnerToWorkspacesOwnedBy); err != nil {
+		return nil, fmt.Errorf("error preparing query AddOwnerToWorkspacesOwnedBy: %w", err)
+	}
+	if q.cancelLoginFailureStmt, err = db.PrepareContext(ctx, cancelLoginFailure); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelLoginFailure: %w", err)
+	}
+	if q.confirmTOTPCredentialStmt, err = db.PrepareContext(ctx, confirmTOTPCredential); err != nil {
+		return nil, fmt.Errorf("error preparing query ConfirmTOTPCredential: %w", err)
+	}
+	if q.countSubtaskProgressStmt, err = db.PrepareContext(ctx, countSubtaskProgress); err != nil {
+		return nil, fmt.Errorf("error preparing query CountSubtaskProgress: %w", err)
+	}
+	if q.countTasksInProjectOutsideStatusesStmt, err = db.PrepareContext(ctx, countTasksInProjectOutsideStatuses); err != nil {
+		return nil, fmt.Errorf("error preparing query CountTasksInProjectOutsideStatuses: %w", err)
+	}
+	if q.countWorkspaceOwnersStmt, err = db.PrepareContext(ctx, countWorkspaceOwners); err != nil {
+		return nil, fmt.Errorf("error preparing query CountWorkspaceOwners: %w", err)
+	}
+	if q.createEmailVerificationTokenStmt, err = db.PrepareContext(ctx, createEmailVerificationToken); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEmailVerificationToken: %w", err)
+	}
+	if q.createLoginEventStmt, err = db.PrepareContext(ctx, createLoginEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateLoginEvent: %w", err)
+	}
+	if q.createOIDCAuthRequestStmt, err = db.PrepareContext(ctx, createOIDCAuthRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateOIDCAuthRequest: %w", err)
+	}
+	if q.createPasswordResetTokenStmt, err = db.PrepareContext(ctx, createPasswordResetToken); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePasswordResetToken: %w", err)
+	}
+	if q.createPersonalAccessTokenStmt, err = db.PrepareContext(ctx, createPersonalAccessToken); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePersonalAccessToken: %w", err)
+	}
+	if q.createProjectStmt, err = db.PrepareContext(ctx, createProject); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProject: %w", err)
+	}
+	if q.createRefreshTokenStmt, err = db.PrepareContext(ctx, createRefreshToken); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRefreshToken: %w", err)
+	}
+	if q.createTOTPRecoveryCodeStmt, err = db.PrepareContext(ctx, createTOTPRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTOTPRecoveryCode: %w", err)
+	}
 	if q.createTaskStmt, err = db.PrepareContext(ctx, createTask); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateTask: %w", err)
 	}
 	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
 	}
+	if q.createUserIdentityStmt, err = db.PrepareContext(ctx, createUserIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateUserIdentity: %w", err)
+	}
+	if q.createWorkspaceStmt, err = db.PrepareContext(ctx, createWorkspace); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWorkspace: %w", err)
+	}
+	if q.createWorkspaceInvitationStmt, err = db.PrepareContext(ctx, createWorkspaceInvitation); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWorkspaceInvitation: %w", err)
+	}
+	if q.createWorkspaceMemberStmt, err = db.PrepareContext(ctx, createWorkspaceMember); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWorkspaceMember: %w", err)
+	}
+	if q.deleteExpiredOIDCAuthRequestsStmt, err = db.PrepareContext(ctx, deleteExpiredOIDCAuthRequests); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredOIDCAuthRequests: %w", err)
+	}
+	if q.deleteExpiredRevokedTokensStmt, err = db.PrepareContext(ctx, deleteExpiredRevokedTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredRevokedTokens: %w", err)
+	}
+	if q.deleteLoginThrottleStmt, err = db.PrepareContext(ctx, deleteLoginThrottle); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteLoginThrottle: %w", err)
+	}
+	if q.deleteOIDCAuthRequestStmt, err = db.PrepareContext(ctx, deleteOIDCAuthRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteOIDCAuthRequest: %w", err)
+	}
+	if q.deleteProjectStmt, err = db.PrepareContext(ctx, deleteProject); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProject: %w", err)
+	}
+	if q.deleteTOTPCredentialStmt, err = db.PrepareContext(ctx, deleteTOTPCredential); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTOTPCredential: %w", err)
+	}
 	if q.deleteTaskStmt, err = db.PrepareContext(ctx, deleteTask); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteTask: %w", err)
 	}
+	if q.deleteTasksCreatedByStmt, err = db.PrepareContext(ctx, deleteTasksCreatedBy); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTasksCreatedBy: %w", err)
+	}
+	if q.deleteTasksInProjectStmt, err = db.PrepareContext(ctx, deleteTasksInProject); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTasksInProject: %w", err)
+	}
+	if q.deleteUserStmt, err = db.PrepareContext(ctx, deleteUser); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUser: %w", err)
+	}
+	if q.deleteUserTOTPRecoveryCodesStmt, err = db.PrepareContext(ctx, deleteUserTOTPRecoveryCodes); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUserTOTPRecoveryCodes: %w", err)
+	}
+	if q.deleteWorkspaceStmt, err = db.PrepareContext(ctx, deleteWorkspace); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWorkspace: %w", err)
+	}
+	if q.deleteWorkspaceMemberStmt, err = db.PrepareContext(ctx, deleteWorkspaceMember); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWorkspaceMember: %w", err)
+	}
+	if q.fallbackTaskStatusesInProjectStmt, err = db.PrepareContext(ctx, fallbackTaskStatusesInProject); err != nil {
+		return nil, fmt.Errorf("error preparing query FallbackTaskStatusesInProject: %w", err)
+	}
+	if q.getEmailVerificationTokenByHashStmt, err = db.PrepareContext(ctx, getEmailVerificationTokenByHash); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEmailVerificationTokenByHash: %w", err)
+	}
+	if q.getLastRankInColumnStmt, err = db.PrepareContext(ctx, getLastRankInColumn); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLastRankInColumn: %w", err)
+	}
+	if q.getLoginThrottleStmt, err = db.PrepareContext(ctx, getLoginThrottle); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLoginThrottle: %w", err)
+	}
+	if q.getOIDCAuthRequestStmt, err = db.PrepareContext(ctx, getOIDCAuthRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOIDCAuthRequest: %w", err)
+	}
+	if q.getPasswordResetTokenByHashStmt, err = db.PrepareContext(ctx, getPasswordResetTokenByHash); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPasswordResetTokenByHash: %w", err)
+	}
+	if q.getPersonalAccessTokenByHashStmt, err = db.PrepareContext(ctx, getPersonalAccessTokenByHash); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPersonalAccessTokenByHash: %w", err)
+	}
+	if q.getProjectByIDStmt, err = db.PrepareContext(ctx, getProjectByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProjectByID: %w", err)
+	}
+	if q.getRankAfterInColumnStmt, err = db.PrepareContext(ctx, getRankAfterInColumn); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRankAfterInColumn: %w", err)
+	}
+	if q.getRankBeforeInColumnStmt, err = db.PrepareContext(ctx, getRankBeforeInColumn); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRankBeforeInColumn: %w", err)
+	}
+	if q.getRefreshTokenByHashStmt, err = db.PrepareContext(ctx, getRefreshTokenByHash); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRefreshTokenByHash: %w", err)
+	}
+	if q.getTOTPCredentialStmt, err = db.PrepareContext(ctx, getTOTPCredential); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTOTPCredential: %w", err)
+	}
 	if q.getTaskByIDStmt, err = db.PrepareContext(ctx, getTaskByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTaskByID: %w", err)
 	}
+	if q.getTaskByIDForUpdateStmt, err = db.PrepareContext(ctx, getTaskByIDForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTaskByIDForUpdate: %w", err)
+	}
 	if q.getUserByEmailStmt, err = db.PrepareContext(ctx, getUserByEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByEmail: %w", err)
 	}
 	if q.getUserByIDStmt, err = db.PrepareContext(ctx, getUserByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByID: %w", err)
 	}
-	if q.listTasksStmt, err = db.PrepareContext(ctx, listTasks); err != nil {
-		return nil, fmt.Errorf("error preparing query ListTasks: %w", err)
+	if q.getUserIdentityStmt, err = db.PrepareContext(ctx, getUserIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserIdentity: %w", err)
+	}
+	if q.getUserTokensRevokedBeforeStmt, err = db.PrepareContext(ctx, getUserTokensRevokedBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserTokensRevokedBefore: %w", err)
+	}
+	if q.getWorkspaceByIDStmt, err = db.PrepareContext(ctx, getWorkspaceByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWorkspaceByID: %w", err)
+	}
+	if q.getWorkspaceInvitationByIDStmt, err = db.PrepareContext(ctx, getWorkspaceInvitationByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWorkspaceInvitationByID: %w", err)
+	}
+	if q.getWorkspaceMemberStmt, err = db.PrepareContext(ctx, getWorkspaceMember); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWorkspaceMember: %w", err)
+	}
+	if q.invalidateUserEmailVerificationTokensStmt, err = db.PrepareContext(ctx, invalidateUserEmailVerificationTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query InvalidateUserEmailVerificationTokens: %w", err)
+	}
+	if q.invalidateUserPasswordResetTokensStmt, err = db.PrepareContext(ctx, invalidateUserPasswordResetTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query InvalidateUserPasswordResetTokens: %w", err)
+	}
+	if q.isTokenRevokedStmt, err = db.PrepareContext(ctx, isTokenRevoked); err != nil {
+		return nil, fmt.Errorf("error preparing query IsTokenRevoked: %w", err)
+	}
+	if q.listLoginEventsByUserStmt, err = db.PrepareContext(ctx, listLoginEventsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query ListLoginEventsByUser: %w", err)
+	}
+	if q.listPendingWorkspaceInvitationsStmt, err = db.PrepareContext(ctx, listPendingWorkspaceInvitations); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPendingWorkspaceInvitations: %w", err)
+	}
+	if q.listPendingWorkspaceInvitationsByEmailStmt, err = db.PrepareContext(ctx, listPendingWorkspaceInvitationsByEmail); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPendingWorkspaceInvitationsByEmail: %w", err)
+	}
+	if q.listPersonalAccessTokensByUserStmt, err = db.PrepareContext(ctx, listPersonalAccessTokensByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPersonalAccessTokensByUser: %w", err)
+	}
+	if q.listProjectIDsOwnedByStmt, err = db.PrepareContext(ctx, listProjectIDsOwnedBy); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProjectIDsOwnedBy: %w", err)
+	}
+	if q.listProjectsByOwnerStmt, err = db.PrepareContext(ctx, listProjectsByOwner); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProjectsByOwner: %w", err)
+	}
+	if q.listSubtasksStmt, err = db.PrepareContext(ctx, listSubtasks); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSubtasks: %w", err)
+	}
+	if q.listSubtasksForUpdateStmt, err = db.PrepareContext(ctx, listSubtasksForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSubtasksForUpdate: %w", err)
+	}
+	if q.listTaskDescendantsStmt, err = db.PrepareContext(ctx, listTaskDescendants); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTaskDescendants: %w", err)
+	}
+	if q.listTaskIDsInColumnStmt, err = db.PrepareContext(ctx, listTaskIDsInColumn); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTaskIDsInColumn: %w", err)
+	}
+	if q.listTasksByCreatedAtStmt, err = db.PrepareContext(ctx, listTasksByCreatedAt); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTasksByCreatedAt: %w", err)
+	}
+	if q.listTasksByDueDateStmt, err = db.PrepareContext(ctx, listTasksByDueDate); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTasksByDueDate: %w", err)
+	}
+	if q.listTasksByPriorityStmt, err = db.PrepareContext(ctx, listTasksByPriority); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTasksByPriority: %w", err)
+	}
+	if q.listTasksByRankStmt, err = db.PrepareContext(ctx, listTasksByRank); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTasksByRank: %w", err)
+	}
+	if q.listTasksByUpdatedAtStmt, err = db.PrepareContext(ctx, listTasksByUpdatedAt); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTasksByUpdatedAt: %w", err)
+	}
+	if q.listUsersStmt, err = db.PrepareContext(ctx, listUsers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUsers: %w", err)
+	}
+	if q.listWorkspaceMembersStmt, err = db.PrepareContext(ctx, listWorkspaceMembers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWorkspaceMembers: %w", err)
+	}
+	if q.listWorkspacesByMemberStmt, err = db.PrepareContext(ctx, listWorkspacesByMember); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWorkspacesByMember: %w", err)
+	}
+	if q.listWorkspacesSolelyOwnedByStmt, err = db.PrepareContext(ctx, listWorkspacesSolelyOwnedBy); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWorkspacesSolelyOwnedBy: %w", err)
+	}
+	if q.lockLoginStmt, err = db.PrepareContext(ctx, lockLogin); err != nil {
+		return nil, fmt.Errorf("error preparing query LockLogin: %w", err)
+	}
+	if q.markEmailVerificationTokenUsedStmt, err = db.PrepareContext(ctx, markEmailVerificationTokenUsed); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkEmailVerificationTokenUsed: %w", err)
+	}
+	if q.markPasswordResetTokenUsedStmt, err = db.PrepareContext(ctx, markPasswordResetTokenUsed); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkPasswordResetTokenUsed: %w", err)
+	}
+	if q.markRefreshTokenUsedStmt, err = db.PrepareContext(ctx, markRefreshTokenUsed); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkRefreshTokenUsed: %w", err)
+	}
+	if q.markUserEmailVerifiedStmt, err = db.PrepareContext(ctx, markUserEmailVerified); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkUserEmailVerified: %w", err)
+	}
+	if q.moveTasksToProjectStmt, err = db.PrepareContext(ctx, moveTasksToProject); err != nil {
+		return nil, fmt.Errorf("error preparing query MoveTasksToProject: %w", err)
+	}
+	if q.reassignProjectsOwnedByStmt, err = db.PrepareContext(ctx, reassignProjectsOwnedBy); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignProjectsOwnedBy: %w", err)
+	}
+	if q.reassignTasksAssignedToStmt, err = db.PrepareContext(ctx, reassignTasksAssignedTo); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignTasksAssignedTo: %w", err)
+	}
+	if q.reassignTasksCreatedByStmt, err = db.PrepareContext(ctx, reassignTasksCreatedBy); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignTasksCreatedBy: %w", err)
+	}
+	if q.reassignWorkspaceTasksCreatedByToOwnerStmt, err = db.PrepareContext(ctx, reassignWorkspaceTasksCreatedByToOwner); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignWorkspaceTasksCreatedByToOwner: %w", err)
+	}
+	if q.recordLoginFailureStmt, err = db.PrepareContext(ctx, recordLoginFailure); err != nil {
+		return nil, fmt.Errorf("error preparing query RecordLoginFailure: %w", err)
+	}
+	if q.reparentSubtasksStmt, err = db.PrepareContext(ctx, reparentSubtasks); err != nil {
+		return nil, fmt.Errorf("error preparing query ReparentSubtasks: %w", err)
+	}
+	if q.respondWorkspaceInvitationStmt, err = db.PrepareContext(ctx, respondWorkspaceInvitation); err != nil {
+		return nil, fmt.Errorf("error preparing query RespondWorkspaceInvitation: %w", err)
+	}
+	if q.revokePendingWorkspaceInvitationsStmt, err = db.PrepareContext(ctx, revokePendingWorkspaceInvitations); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokePendingWorkspaceInvitations: %w", err)
+	}
+	if q.revokePersonalAccessTokenStmt, err = db.PrepareContext(ctx, revokePersonalAccessToken); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokePersonalAccessToken: %w", err)
+	}
+	if q.revokeRefreshTokenFamilyStmt, err = db.PrepareContext(ctx, revokeRefreshTokenFamily); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeRefreshTokenFamily: %w", err)
+	}
+	if q.revokeTokenStmt, err = db.PrepareContext(ctx, revokeToken); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeToken: %w", err)
+	}
+	if q.revokeUserPersonalAccessTokensStmt, err = db.PrepareContext(ctx, revokeUserPersonalAccessTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeUserPersonalAccessTokens: %w", err)
+	}
+	if q.revokeUserRefreshTokensStmt, err = db.PrepareContext(ctx, revokeUserRefreshTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeUserRefreshTokens: %w", err)
+	}
+	if q.saveTOTPCredentialStmt, err = db.PrepareContext(ctx, saveTOTPCredential); err != nil {
+		return nil, fmt.Errorf("error preparing query SaveTOTPCredential: %w", err)
+	}
+	if q.searchUsersStmt, err = db.PrepareContext(ctx, searchUsers); err != nil {
+		return nil, fmt.Errorf("error preparing query SearchUsers: %w", err)
+	}
+	if q.setUserDisabledAtStmt, err = db.PrepareContext(ctx, setUserDisabledAt); err != nil {
+		return nil, fmt.Errorf("error preparing query SetUserDisabledAt: %w", err)
+	}
+	if q.setUserTokensRevokedBeforeStmt, err = db.PrepareContext(ctx, setUserTokensRevokedBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query SetUserTokensRevokedBefore: %w", err)
+	}
+	if q.syncTaskCompletionInProjectStmt, err = db.PrepareContext(ctx, syncTaskCompletionInProject); err != nil {
+		return nil, fmt.Errorf("error preparing query SyncTaskCompletionInProject: %w", err)
+	}
+	if q.unassignWorkspaceTasksStmt, err = db.PrepareContext(ctx, unassignWorkspaceTasks); err != nil {
+		return nil, fmt.Errorf("error preparing query UnassignWorkspaceTasks: %w", err)
+	}
+	if q.unlockLoginStmt, err = db.PrepareContext(ctx, unlockLogin); err != nil {
+		return nil, fmt.Errorf("error preparing query UnlockLogin: %w", err)
+	}
+	if q.updateProjectStmt, err = db.PrepareContext(ctx, updateProject); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProject: %w", err)
+	}
+	if q.updateProjectWorkflowStmt, err = db.PrepareContext(ctx, updateProjectWorkflow); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProjectWorkflow: %w", err)
 	}
 	if q.updateTaskStmt, err = db.PrepareContext(ctx, updateTask); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateTask: %w", err)
 	}
+	if q.updateTaskRankStmt, err = db.PrepareContext(ctx, updateTaskRank); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTaskRank: %w", err)
+	}
 	if q.updateUserStmt, err = db.PrepareContext(ctx, updateUser); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUser: %w", err)
 	}
+	if q.updateWorkspaceStmt, err = db.PrepareContext(ctx, updateWorkspace); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWorkspace: %w", err)
+	}
+	if q.updateWorkspaceMemberRoleStmt, err = db.PrepareContext(ctx, updateWorkspaceMemberRole); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWorkspaceMemberRole: %w", err)
+	}
+	if q.useTOTPRecoveryCodeStmt, err = db.PrepareContext(ctx, useTOTPRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query UseTOTPRecoveryCode: %w", err)
+	}
+	if q.useTOTPStepStmt, err = db.PrepareContext(ctx, useTOTPStep); err != nil {
+		return nil, fmt.Errorf("error preparing query UseTOTPStep: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.addOwnerToWorkspacesOwnedByStmt != nil {
+		if cerr := q.addOwnerToWorkspacesOwnedByStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addOwnerToWorkspacesOwnedByStmt: %w", cerr)
+		}
+	}
+	if q.cancelLoginFailureStmt != nil {
+		if cerr := q.cancelLoginFailureStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelLoginFailureStmt: %w", cerr)
+		}
+	}
+	if q.confirmTOTPCredentialStmt != nil {
+		if cerr := q.confirmTOTPCredentialStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing confirmTOTPCredentialStmt: %w", cerr)
+		}
+	}
+	if q.countSubtaskProgressStmt != nil {
+		if cerr := q.countSubtaskProgressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countSubtaskProgressStmt: %w", cerr)
+		}
+	}
+	if q.countTasksInProjectOutsideStatusesStmt != nil {
+		if cerr := q.countTasksInProjectOutsideStatusesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countTasksInProjectOutsideStatusesStmt: %w", cerr)
+		}
+	}
+	if q.countWorkspaceOwnersStmt != nil {
+		if cerr := q.countWorkspaceOwnersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countWorkspaceOwnersStmt: %w", cerr)
+		}
+	}
+	if q.createEmailVerificationTokenStmt != nil {
+		if cerr := q.createEmailVerificationTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEmailVerificationTokenStmt: %w", cerr)
+		}
+	}
+	if q.createLoginEventStmt != nil {
+		if cerr := q.createLoginEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createLoginEventStmt: %w", cerr)
+		}
+	}
+	if q.createOIDCAuthRequestStmt != nil {
+		if cerr := q.createOIDCAuthRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createOIDCAuthRequestStmt: %w", cerr)
+		}
+	}
+	if q.createPasswordResetTokenStmt != nil {
+		if cerr := q.createPasswordResetTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPasswordResetTokenStmt: %w", cerr)
+		}
+	}
+	if q.createPersonalAccessTokenStmt != nil {
+		if cerr := q.createPersonalAccessTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPersonalAccessTokenStmt: %w", cerr)
+		}
+	}
+	if q.createProjectStmt != nil {
+		if cerr := q.createProjectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProjectStmt: %w", cerr)
+		}
+	}
+	if q.createRefreshTokenStmt != nil {
+		if cerr := q.createRefreshTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRefreshTokenStmt: %w", cerr)
+		}
+	}
+	if q.createTOTPRecoveryCodeStmt != nil {
+		if cerr := q.createTOTPRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTOTPRecoveryCodeStmt: %w", cerr)
+		}
+	}
 	if q.createTaskStmt != nil {
 		if cerr := q.createTaskStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createTaskStmt: %w", cerr)
@@ -66,16 +442,161 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
 		}
 	}
+	if q.createUserIdentityStmt != nil {
+		if cerr := q.createUserIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createUserIdentityStmt: %w", cerr)
+		}
+	}
+	if q.createWorkspaceStmt != nil {
+		if cerr := q.createWorkspaceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWorkspaceStmt: %w", cerr)
+		}
+	}
+	if q.createWorkspaceInvitationStmt != nil {
+		if cerr := q.createWorkspaceInvitationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWorkspaceInvitationStmt: %w", cerr)
+		}
+	}
+	if q.createWorkspaceMemberStmt != nil {
+		if cerr := q.createWorkspaceMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWorkspaceMemberStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredOIDCAuthRequestsStmt != nil {
+		if cerr := q.deleteExpiredOIDCAuthRequestsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredOIDCAuthRequestsStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredRevokedTokensStmt != nil {
+		if cerr := q.deleteExpiredRevokedTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredRevokedTokensStmt: %w", cerr)
+		}
+	}
+	if q.deleteLoginThrottleStmt != nil {
+		if cerr := q.deleteLoginThrottleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteLoginThrottleStmt: %w", cerr)
+		}
+	}
+	if q.deleteOIDCAuthRequestStmt != nil {
+		if cerr := q.deleteOIDCAuthRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteOIDCAuthRequestStmt: %w", cerr)
+		}
+	}
+	if q.deleteProjectStmt != nil {
+		if cerr := q.deleteProjectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProjectStmt: %w", cerr)
+		}
+	}
+	if q.deleteTOTPCredentialStmt != nil {
+		if cerr := q.deleteTOTPCredentialStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTOTPCredentialStmt: %w", cerr)
+		}
+	}
 	if q.deleteTaskStmt != nil {
 		if cerr := q.deleteTaskStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteTaskStmt: %w", cerr)
 		}
 	}
+	if q.deleteTasksCreatedByStmt != nil {
+		if cerr := q.deleteTasksCreatedByStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTasksCreatedByStmt: %w", cerr)
+		}
+	}
+	if q.deleteTasksInProjectStmt != nil {
+		if cerr := q.deleteTasksInProjectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTasksInProjectStmt: %w", cerr)
+		}
+	}
+	if q.deleteUserStmt != nil {
+		if cerr := q.deleteUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserStmt: %w", cerr)
+		}
+	}
+	if q.deleteUserTOTPRecoveryCodesStmt != nil {
+		if cerr := q.deleteUserTOTPRecoveryCodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserTOTPRecoveryCodesStmt: %w", cerr)
+		}
+	}
+	if q.deleteWorkspaceStmt != nil {
+		if cerr := q.deleteWorkspaceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWorkspaceStmt: %w", cerr)
+		}
+	}
+	if q.deleteWorkspaceMemberStmt != nil {
+		if cerr := q.deleteWorkspaceMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWorkspaceMemberStmt: %w", cerr)
+		}
+	}
+	if q.fallbackTaskStatusesInProjectStmt != nil {
+		if cerr := q.fallbackTaskStatusesInProjectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing fallbackTaskStatusesInProjectStmt: %w", cerr)
+		}
+	}
+	if q.getEmailVerificationTokenByHashStmt != nil {
+		if cerr := q.getEmailVerificationTokenByHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEmailVerificationTokenByHashStmt: %w", cerr)
+		}
+	}
+	if q.getLastRankInColumnStmt != nil {
+		if cerr := q.getLastRankInColumnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLastRankInColumnStmt: %w", cerr)
+		}
+	}
+	if q.getLoginThrottleStmt != nil {
+		if cerr := q.getLoginThrottleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLoginThrottleStmt: %w", cerr)
+		}
+	}
+	if q.getOIDCAuthRequestStmt != nil {
+		if cerr := q.getOIDCAuthRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOIDCAuthRequestStmt: %w", cerr)
+		}
+	}
+	if q.getPasswordResetTokenByHashStmt != nil {
+		if cerr := q.getPasswordResetTokenByHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPasswordResetTokenByHashStmt: %w", cerr)
+		}
+	}
+	if q.getPersonalAccessTokenByHashStmt != nil {
+		if cerr := q.getPersonalAccessTokenByHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPersonalAccessTokenByHashStmt: %w", cerr)
+		}
+	}
+	if q.getProjectByIDStmt != nil {
+		if cerr := q.getProjectByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProjectByIDStmt: %w", cerr)
+		}
+	}
+	if q.getRankAfterInColumnStmt != nil {
+		if cerr := q.getRankAfterInColumnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRankAfterInColumnStmt: %w", cerr)
+		}
+	}
+	if q.getRankBeforeInColumnStmt != nil {
+		if cerr := q.getRankBeforeInColumnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRankBeforeInColumnStmt: %w", cerr)
+		}
+	}
+	if q.getRefreshTokenByHashStmt != nil {
+		if cerr := q.getRefreshTokenByHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRefreshTokenByHashStmt: %w", cerr)
+		}
+	}
+	if q.getTOTPCredentialStmt != nil {
+		if cerr := q.getTOTPCredentialStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTOTPCredentialStmt: %w", cerr)
+		}
+	}
 	if q.getTaskByIDStmt != nil {
 		if cerr := q.getTaskByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTaskByIDStmt: %w", cerr)
 		}
 	}
+	if q.getTaskByIDForUpdateStmt != nil {
+		if cerr := q.getTaskByIDForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTaskByIDForUpdateStmt: %w", cerr)
+		}
+	}
 	if q.getUserByEmailStmt != nil {
 		if cerr := q.getUserByEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserByEmailStmt: %w", cerr)
@@ -86,9 +607,279 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserByIDStmt: %w", cerr)
 		}
 	}
-	if q.listTasksStmt != nil {
-		if cerr := q.listTasksStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing listTasksStmt: %w", cerr)
+	if q.getUserIdentityStmt != nil {
+		if cerr := q.getUserIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserIdentityStmt: %w", cerr)
+		}
+	}
+	if q.getUserTokensRevokedBeforeStmt != nil {
+		if cerr := q.getUserTokensRevokedBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserTokensRevokedBeforeStmt: %w", cerr)
+		}
+	}
+	if q.getWorkspaceByIDStmt != nil {
+		if cerr := q.getWorkspaceByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWorkspaceByIDStmt: %w", cerr)
+		}
+	}
+	if q.getWorkspaceInvitationByIDStmt != nil {
+		if cerr := q.getWorkspaceInvitationByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWorkspaceInvitationByIDStmt: %w", cerr)
+		}
+	}
+	if q.getWorkspaceMemberStmt != nil {
+		if cerr := q.getWorkspaceMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWorkspaceMemberStmt: %w", cerr)
+		}
+	}
+	if q.invalidateUserEmailVerificationTokensStmt != nil {
+		if cerr := q.invalidateUserEmailVerificationTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing invalidateUserEmailVerificationTokensStmt: %w", cerr)
+		}
+	}
+	if q.invalidateUserPasswordResetTokensStmt != nil {
+		if cerr := q.invalidateUserPasswordResetTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing invalidateUserPasswordResetTokensStmt: %w", cerr)
+		}
+	}
+	if q.isTokenRevokedStmt != nil {
+		if cerr := q.isTokenRevokedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing isTokenRevokedStmt: %w", cerr)
+		}
+	}
+	if q.listLoginEventsByUserStmt != nil {
+		if cerr := q.listLoginEventsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listLoginEventsByUserStmt: %w", cerr)
+		}
+	}
+	if q.listPendingWorkspaceInvitationsStmt != nil {
+		if cerr := q.listPendingWorkspaceInvitationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPendingWorkspaceInvitationsStmt: %w", cerr)
+		}
+	}
+	if q.listPendingWorkspaceInvitationsByEmailStmt != nil {
+		if cerr := q.listPendingWorkspaceInvitationsByEmailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPendingWorkspaceInvitationsByEmailStmt: %w", cerr)
+		}
+	}
+	if q.listPersonalAccessTokensByUserStmt != nil {
+		if cerr := q.listPersonalAccessTokensByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPersonalAccessTokensByUserStmt: %w", cerr)
+		}
+	}
+	if q.listProjectIDsOwnedByStmt != nil {
+		if cerr := q.listProjectIDsOwnedByStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProjectIDsOwnedByStmt: %w", cerr)
+		}
+	}
+	if q.listProjectsByOwnerStmt != nil {
+		if cerr := q.listProjectsByOwnerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProjectsByOwnerStmt: %w", cerr)
+		}
+	}
+	if q.listSubtasksStmt != nil {
+		if cerr := q.listSubtasksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSubtasksStmt: %w", cerr)
+		}
+	}
+	if q.listSubtasksForUpdateStmt != nil {
+		if cerr := q.listSubtasksForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSubtasksForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.listTaskDescendantsStmt != nil {
+		if cerr := q.listTaskDescendantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTaskDescendantsStmt: %w", cerr)
+		}
+	}
+	if q.listTaskIDsInColumnStmt != nil {
+		if cerr := q.listTaskIDsInColumnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTaskIDsInColumnStmt: %w", cerr)
+		}
+	}
+	if q.listTasksByCreatedAtStmt != nil {
+		if cerr := q.listTasksByCreatedAtStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTasksByCreatedAtStmt: %w", cerr)
+		}
+	}
+	if q.listTasksByDueDateStmt != nil {
+		if cerr := q.listTasksByDueDateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTasksByDueDateStmt: %w", cerr)
+		}
+	}
+	if q.listTasksByPriorityStmt != nil {
+		if cerr := q.listTasksByPriorityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTasksByPriorityStmt: %w", cerr)
+		}
+	}
+	if q.listTasksByRankStmt != nil {
+		if cerr := q.listTasksByRankStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTasksByRankStmt: %w", cerr)
+		}
+	}
+	if q.listTasksByUpdatedAtStmt != nil {
+		if cerr := q.listTasksByUpdatedAtStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTasksByUpdatedAtStmt: %w", cerr)
+		}
+	}
+	if q.listUsersStmt != nil {
+		if cerr := q.listUsersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUsersStmt: %w", cerr)
+		}
+	}
+	if q.listWorkspaceMembersStmt != nil {
+		if cerr := q.listWorkspaceMembersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWorkspaceMembersStmt: %w", cerr)
+		}
+	}
+	if q.listWorkspacesByMemberStmt != nil {
+		if cerr := q.listWorkspacesByMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWorkspacesByMemberStmt: %w", cerr)
+		}
+	}
+	if q.listWorkspacesSolelyOwnedByStmt != nil {
+		if cerr := q.listWorkspacesSolelyOwnedByStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWorkspacesSolelyOwnedByStmt: %w", cerr)
+		}
+	}
+	if q.lockLoginStmt != nil {
+		if cerr := q.lockLoginStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockLoginStmt: %w", cerr)
+		}
+	}
+	if q.markEmailVerificationTokenUsedStmt != nil {
+		if cerr := q.markEmailVerificationTokenUsedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markEmailVerificationTokenUsedStmt: %w", cerr)
+		}
+	}
+	if q.markPasswordResetTokenUsedStmt != nil {
+		if cerr := q.markPasswordResetTokenUsedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markPasswordResetTokenUsedStmt: %w", cerr)
+		}
+	}
+	if q.markRefreshTokenUsedStmt != nil {
+		if cerr := q.markRefreshTokenUsedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markRefreshTokenUsedStmt: %w", cerr)
+		}
+	}
+	if q.markUserEmailVerifiedStmt != nil {
+		if cerr := q.markUserEmailVerifiedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markUserEmailVerifiedStmt: %w", cerr)
+		}
+	}
+	if q.moveTasksToProjectStmt != nil {
+		if cerr := q.moveTasksToProjectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing moveTasksToProjectStmt: %w", cerr)
+		}
+	}
+	if q.reassignProjectsOwnedByStmt != nil {
+		if cerr := q.reassignProjectsOwnedByStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignProjectsOwnedByStmt: %w", cerr)
+		}
+	}
+	if q.reassignTasksAssignedToStmt != nil {
+		if cerr := q.reassignTasksAssignedToStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignTasksAssignedToStmt: %w", cerr)
+		}
+	}
+	if q.reassignTasksCreatedByStmt != nil {
+		if cerr := q.reassignTasksCreatedByStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignTasksCreatedByStmt: %w", cerr)
+		}
+	}
+	if q.reassignWorkspaceTasksCreatedByToOwnerStmt != nil {
+		if cerr := q.reassignWorkspaceTasksCreatedByToOwnerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignWorkspaceTasksCreatedByToOwnerStmt: %w", cerr)
+		}
+	}
+	if q.recordLoginFailureStmt != nil {
+		if cerr := q.recordLoginFailureStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recordLoginFailureStmt: %w", cerr)
+		}
+	}
+	if q.reparentSubtasksStmt != nil {
+		if cerr := q.reparentSubtasksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reparentSubtasksStmt: %w", cerr)
+		}
+	}
+	if q.respondWorkspaceInvitationStmt != nil {
+		if cerr := q.respondWorkspaceInvitationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing respondWorkspaceInvitationStmt: %w", cerr)
+		}
+	}
+	if q.revokePendingWorkspaceInvitationsStmt != nil {
+		if cerr := q.revokePendingWorkspaceInvitationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokePendingWorkspaceInvitationsStmt: %w", cerr)
+		}
+	}
+	if q.revokePersonalAccessTokenStmt != nil {
+		if cerr := q.revokePersonalAccessTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokePersonalAccessTokenStmt: %w", cerr)
+		}
+	}
+	if q.revokeRefreshTokenFamilyStmt != nil {
+		if cerr := q.revokeRefreshTokenFamilyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeRefreshTokenFamilyStmt: %w", cerr)
+		}
+	}
+	if q.revokeTokenStmt != nil {
+		if cerr := q.revokeTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeTokenStmt: %w", cerr)
+		}
+	}
+	if q.revokeUserPersonalAccessTokensStmt != nil {
+		if cerr := q.revokeUserPersonalAccessTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeUserPersonalAccessTokensStmt: %w", cerr)
+		}
+	}
+	if q.revokeUserRefreshTokensStmt != nil {
+		if cerr := q.revokeUserRefreshTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeUserRefreshTokensStmt: %w", cerr)
+		}
+	}
+	if q.saveTOTPCredentialStmt != nil {
+		if cerr := q.saveTOTPCredentialStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing saveTOTPCredentialStmt: %w", cerr)
+		}
+	}
+	if q.searchUsersStmt != nil {
+		if cerr := q.searchUsersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing searchUsersStmt: %w", cerr)
+		}
+	}
+	if q.setUserDisabledAtStmt != nil {
+		if cerr := q.setUserDisabledAtStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setUserDisabledAtStmt: %w", cerr)
+		}
+	}
+	if q.setUserTokensRevokedBeforeStmt != nil {
+		if cerr := q.setUserTokensRevokedBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setUserTokensRevokedBeforeStmt: %w", cerr)
+		}
+	}
+	if q.syncTaskCompletionInProjectStmt != nil {
+		if cerr := q.syncTaskCompletionInProjectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing syncTaskCompletionInProjectStmt: %w", cerr)
+		}
+	}
+	if q.unassignWorkspaceTasksStmt != nil {
+		if cerr := q.unassignWorkspaceTasksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing unassignWorkspaceTasksStmt: %w", cerr)
+		}
+	}
+	if q.unlockLoginStmt != nil {
+		if cerr := q.unlockLoginStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing unlockLoginStmt: %w", cerr)
+		}
+	}
+	if q.updateProjectStmt != nil {
+		if cerr := q.updateProjectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProjectStmt: %w", cerr)
+		}
+	}
+	if q.updateProjectWorkflowStmt != nil {
+		if cerr := q.updateProjectWorkflowStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProjectWorkflowStmt: %w", cerr)
 		}
 	}
 	if q.updateTaskStmt != nil {
@@ -96,11 +887,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateTaskStmt: %w", cerr)
 		}
 	}
+	if q.updateTaskRankStmt != nil {
+		if cerr := q.updateTaskRankStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTaskRankStmt: %w", cerr)
+		}
+	}
 	if q.updateUserStmt != nil {
 		if cerr := q.updateUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateUserStmt: %w", cerr)
 		}
 	}
+	if q.updateWorkspaceStmt != nil {
+		if cerr := q.updateWorkspaceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWorkspaceStmt: %w", cerr)
+		}
+	}
+	if q.updateWorkspaceMemberRoleStmt != nil {
+		if cerr := q.updateWorkspaceMemberRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWorkspaceMemberRoleStmt: %w", cerr)
+		}
+	}
+	if q.useTOTPRecoveryCodeStmt != nil {
+		if cerr := q.useTOTPRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useTOTPRecoveryCodeStmt: %w", cerr)
+		}
+	}
+	if q.useTOTPStepStmt != nil {
+		if cerr := q.useTOTPStepStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useTOTPStepStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -138,31 +954,235 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                 DBTX
-	tx                 *sql.Tx
-	createTaskStmt     *sql.Stmt
-	createUserStmt     *sql.Stmt
-	deleteTaskStmt     *sql.Stmt
-	getTaskByIDStmt    *sql.Stmt
-	getUserByEmailStmt *sql.Stmt
-	getUserByIDStmt    *sql.Stmt
-	listTasksStmt      *sql.Stmt
-	updateTaskStmt     *sql.Stmt
-	updateUserStmt     *sql.Stmt
+	db                                         DBTX
+	tx                                         *sql.Tx
+	addOwnerToWorkspacesOwnedByStmt            *sql.Stmt
+	cancelLoginFailureStmt                     *sql.Stmt
+	confirmTOTPCredentialStmt                  *sql.Stmt
+	countSubtaskProgressStmt                   *sql.Stmt
+	countTasksInProjectOutsideStatusesStmt     *sql.Stmt
+	countWorkspaceOwnersStmt                   *sql.Stmt
+	createEmailVerificationTokenStmt           *sql.Stmt
+	createLoginEventStmt                       *sql.Stmt
+	createOIDCAuthRequestStmt                  *sql.Stmt
+	createPasswordResetTokenStmt               *sql.Stmt
+	createPersonalAccessTokenStmt              *sql.Stmt
+	createProjectStmt                          *sql.Stmt
+	createRefreshTokenStmt                     *sql.Stmt
+	createTOTPRecoveryCodeStmt                 *sql.Stmt
+	createTaskStmt                             *sql.Stmt
+	createUserStmt                             *sql.Stmt
+	createUserIdentityStmt                     *sql.Stmt
+	createWorkspaceStmt                        *sql.Stmt
+	createWorkspaceInvitationStmt              *sql.Stmt
+	createWorkspaceMemberStmt                  *sql.Stmt
+	deleteExpiredOIDCAuthRequestsStmt          *sql.Stmt
+	deleteExpiredRevokedTokensStmt             *sql.Stmt
+	deleteLoginThrottleStmt                    *sql.Stmt
+	deleteOIDCAuthRequestStmt                  *sql.Stmt
+	deleteProjectStmt                          *sql.Stmt
+	deleteTOTPCredentialStmt                   *sql.Stmt
+	deleteTaskStmt                             *sql.Stmt
+	deleteTasksCreatedByStmt                   *sql.Stmt
+	deleteTasksInProjectStmt                   *sql.Stmt
+	deleteUserStmt                             *sql.Stmt
+	deleteUserTOTPRecoveryCodesStmt            *sql.Stmt
+	deleteWorkspaceStmt                        *sql.Stmt
+	deleteWorkspaceMemberStmt                  *sql.Stmt
+	fallbackTaskStatusesInProjectStmt          *sql.Stmt
+	getEmailVerificationTokenByHashStmt        *sql.Stmt
+	getLastRankInColumnStmt                    *sql.Stmt
+	getLoginThrottleStmt                       *sql.Stmt
+	getOIDCAuthRequestStmt                     *sql.Stmt
+	getPasswordResetTokenByHashStmt            *sql.Stmt
+	getPersonalAccessTokenByHashStmt           *sql.Stmt
+	getProjectByIDStmt                         *sql.Stmt
+	getRankAfterInColumnStmt                   *sql.Stmt
+	getRankBeforeInColumnStmt                  *sql.Stmt
+	getRefreshTokenByHashStmt                  *sql.Stmt
+	getTOTPCredentialStmt                      *sql.Stmt
+	getTaskByIDStmt                            *sql.Stmt
+	getTaskByIDForUpdateStmt                   *sql.Stmt
+	getUserByEmailStmt                         *sql.Stmt
+	getUserByIDStmt                            *sql.Stmt
+	getUserIdentityStmt                        *sql.Stmt
+	getUserTokensRevokedBeforeStmt             *sql.Stmt
+	getWorkspaceByIDStmt                       *sql.Stmt
+	getWorkspaceInvitationByIDStmt             *sql.Stmt
+	getWorkspaceMemberStmt                     *sql.Stmt
+	invalidateUserEmailVerificationTokensStmt  *sql.Stmt
+	invalidateUserPasswordResetTokensStmt      *sql.Stmt
+	isTokenRevokedStmt                         *sql.Stmt
+	listLoginEventsByUserStmt                  *sql.Stmt
+	listPendingWorkspaceInvitationsStmt        *sql.Stmt
+	listPendingWorkspaceInvitationsByEmailStmt *sql.Stmt
+	listPersonalAccessTokensByUserStmt         *sql.Stmt
+	listProjectIDsOwnedByStmt                  *sql.Stmt
+	listProjectsByOwnerStmt                    *sql.Stmt
+	listSubtasksStmt                           *sql.Stmt
+	listSubtasksForUpdateStmt                  *sql.Stmt
+	listTaskDescendantsStmt                    *sql.Stmt
+	listTaskIDsInColumnStmt                    *sql.Stmt
+	listTasksByCreatedAtStmt                   *sql.Stmt
+	listTasksByDueDateStmt                     *sql.Stmt
+	listTasksByPriorityStmt                    *sql.Stmt
+	listTasksByRankStmt                        *sql.Stmt
+	listTasksByUpdatedAtStmt                   *sql.Stmt
+	listUsersStmt                              *sql.Stmt
+	listWorkspaceMembersStmt                   *sql.Stmt
+	listWorkspacesByMemberStmt                 *sql.Stmt
+	listWorkspacesSolelyOwnedByStmt            *sql.Stmt
+	lockLoginStmt                              *sql.Stmt
+	markEmailVerificationTokenUsedStmt         *sql.Stmt
+	markPasswordResetTokenUsedStmt             *sql.Stmt
+	markRefreshTokenUsedStmt                   *sql.Stmt
+	markUserEmailVerifiedStmt                  *sql.Stmt
+	moveTasksToProjectStmt                     *sql.Stmt
+	reassignProjectsOwnedByStmt                *sql.Stmt
+	reassignTasksAssignedToStmt                *sql.Stmt
+	reassignTasksCreatedByStmt                 *sql.Stmt
+	reassignWorkspaceTasksCreatedByToOwnerStmt *sql.Stmt
+	recordLoginFailureStmt                     *sql.Stmt
+	reparentSubtasksStmt                       *sql.Stmt
+	respondWorkspaceInvitationStmt             *sql.Stmt
+	revokePendingWorkspaceInvitationsStmt      *sql.Stmt
+	revokePersonalAccessTokenStmt              *sql.Stmt
+	revokeRefreshTokenFamilyStmt               *sql.Stmt
+	revokeTokenStmt                            *sql.Stmt
+	revokeUserPersonalAccessTokensStmt         *sql.Stmt
+	revokeUserRefreshTokensStmt                *sql.Stmt
+	saveTOTPCredentialStmt                     *sql.Stmt
+	searchUsersStmt                            *sql.Stmt
+	setUserDisabledAtStmt                      *sql.Stmt
+	setUserTokensRevokedBeforeStmt             *sql.Stmt
+	syncTaskCompletionInProjectStmt            *sql.Stmt
+	unassignWorkspaceTasksStmt                 *sql.Stmt
+	unlockLoginStmt                            *sql.Stmt
+	updateProjectStmt                          *sql.Stmt
+	updateProjectWorkflowStmt                  *sql.Stmt
+	updateTaskStmt                             *sql.Stmt
+	updateTaskRankStmt                         *sql.Stmt
+	updateUserStmt                             *sql.Stmt
+	updateWorkspaceStmt                        *sql.Stmt
+	updateWorkspaceMemberRoleStmt              *sql.Stmt
+	useTOTPRecoveryCodeStmt                    *sql.Stmt
+	useTOTPStepStmt                            *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                 tx,
-		tx:                 tx,
-		createTaskStmt:     q.createTaskStmt,
-		createUserStmt:     q.createUserStmt,
-		deleteTaskStmt:     q.deleteTaskStmt,
-		getTaskByIDStmt:    q.getTaskByIDStmt,
-		getUserByEmailStmt: q.getUserByEmailStmt,
-		getUserByIDStmt:    q.getUserByIDStmt,
-		listTasksStmt:      q.listTasksStmt,
-		updateTaskStmt:     q.updateTaskStmt,
-		updateUserStmt:     q.updateUserStmt,
+		db:                                         tx,
+		tx:                                         tx,
+		addOwnerToWorkspacesOwnedByStmt:            q.addOwnerToWorkspacesOwnedByStmt,
+		cancelLoginFailureStmt:                     q.cancelLoginFailureStmt,
+		confirmTOTPCredentialStmt:                  q.confirmTOTPCredentialStmt,
+		countSubtaskProgressStmt:                   q.countSubtaskProgressStmt,
+		countTasksInProjectOutsideStatusesStmt:     q.countTasksInProjectOutsideStatusesStmt,
+		countWorkspaceOwnersStmt:                   q.countWorkspaceOwnersStmt,
+		createEmailVerificationTokenStmt:           q.createEmailVerificationTokenStmt,
+		createLoginEventStmt:                       q.createLoginEventStmt,
+		createOIDCAuthRequestStmt:                  q.createOIDCAuthRequestStmt,
+		createPasswordResetTokenStmt:               q.createPasswordResetTokenStmt,
+		createPersonalAccessTokenStmt:              q.createPersonalAccessTokenStmt,
+		createProjectStmt:                          q.createProjectStmt,
+		createRefreshTokenStmt:                     q.createRefreshTokenStmt,
+		createTOTPRecoveryCodeStmt:                 q.createTOTPRecoveryCodeStmt,
+		createTaskStmt:                             q.createTaskStmt,
+		createUserStmt:                             q.createUserStmt,
+		createUserIdentityStmt:                     q.createUserIdentityStmt,
+		createWorkspaceStmt:                        q.createWorkspaceStmt,
+		createWorkspaceInvitationStmt:              q.createWorkspaceInvitationStmt,
+		createWorkspaceMemberStmt:                  q.createWorkspaceMemberStmt,
+		deleteExpiredOIDCAuthRequestsStmt:          q.deleteExpiredOIDCAuthRequestsStmt,
+		deleteExpiredRevokedTokensStmt:             q.deleteExpiredRevokedTokensStmt,
+		deleteLoginThrottleStmt:                    q.deleteLoginThrottleStmt,
+		deleteOIDCAuthRequestStmt:                  q.deleteOIDCAuthRequestStmt,
+		deleteProjectStmt:                          q.deleteProjectStmt,
+		deleteTOTPCredentialStmt:                   q.deleteTOTPCredentialStmt,
+		deleteTaskStmt:                             q.deleteTaskStmt,
+		deleteTasksCreatedByStmt:                   q.deleteTasksCreatedByStmt,
+		deleteTasksInProjectStmt:                   q.deleteTasksInProjectStmt,
+		deleteUserStmt:                             q.deleteUserStmt,
+		deleteUserTOTPRecoveryCodesStmt:            q.deleteUserTOTPRecoveryCodesStmt,
+		deleteWorkspaceStmt:                        q.deleteWorkspaceStmt,
+		deleteWorkspaceMemberStmt:                  q.deleteWorkspaceMemberStmt,
+		fallbackTaskStatusesInProjectStmt:          q.fallbackTaskStatusesInProjectStmt,
+		getEmailVerificationTokenByHashStmt:        q.getEmailVerificationTokenByHashStmt,
+		getLastRankInColumnStmt:                    q.getLastRankInColumnStmt,
+		getLoginThrottleStmt:                       q.getLoginThrottleStmt,
+		getOIDCAuthRequestStmt:                     q.getOIDCAuthRequestStmt,
+		getPasswordResetTokenByHashStmt:            q.getPasswordResetTokenByHashStmt,
+		getPersonalAccessTokenByHashStmt:           q.getPersonalAccessTokenByHashStmt,
+		getProjectByIDStmt:                         q.getProjectByIDStmt,
+		getRankAfterInColumnStmt:                   q.getRankAfterInColumnStmt,
+		getRankBeforeInColumnStmt:                  q.getRankBeforeInColumnStmt,
+		getRefreshTokenByHashStmt:                  q.getRefreshTokenByHashStmt,
+		getTOTPCredentialStmt:                      q.getTOTPCredentialStmt,
+		getTaskByIDStmt:                            q.getTaskByIDStmt,
+		getTaskByIDForUpdateStmt:                   q.getTaskByIDForUpdateStmt,
+		getUserByEmailStmt:                         q.getUserByEmailStmt,
+		getUserByIDStmt:                            q.getUserByIDStmt,
+		getUserIdentityStmt:                        q.getUserIdentityStmt,
+		getUserTokensRevokedBeforeStmt:             q.getUserTokensRevokedBeforeStmt,
+		getWorkspaceByIDStmt:                       q.getWorkspaceByIDStmt,
+		getWorkspaceInvitationByIDStmt:             q.getWorkspaceInvitationByIDStmt,
+		getWorkspaceMemberStmt:                     q.getWorkspaceMemberStmt,
+		invalidateUserEmailVerificationTokensStmt:  q.invalidateUserEmailVerificationTokensStmt,
+		invalidateUserPasswordResetTokensStmt:      q.invalidateUserPasswordResetTokensStmt,
+		isTokenRevokedStmt:                         q.isTokenRevokedStmt,
+		listLoginEventsByUserStmt:                  q.listLoginEventsByUserStmt,
+		listPendingWorkspaceInvitationsStmt:        q.listPendingWorkspaceInvitationsStmt,
+		listPendingWorkspaceInvitationsByEmailStmt: q.listPendingWorkspaceInvitationsByEmailStmt,
+		listPersonalAccessTokensByUserStmt:         q.listPersonalAccessTokensByUserStmt,
+		listProjectIDsOwnedByStmt:                  q.listProjectIDsOwnedByStmt,
+		listProjectsByOwnerStmt:                    q.listProjectsByOwnerStmt,
+		listSubtasksStmt:                           q.listSubtasksStmt,
+		listSubtasksForUpdateStmt:                  q.listSubtasksForUpdateStmt,
+		listTaskDescendantsStmt:                    q.listTaskDescendantsStmt,
+		listTaskIDsInColumnStmt:                    q.listTaskIDsInColumnStmt,
+		listTasksByCreatedAtStmt:                   q.listTasksByCreatedAtStmt,
+		listTasksByDueDateStmt:                     q.listTasksByDueDateStmt,
+		listTasksByPriorityStmt:                    q.listTasksByPriorityStmt,
+		listTasksByRankStmt:                        q.listTasksByRankStmt,
+		listTasksByUpdatedAtStmt:                   q.listTasksByUpdatedAtStmt,
+		listUsersStmt:                              q.listUsersStmt,
+		listWorkspaceMembersStmt:                   q.listWorkspaceMembersStmt,
+		listWorkspacesByMemberStmt:                 q.listWorkspacesByMemberStmt,
+		listWorkspacesSolelyOwnedByStmt:            q.listWorkspacesSolelyOwnedByStmt,
+		lockLoginStmt:                              q.lockLoginStmt,
+		markEmailVerificationTokenUsedStmt:         q.markEmailVerificationTokenUsedStmt,
+		markPasswordResetTokenUsedStmt:             q.markPasswordResetTokenUsedStmt,
+		markRefreshTokenUsedStmt:                   q.markRefreshTokenUsedStmt,
+		markUserEmailVerifiedStmt:                  q.markUserEmailVerifiedStmt,
+		moveTasksToProjectStmt:                     q.moveTasksToProjectStmt,
+		reassignProjectsOwnedByStmt:                q.reassignProjectsOwnedByStmt,
+		reassignTasksAssignedToStmt:                q.reassignTasksAssignedToStmt,
+		reassignTasksCreatedByStmt:                 q.reassignTasksCreatedByStmt,
+		reassignWorkspaceTasksCreatedByToOwnerStmt: q.reassignWorkspaceTasksCreatedByToOwnerStmt,
+		recordLoginFailureStmt:                     q.recordLoginFailureStmt,
+		reparentSubtasksStmt:                       q.reparentSubtasksStmt,
+		respondWorkspaceInvitationStmt:             q.respondWorkspaceInvitationStmt,
+		revokePendingWorkspaceInvitationsStmt:      q.revokePendingWorkspaceInvitationsStmt,
+		revokePersonalAccessTokenStmt:              q.revokePersonalAccessTokenStmt,
+		revokeRefreshTokenFamilyStmt:               q.revokeRefreshTokenFamilyStmt,
+		revokeTokenStmt:                            q.revokeTokenStmt,
+		revokeUserPersonalAccessTokensStmt:         q.revokeUserPersonalAccessTokensStmt,
+		revokeUserRefreshTokensStmt:                q.revokeUserRefreshTokensStmt,
+		saveTOTPCredentialStmt:                     q.saveTOTPCredentialStmt,
+		searchUsersStmt:                            q.searchUsersStmt,
+		setUserDisabledAtStmt:                      q.setUserDisabledAtStmt,
+		setUserTokensRevokedBeforeStmt:             q.setUserTokensRevokedBeforeStmt,
+		syncTaskCompletionInProjectStmt:            q.syncTaskCompletionInProjectStmt,
+		unassignWorkspaceTasksStmt:                 q.unassignWorkspaceTasksStmt,
+		unlockLoginStmt:                            q.unlockLoginStmt,
+		updateProjectStmt:                          q.updateProjectStmt,
+		updateProjectWorkflowStmt:                  q.updateProjectWorkflowStmt,
+		updateTaskStmt:                             q.updateTaskStmt,
+		updateTaskRankStmt:                         q.updateTaskRankStmt,
+		updateUserStmt:                             q.updateUserStmt,
+		updateWorkspaceStmt:                        q.updateWorkspaceStmt,
+		updateWorkspaceMemberRoleStmt:              q.updateWorkspaceMemberRoleStmt,
+		useTOTPRecoveryCodeStmt:                    q.useTOTPRecoveryCodeStmt,
+		useTOTPStepStmt:                            q.useTOTPStepStmt,
 	}
 }
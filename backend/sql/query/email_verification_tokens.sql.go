@@ -0,0 +1,77 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: email_verification_tokens.sql
+
+package query
+
+import (
+	"context"
+	"time"
+)
+
+const createEmailVerificationToken = `-- name: CreateEmailVerificationToken :exec
+
+INSERT INTO email_verification_tokens (id, user_id, email, token_hash, expires_at) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateEmailVerificationTokenParams struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// sql/queries/email_verification_tokens.sql
+func (q *Queries) CreateEmailVerificationToken(ctx context.Context, arg *CreateEmailVerificationTokenParams) error {
+	_, err := q.exec(ctx, q.createEmailVerificationTokenStmt, createEmailVerificationToken,
+		arg.ID,
+		arg.UserID,
+		arg.Email,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const getEmailVerificationTokenByHash = `-- name: GetEmailVerificationTokenByHash :one
+SELECT id, user_id, email, token_hash, expires_at, used_at, created_at FROM email_verification_tokens WHERE token_hash = ? LIMIT 1
+`
+
+func (q *Queries) GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*EmailVerificationToken, error) {
+	row := q.queryRow(ctx, q.getEmailVerificationTokenByHashStmt, getEmailVerificationTokenByHash, tokenHash)
+	var i EmailVerificationToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Email,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const invalidateUserEmailVerificationTokens = `-- name: InvalidateUserEmailVerificationTokens :exec
+UPDATE email_verification_tokens SET used_at = CURRENT_TIMESTAMP WHERE user_id = ? AND used_at IS NULL
+`
+
+func (q *Queries) InvalidateUserEmailVerificationTokens(ctx context.Context, userID string) error {
+	_, err := q.exec(ctx, q.invalidateUserEmailVerificationTokensStmt, invalidateUserEmailVerificationTokens, userID)
+	return err
+}
+
+const markEmailVerificationTokenUsed = `-- name: MarkEmailVerificationTokenUsed :execrows
+UPDATE email_verification_tokens SET used_at = CURRENT_TIMESTAMP WHERE id = ? AND used_at IS NULL
+`
+
+// 未使用の場合のみ使用済みにする (0 行の場合は同時に使用された)
+func (q *Queries) MarkEmailVerificationTokenUsed(ctx context.Context, id string) (int64, error) {
+	result, err := q.exec(ctx, q.markEmailVerificationTokenUsedStmt, markEmailVerificationTokenUsed, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,74 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: login_events.sql
+
+package query
+
+import (
+	"context"
+)
+
+const createLoginEvent = `-- name: CreateLoginEvent :exec
+
+INSERT INTO login_events (id, user_id, result, ip_address, user_agent) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateLoginEventParams struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	Result    string `json:"result"`
+	IpAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+}
+
+// sql/queries/login_events.sql
+func (q *Queries) CreateLoginEvent(ctx context.Context, arg *CreateLoginEventParams) error {
+	_, err := q.exec(ctx, q.createLoginEventStmt, createLoginEvent,
+		arg.ID,
+		arg.UserID,
+		arg.Result,
+		arg.IpAddress,
+		arg.UserAgent,
+	)
+	return err
+}
+
+const listLoginEventsByUser = `-- name: ListLoginEventsByUser :many
+SELECT id, user_id, result, ip_address, user_agent, created_at FROM login_events WHERE user_id = ? ORDER BY created_at DESC, id DESC LIMIT ?
+`
+
+type ListLoginEventsByUserParams struct {
+	UserID string `json:"user_id"`
+	Limit  int32  `json:"limit"`
+}
+
+func (q *Queries) ListLoginEventsByUser(ctx context.Context, arg *ListLoginEventsByUserParams) ([]*LoginEvent, error) {
+	rows, err := q.query(ctx, q.listLoginEventsByUserStmt, listLoginEventsByUser, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*LoginEvent
+	for rows.Next() {
+		var i LoginEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Result,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,101 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: login_throttles.sql
+
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const cancelLoginFailure = `-- name: CancelLoginFailure :exec
+UPDATE login_throttles SET failures = GREATEST(failures - 1, 0) WHERE throttle_key = ?
+`
+
+func (q *Queries) CancelLoginFailure(ctx context.Context, throttleKey string) error {
+	_, err := q.exec(ctx, q.cancelLoginFailureStmt, cancelLoginFailure, throttleKey)
+	return err
+}
+
+const deleteLoginThrottle = `-- name: DeleteLoginThrottle :exec
+DELETE FROM login_throttles WHERE throttle_key = ?
+`
+
+func (q *Queries) DeleteLoginThrottle(ctx context.Context, throttleKey string) error {
+	_, err := q.exec(ctx, q.deleteLoginThrottleStmt, deleteLoginThrottle, throttleKey)
+	return err
+}
+
+const getLoginThrottle = `-- name: GetLoginThrottle :one
+SELECT throttle_key, failures, last_failure_at, locked_until FROM login_throttles WHERE throttle_key = ? LIMIT 1
+`
+
+func (q *Queries) GetLoginThrottle(ctx context.Context, throttleKey string) (*LoginThrottle, error) {
+	row := q.queryRow(ctx, q.getLoginThrottleStmt, getLoginThrottle, throttleKey)
+	var i LoginThrottle
+	err := row.Scan(
+		&i.ThrottleKey,
+		&i.Failures,
+		&i.LastFailureAt,
+		&i.LockedUntil,
+	)
+	return &i, err
+}
+
+const lockLogin = `-- name: LockLogin :execrows
+UPDATE login_throttles SET locked_until = ?
+WHERE throttle_key = ? AND (locked_until IS NULL OR locked_until <= ?)
+`
+
+type LockLoginParams struct {
+	LockedUntil sql.NullTime `json:"locked_until"`
+	ThrottleKey string       `json:"throttle_key"`
+	Now         sql.NullTime `json:"now"`
+}
+
+// ロックされていない場合のみロックする (影響を受けた行数が 0 の場合は他の試行がロック済み)
+func (q *Queries) LockLogin(ctx context.Context, arg *LockLoginParams) (int64, error) {
+	result, err := q.exec(ctx, q.lockLoginStmt, lockLogin, arg.LockedUntil, arg.ThrottleKey, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const recordLoginFailure = `-- name: RecordLoginFailure :execresult
+
+INSERT INTO login_throttles (throttle_key, failures, last_failure_at) VALUES (?, LAST_INSERT_ID(1), ?)
+ON DUPLICATE KEY UPDATE
+    failures = LAST_INSERT_ID(IF(GREATEST(last_failure_at, COALESCE(locked_until, last_failure_at)) < ?, 1, failures + 1)),
+    last_failure_at = ?
+`
+
+type RecordLoginFailureParams struct {
+	ThrottleKey string    `json:"throttle_key"`
+	FailedAt    time.Time `json:"failed_at"`
+	WindowStart time.Time `json:"window_start"`
+}
+
+// sql/queries/login_throttles.sql
+// 前回の失敗 (ロック中の場合はロックの解除) から一定時間が経過している場合は 1 からやり直す
+// 加算後の回数を LAST_INSERT_ID(expr) で設定し、同じ文の結果 (LastInsertId) として返す (他の接続の加算が混ざらない)
+func (q *Queries) RecordLoginFailure(ctx context.Context, arg *RecordLoginFailureParams) (sql.Result, error) {
+	return q.exec(ctx, q.recordLoginFailureStmt, recordLoginFailure,
+		arg.ThrottleKey,
+		arg.FailedAt,
+		arg.WindowStart,
+		arg.FailedAt,
+	)
+}
+
+const unlockLogin = `-- name: UnlockLogin :exec
+UPDATE login_throttles SET locked_until = NULL WHERE throttle_key = ?
+`
+
+func (q *Queries) UnlockLogin(ctx context.Context, throttleKey string) error {
+	_, err := q.exec(ctx, q.unlockLoginStmt, unlockLogin, throttleKey)
+	return err
+}
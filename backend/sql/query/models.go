@@ -6,27 +6,180 @@ package query
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
+type EmailVerificationToken struct {
+	ID        string       `json:"id"`
+	UserID    string       `json:"user_id"`
+	Email     string       `json:"email"`
+	TokenHash string       `json:"token_hash"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type LoginEvent struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Result    string    `json:"result"`
+	IpAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type LoginThrottle struct {
+	ThrottleKey   string       `json:"throttle_key"`
+	Failures      int32        `json:"failures"`
+	LastFailureAt time.Time    `json:"last_failure_at"`
+	LockedUntil   sql.NullTime `json:"locked_until"`
+}
+
+type OidcAuthRequest struct {
+	StateHash    string    `json:"state_hash"`
+	Nonce        string    `json:"nonce"`
+	CodeVerifier string    `json:"code_verifier"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type PasswordResetToken struct {
+	ID        string       `json:"id"`
+	UserID    string       `json:"user_id"`
+	TokenHash string       `json:"token_hash"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type PersonalAccessToken struct {
+	ID          string       `json:"id"`
+	UserID      string       `json:"user_id"`
+	Name        string       `json:"name"`
+	TokenPrefix string       `json:"token_prefix"`
+	TokenHash   string       `json:"token_hash"`
+	Scopes      string       `json:"scopes"`
+	ExpiresAt   time.Time    `json:"expires_at"`
+	RevokedAt   sql.NullTime `json:"revoked_at"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+type Project struct {
+	ID          string          `json:"id"`
+	OwnerID     string          `json:"owner_id"`
+	Name        string          `json:"name"`
+	Description sql.NullString  `json:"description"`
+	ArchivedAt  sql.NullTime    `json:"archived_at"`
+	Workflow    json.RawMessage `json:"workflow"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type RefreshToken struct {
+	ID        string       `json:"id"`
+	UserID    string       `json:"user_id"`
+	FamilyID  string       `json:"family_id"`
+	TokenHash string       `json:"token_hash"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	RevokedAt sql.NullTime `json:"revoked_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type RevokedToken struct {
+	Jti       string    `json:"jti"`
+	UserID    string    `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type Task struct {
-	ID          string         `json:"id"`
-	Title       string         `json:"title"`
-	Description sql.NullString `json:"description"`
-	IsCompleted bool           `json:"is_completed"`
-	UserID      string         `json:"user_id"`
-	AssigneeID  sql.NullString `json:"assignee_id"`
-	Priority    string         `json:"priority"`
-	DueDate     sql.NullTime   `json:"due_date"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
+	ID           string         `json:"id"`
+	Title        string         `json:"title"`
+	Description  sql.NullString `json:"description"`
+	Status       string         `json:"status"`
+	IsCompleted  bool           `json:"is_completed"`
+	UserID       string         `json:"user_id"`
+	WorkspaceID  sql.NullString `json:"workspace_id"`
+	AssigneeID   sql.NullString `json:"assignee_id"`
+	ProjectID    sql.NullString `json:"project_id"`
+	ParentID     sql.NullString `json:"parent_id"`
+	BoardRank    string         `json:"board_rank"`
+	Priority     string         `json:"priority"`
+	DueDate      sql.NullTime   `json:"due_date"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	Version      int64          `json:"version"`
+	PriorityRank int8           `json:"priority_rank"`
+	DueDateSort  time.Time      `json:"due_date_sort"`
+}
+
+type TotpCredential struct {
+	UserID           string       `json:"user_id"`
+	SecretCiphertext []byte       `json:"secret_ciphertext"`
+	ConfirmedAt      sql.NullTime `json:"confirmed_at"`
+	LastUsedStep     int64        `json:"last_used_step"`
+	CreatedAt        time.Time    `json:"created_at"`
+}
+
+type TotpRecoveryCode struct {
+	ID        string       `json:"id"`
+	UserID    string       `json:"user_id"`
+	CodeHash  string       `json:"code_hash"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
 }
 
 type User struct {
+	ID              string       `json:"id"`
+	Name            string       `json:"name"`
+	Email           string       `json:"email"`
+	Password        string       `json:"password"`
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+	EmailVerifiedAt sql.NullTime `json:"email_verified_at"`
+	Role            string       `json:"role"`
+	DisabledAt      sql.NullTime `json:"disabled_at"`
+}
+
+type UserIdentity struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type UserTokenRevocation struct {
+	UserID        string    `json:"user_id"`
+	RevokedBefore time.Time `json:"revoked_before"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type Workspace struct {
 	ID        string    `json:"id"`
 	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Password  string    `json:"password"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+type WorkspaceInvitation struct {
+	ID          string       `json:"id"`
+	WorkspaceID string       `json:"workspace_id"`
+	Email       string       `json:"email"`
+	Role        string       `json:"role"`
+	InvitedBy   string       `json:"invited_by"`
+	Status      string       `json:"status"`
+	ExpiresAt   time.Time    `json:"expires_at"`
+	RespondedAt sql.NullTime `json:"responded_at"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID string    `json:"workspace_id"`
+	UserID      string    `json:"user_id"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
@@ -0,0 +1,73 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: oidc_auth_requests.sql
+
+package query
+
+import (
+	"context"
+	"time"
+)
+
+const createOIDCAuthRequest = `-- name: CreateOIDCAuthRequest :exec
+
+INSERT INTO oidc_auth_requests (state_hash, nonce, code_verifier, expires_at) VALUES (?, ?, ?, ?)
+`
+
+type CreateOIDCAuthRequestParams struct {
+	StateHash    string    `json:"state_hash"`
+	Nonce        string    `json:"nonce"`
+	CodeVerifier string    `json:"code_verifier"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// sql/queries/oidc_auth_requests.sql
+func (q *Queries) CreateOIDCAuthRequest(ctx context.Context, arg *CreateOIDCAuthRequestParams) error {
+	_, err := q.exec(ctx, q.createOIDCAuthRequestStmt, createOIDCAuthRequest,
+		arg.StateHash,
+		arg.Nonce,
+		arg.CodeVerifier,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const deleteExpiredOIDCAuthRequests = `-- name: DeleteExpiredOIDCAuthRequests :exec
+DELETE FROM oidc_auth_requests WHERE expires_at < ?
+`
+
+func (q *Queries) DeleteExpiredOIDCAuthRequests(ctx context.Context, expiresAt time.Time) error {
+	_, err := q.exec(ctx, q.deleteExpiredOIDCAuthRequestsStmt, deleteExpiredOIDCAuthRequests, expiresAt)
+	return err
+}
+
+const deleteOIDCAuthRequest = `-- name: DeleteOIDCAuthRequest :execrows
+DELETE FROM oidc_auth_requests WHERE state_hash = ?
+`
+
+// 0 行の場合は同じ state が同時に使用された
+func (q *Queries) DeleteOIDCAuthRequest(ctx context.Context, stateHash string) (int64, error) {
+	result, err := q.exec(ctx, q.deleteOIDCAuthRequestStmt, deleteOIDCAuthRequest, stateHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getOIDCAuthRequest = `-- name: GetOIDCAuthRequest :one
+SELECT state_hash, nonce, code_verifier, expires_at, created_at FROM oidc_auth_requests WHERE state_hash = ? LIMIT 1
+`
+
+func (q *Queries) GetOIDCAuthRequest(ctx context.Context, stateHash string) (*OidcAuthRequest, error) {
+	row := q.queryRow(ctx, q.getOIDCAuthRequestStmt, getOIDCAuthRequest, stateHash)
+	var i OidcAuthRequest
+	err := row.Scan(
+		&i.StateHash,
+		&i.Nonce,
+		&i.CodeVerifier,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,125 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: personal_access_tokens.sql
+
+package query
+
+import (
+	"context"
+	"time"
+)
+
+const createPersonalAccessToken = `-- name: CreatePersonalAccessToken :exec
+
+INSERT INTO personal_access_tokens (id, user_id, name, token_prefix, token_hash, scopes, expires_at) VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreatePersonalAccessTokenParams struct {
+	ID          string    `json:"id"`
+	UserID      string    `json:"user_id"`
+	Name        string    `json:"name"`
+	TokenPrefix string    `json:"token_prefix"`
+	TokenHash   string    `json:"token_hash"`
+	Scopes      string    `json:"scopes"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// sql/queries/personal_access_tokens.sql
+func (q *Queries) CreatePersonalAccessToken(ctx context.Context, arg *CreatePersonalAccessTokenParams) error {
+	_, err := q.exec(ctx, q.createPersonalAccessTokenStmt, createPersonalAccessToken,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.TokenPrefix,
+		arg.TokenHash,
+		arg.Scopes,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const getPersonalAccessTokenByHash = `-- name: GetPersonalAccessTokenByHash :one
+SELECT id, user_id, name, token_prefix, token_hash, scopes, expires_at, revoked_at, created_at FROM personal_access_tokens WHERE token_hash = ? LIMIT 1
+`
+
+func (q *Queries) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error) {
+	row := q.queryRow(ctx, q.getPersonalAccessTokenByHashStmt, getPersonalAccessTokenByHash, tokenHash)
+	var i PersonalAccessToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.TokenPrefix,
+		&i.TokenHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const listPersonalAccessTokensByUser = `-- name: ListPersonalAccessTokensByUser :many
+SELECT id, user_id, name, token_prefix, token_hash, scopes, expires_at, revoked_at, created_at FROM personal_access_tokens WHERE user_id = ? AND revoked_at IS NULL ORDER BY created_at DESC, id DESC
+`
+
+// 失効済みのトークンは含めない (期限切れのトークンは含める)
+func (q *Queries) ListPersonalAccessTokensByUser(ctx context.Context, userID string) ([]*PersonalAccessToken, error) {
+	rows, err := q.query(ctx, q.listPersonalAccessTokensByUserStmt, listPersonalAccessTokensByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*PersonalAccessToken
+	for rows.Next() {
+		var i PersonalAccessToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.TokenPrefix,
+			&i.TokenHash,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokePersonalAccessToken = `-- name: RevokePersonalAccessToken :execrows
+UPDATE personal_access_tokens SET revoked_at = CURRENT_TIMESTAMP WHERE id = ? AND user_id = ? AND revoked_at IS NULL
+`
+
+type RevokePersonalAccessTokenParams struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) RevokePersonalAccessToken(ctx context.Context, arg *RevokePersonalAccessTokenParams) (int64, error) {
+	result, err := q.exec(ctx, q.revokePersonalAccessTokenStmt, revokePersonalAccessToken, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeUserPersonalAccessTokens = `-- name: RevokeUserPersonalAccessTokens :exec
+UPDATE personal_access_tokens SET revoked_at = CURRENT_TIMESTAMP WHERE user_id = ? AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeUserPersonalAccessTokens(ctx context.Context, userID string) error {
+	_, err := q.exec(ctx, q.revokeUserPersonalAccessTokensStmt, revokeUserPersonalAccessTokens, userID)
+	return err
+}
@@ -0,0 +1,204 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: projects.sql
+
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+)
+
+const createProject = `-- name: CreateProject :exec
+
+INSERT INTO projects (id, owner_id, name, description) VALUES (?, ?, ?, ?)
+`
+
+type CreateProjectParams struct {
+	ID          string         `json:"id"`
+	OwnerID     string         `json:"owner_id"`
+	Name        string         `json:"name"`
+	Description sql.NullString `json:"description"`
+}
+
+// sql/queries/projects.sql
+func (q *Queries) CreateProject(ctx context.Context, arg *CreateProjectParams) error {
+	_, err := q.exec(ctx, q.createProjectStmt, createProject,
+		arg.ID,
+		arg.OwnerID,
+		arg.Name,
+		arg.Description,
+	)
+	return err
+}
+
+const deleteProject = `-- name: DeleteProject :exec
+DELETE FROM projects WHERE id = ?
+`
+
+func (q *Queries) DeleteProject(ctx context.Context, id string) error {
+	_, err := q.exec(ctx, q.deleteProjectStmt, deleteProject, id)
+	return err
+}
+
+const getProjectByID = `-- name: GetProjectByID :one
+SELECT id, owner_id, name, description, archived_at, workflow, created_at, updated_at FROM projects WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetProjectByID(ctx context.Context, id string) (*Project, error) {
+	row := q.queryRow(ctx, q.getProjectByIDStmt, getProjectByID, id)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.Description,
+		&i.ArchivedAt,
+		&i.Workflow,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const listProjectIDsOwnedBy = `-- name: ListProjectIDsOwnedBy :many
+SELECT id FROM projects WHERE owner_id = ?
+`
+
+// ユーザーの削除時に、削除するユーザーのプロジェクト (アーカイブしたものを含む) の ID を返す
+func (q *Queries) ListProjectIDsOwnedBy(ctx context.Context, ownerID string) ([]string, error) {
+	rows, err := q.query(ctx, q.listProjectIDsOwnedByStmt, listProjectIDsOwnedBy, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProjectsByOwner = `-- name: ListProjectsByOwner :many
+SELECT id, owner_id, name, description, archived_at, workflow, created_at, updated_at FROM projects
+WHERE owner_id = ?
+  AND (archived_at IS NULL OR ? = TRUE)
+  AND (? IS NULL
+    OR created_at < ?
+    OR (created_at = ? AND id < ?))
+ORDER BY created_at DESC, id DESC
+LIMIT ?
+`
+
+type ListProjectsByOwnerParams struct {
+	OwnerID         string       `json:"owner_id"`
+	IncludeArchived interface{}  `json:"include_archived"`
+	CursorCreatedAt sql.NullTime `json:"cursor_created_at"`
+	CursorID        string       `json:"cursor_id"`
+	Limit           int32        `json:"limit"`
+}
+
+// 作成日時の新しい順にキーセット方式で 1 ページ分のプロジェクトを返す (最初のページは cursor_created_at に NULL を渡す)。
+// include_archived が偽の場合はアーカイブしたプロジェクトを含めない
+func (q *Queries) ListProjectsByOwner(ctx context.Context, arg *ListProjectsByOwnerParams) ([]*Project, error) {
+	rows, err := q.query(ctx, q.listProjectsByOwnerStmt, listProjectsByOwner,
+		arg.OwnerID,
+		arg.IncludeArchived,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Name,
+			&i.Description,
+			&i.ArchivedAt,
+			&i.Workflow,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reassignProjectsOwnedBy = `-- name: ReassignProjectsOwnedBy :exec
+UPDATE projects SET owner_id = ? WHERE owner_id = ?
+`
+
+type ReassignProjectsOwnedByParams struct {
+	NewOwnerID string `json:"new_owner_id"`
+	OwnerID    string `json:"owner_id"`
+}
+
+// ユーザーの削除時に、削除するユーザーのプロジェクトを引き継ぎ先に移す
+func (q *Queries) ReassignProjectsOwnedBy(ctx context.Context, arg *ReassignProjectsOwnedByParams) error {
+	_, err := q.exec(ctx, q.reassignProjectsOwnedByStmt, reassignProjectsOwnedBy, arg.NewOwnerID, arg.OwnerID)
+	return err
+}
+
+const updateProject = `-- name: UpdateProject :exec
+UPDATE projects SET name = ?, description = ?, archived_at = ? WHERE id = ?
+`
+
+type UpdateProjectParams struct {
+	Name        string         `json:"name"`
+	Description sql.NullString `json:"description"`
+	ArchivedAt  sql.NullTime   `json:"archived_at"`
+	ID          string         `json:"id"`
+}
+
+func (q *Queries) UpdateProject(ctx context.Context, arg *UpdateProjectParams) error {
+	_, err := q.exec(ctx, q.updateProjectStmt, updateProject,
+		arg.Name,
+		arg.Description,
+		arg.ArchivedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateProjectWorkflow = `-- name: UpdateProjectWorkflow :exec
+UPDATE projects SET workflow = ? WHERE id = ?
+`
+
+type UpdateProjectWorkflowParams struct {
+	Workflow json.RawMessage `json:"workflow"`
+	ID       string          `json:"id"`
+}
+
+// workflow に NULL を渡すと既定のワークフローに戻す
+func (q *Queries) UpdateProjectWorkflow(ctx context.Context, arg *UpdateProjectWorkflowParams) error {
+	_, err := q.exec(ctx, q.updateProjectWorkflowStmt, updateProjectWorkflow, arg.Workflow, arg.ID)
+	return err
+}
@@ -6,20 +6,206 @@ package query
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
 type Querier interface {
+	// ユーザーの削除時に、ユーザーが所有者であるワークスペースに new_owner_id のユーザーを所有者として追加する (メンバーの場合は所有者に変更する)
+	AddOwnerToWorkspacesOwnedBy(ctx context.Context, arg *AddOwnerToWorkspacesOwnedByParams) error
+	CancelLoginFailure(ctx context.Context, throttleKey string) error
+	// 未確認の場合のみ確認済みにする (0 行の場合は同時に確認された)
+	ConfirmTOTPCredential(ctx context.Context, arg *ConfirmTOTPCredentialParams) (int64, error)
+	// ids のタスクごとの、子孫の数と完了している子孫の数 (子孫がないタスクは含まない)
+	CountSubtaskProgress(ctx context.Context, ids []sql.NullString) ([]*CountSubtaskProgressRow, error)
+	// 以下はプロジェクトのワークフローの変更時に使用する
+	// 新しいワークフローにない状態 (statuses に含まれない状態) のタスクの数
+	CountTasksInProjectOutsideStatuses(ctx context.Context, arg *CountTasksInProjectOutsideStatusesParams) (int64, error)
+	CountWorkspaceOwners(ctx context.Context, workspaceID string) (int64, error)
+	// sql/queries/email_verification_tokens.sql
+	CreateEmailVerificationToken(ctx context.Context, arg *CreateEmailVerificationTokenParams) error
+	// sql/queries/login_events.sql
+	CreateLoginEvent(ctx context.Context, arg *CreateLoginEventParams) error
+	// sql/queries/oidc_auth_requests.sql
+	CreateOIDCAuthRequest(ctx context.Context, arg *CreateOIDCAuthRequestParams) error
+	// sql/queries/password_reset_tokens.sql
+	CreatePasswordResetToken(ctx context.Context, arg *CreatePasswordResetTokenParams) error
+	// sql/queries/personal_access_tokens.sql
+	CreatePersonalAccessToken(ctx context.Context, arg *CreatePersonalAccessTokenParams) error
+	// sql/queries/projects.sql
+	CreateProject(ctx context.Context, arg *CreateProjectParams) error
+	// sql/queries/refresh_tokens.sql
+	CreateRefreshToken(ctx context.Context, arg *CreateRefreshTokenParams) error
+	CreateTOTPRecoveryCode(ctx context.Context, arg *CreateTOTPRecoveryCodeParams) error
 	// sql/queries/tasks.sql
 	CreateTask(ctx context.Context, arg *CreateTaskParams) error
 	// sql/queries/users.sql
 	CreateUser(ctx context.Context, arg *CreateUserParams) error
-	DeleteTask(ctx context.Context, id string) error
+	// sql/queries/user_identities.sql
+	CreateUserIdentity(ctx context.Context, arg *CreateUserIdentityParams) error
+	// sql/queries/workspaces.sql
+	CreateWorkspace(ctx context.Context, arg *CreateWorkspaceParams) error
+	CreateWorkspaceInvitation(ctx context.Context, arg *CreateWorkspaceInvitationParams) error
+	CreateWorkspaceMember(ctx context.Context, arg *CreateWorkspaceMemberParams) error
+	DeleteExpiredOIDCAuthRequests(ctx context.Context, expiresAt time.Time) error
+	DeleteExpiredRevokedTokens(ctx context.Context, expiresAt time.Time) error
+	DeleteLoginThrottle(ctx context.Context, throttleKey string) error
+	// 0 行の場合は同じ state が同時に使用された
+	DeleteOIDCAuthRequest(ctx context.Context, stateHash string) (int64, error)
+	DeleteProject(ctx context.Context, id string) error
+	DeleteTOTPCredential(ctx context.Context, userID string) error
+	DeleteTask(ctx context.Context, arg *DeleteTaskParams) (int64, error)
+	// ワークスペースに属さない個人のタスクのみ削除する (ワークスペースのタスクは ReassignWorkspaceTasksCreatedByToOwner で引き継ぐ)
+	DeleteTasksCreatedBy(ctx context.Context, userID string) error
+	DeleteTasksInProject(ctx context.Context, projectID sql.NullString) error
+	DeleteUser(ctx context.Context, id string) error
+	DeleteUserTOTPRecoveryCodes(ctx context.Context, userID string) error
+	// メンバー・招待・タスクはワークスペースの削除に連動して削除される (ON DELETE CASCADE)
+	DeleteWorkspace(ctx context.Context, id string) error
+	DeleteWorkspaceMember(ctx context.Context, arg *DeleteWorkspaceMemberParams) error
+	// 移動先のワークフローにない状態 (statuses に含まれない状態) のタスクを、完了していたかどうかに応じて
+	// done_status または initial_status にする (is_completed は変わらない)
+	FallbackTaskStatusesInProject(ctx context.Context, arg *FallbackTaskStatusesInProjectParams) error
+	GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*EmailVerificationToken, error)
+	// 以下はボードの列 (状態が同じで、プロジェクト、プロジェクトなしの場合はワークスペースか作成者が同じタスク) の
+	// 並び順を扱う。列に含まない側の project_id / workspace_id / owner_id には NULL を渡す (<=> で NULL 同士も一致させる)。
+	// exclude_id のタスク (移動するタスク) は列に含めない。
+	GetLastRankInColumn(ctx context.Context, arg *GetLastRankInColumnParams) (string, error)
+	GetLoginThrottle(ctx context.Context, throttleKey string) (*LoginThrottle, error)
+	GetOIDCAuthRequest(ctx context.Context, stateHash string) (*OidcAuthRequest, error)
+	GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error)
+	GetProjectByID(ctx context.Context, id string) (*Project, error)
+	// (board_rank, id) の位置より後ろにある、最も近いタスクのキー
+	GetRankAfterInColumn(ctx context.Context, arg *GetRankAfterInColumnParams) (string, error)
+	// (board_rank, id) の位置より前にある、最も近いタスクのキー
+	GetRankBeforeInColumn(ctx context.Context, arg *GetRankBeforeInColumnParams) (string, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	GetTOTPCredential(ctx context.Context, userID string) (*TotpCredential, error)
 	GetTaskByID(ctx context.Context, id string) (*Task, error)
+	// 親の変更やサブタスクのあるタスクの削除の間、タスクの階層が変わらないよう行をロックする
+	GetTaskByIDForUpdate(ctx context.Context, id string) (*Task, error)
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
 	GetUserByID(ctx context.Context, id string) (*User, error)
-	ListTasks(ctx context.Context, userID string) ([]*Task, error)
-	UpdateTask(ctx context.Context, arg *UpdateTaskParams) error
+	GetUserIdentity(ctx context.Context, arg *GetUserIdentityParams) (*UserIdentity, error)
+	GetUserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error)
+	GetWorkspaceByID(ctx context.Context, id string) (*Workspace, error)
+	GetWorkspaceInvitationByID(ctx context.Context, id string) (*WorkspaceInvitation, error)
+	GetWorkspaceMember(ctx context.Context, arg *GetWorkspaceMemberParams) (*WorkspaceMember, error)
+	InvalidateUserEmailVerificationTokens(ctx context.Context, userID string) error
+	InvalidateUserPasswordResetTokens(ctx context.Context, userID string) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	ListLoginEventsByUser(ctx context.Context, arg *ListLoginEventsByUserParams) ([]*LoginEvent, error)
+	// ワークスペースの有効期限内の未回答の招待を新しい順に返す
+	ListPendingWorkspaceInvitations(ctx context.Context, workspaceID string) ([]*WorkspaceInvitation, error)
+	// メールアドレス宛ての有効期限内の未回答の招待を、ワークスペースの名前とともに新しい順に返す
+	ListPendingWorkspaceInvitationsByEmail(ctx context.Context, email string) ([]*ListPendingWorkspaceInvitationsByEmailRow, error)
+	// 失効済みのトークンは含めない (期限切れのトークンは含める)
+	ListPersonalAccessTokensByUser(ctx context.Context, userID string) ([]*PersonalAccessToken, error)
+	// ユーザーの削除時に、削除するユーザーのプロジェクト (アーカイブしたものを含む) の ID を返す
+	ListProjectIDsOwnedBy(ctx context.Context, ownerID string) ([]string, error)
+	// 作成日時の新しい順にキーセット方式で 1 ページ分のプロジェクトを返す (最初のページは cursor_created_at に NULL を渡す)。
+	// include_archived が偽の場合はアーカイブしたプロジェクトを含めない
+	ListProjectsByOwner(ctx context.Context, arg *ListProjectsByOwnerParams) ([]*Project, error)
+	// 以下はサブタスク (parent_id による階層) を扱う。階層は MaxTaskDepth 段までのため再帰の回数は限られる
+	ListSubtasks(ctx context.Context, parentID sql.NullString) ([]*Task, error)
+	// parent_ids のタスクの子をロックして返す (parent_id のインデックスもロックするため、子の追加も待たせる)
+	ListSubtasksForUpdate(ctx context.Context, parentIds []sql.NullString) ([]*Task, error)
+	// root_id のタスクの子孫すべて (root_id のタスク自身は含まない)
+	ListTaskDescendants(ctx context.Context, rootID sql.NullString) ([]*ListTaskDescendantsRow, error)
+	// キーを振り直すため、列のタスクを並び順にロックして返す
+	ListTaskIDsInColumn(ctx context.Context, arg *ListTaskIDsInColumnParams) ([]string, error)
+	// ListTasksBy* はキーセット方式で 1 ページ分のタスクを返す。
+	// cursor_* には直前のページの最後のタスクの値を渡す (最初のページは NULL)。
+	// 一覧の範囲 (作成者・担当者・ワークスペース) ごとに、範囲の列から始まるインデックスの順に 1 ページ分を読む副問い合わせを
+	// UNION ALL でまとめ、外側で並べ直して 1 ページ分に絞る (OR で範囲をまとめるとインデックスで並べられず filesort になるため)。
+	// LIMIT の ? (Limit, Limit_2, ...) にはすべて同じページの件数を渡す。
+	// creator_id / assigned_to / in_workspace は一覧の範囲を表し、対象外のものには NULL を渡す (NULL との比較は常に偽になるため、
+	// その副問い合わせは行を返さない)。作成者と担当者の両方を指定した場合、自分が作成して担当しているタスクは作成者の側だけで返す。
+	// ワークスペースのタスクは member_id のユーザーがメンバーであるワークスペースのものだけを返す
+	// (副問い合わせでは workspace_members と区別するため、列名に tasks. を付ける)。
+	// 範囲・メンバーの確認・絞り込みの条件は 5 つのクエリで同じ行にし、カーソルの条件と ORDER BY だけを変える
+	// (internal/adapter/repository/mysql/task_list_test.go で確認する。TEST_DB_DSN を指定すると同じタスクでの結果も確認する)。
+	ListTasksByCreatedAt(ctx context.Context, arg *ListTasksByCreatedAtParams) ([]*Task, error)
+	ListTasksByDueDate(ctx context.Context, arg *ListTasksByDueDateParams) ([]*Task, error)
+	ListTasksByPriority(ctx context.Context, arg *ListTasksByPriorityParams) ([]*Task, error)
+	ListTasksByRank(ctx context.Context, arg *ListTasksByRankParams) ([]*Task, error)
+	ListTasksByUpdatedAt(ctx context.Context, arg *ListTasksByUpdatedAtParams) ([]*Task, error)
+	// 作成日時の新しい順にキーセット方式で 1 ページ分のユーザーを返す (最初のページは cursor_created_at に NULL を渡す)。
+	// pattern は名前またはメールアドレスの部分一致 (LIKE のパターン、絞り込まない場合は NULL)。
+	ListUsers(ctx context.Context, arg *ListUsersParams) ([]*User, error)
+	// メンバーを参加した順に、ユーザーの名前とメールアドレスとともに返す
+	ListWorkspaceMembers(ctx context.Context, workspaceID string) ([]*ListWorkspaceMembersRow, error)
+	// ユーザーがメンバーであるワークスペースを、ユーザーの役割とともに名前順に返す
+	ListWorkspacesByMember(ctx context.Context, userID string) ([]*ListWorkspacesByMemberRow, error)
+	// ユーザーが唯一の所有者であるワークスペースの ID を返す (ユーザーの削除前の確認に使う)
+	ListWorkspacesSolelyOwnedBy(ctx context.Context, userID string) ([]string, error)
+	// ロックされていない場合のみロックする (影響を受けた行数が 0 の場合は他の試行がロック済み)
+	LockLogin(ctx context.Context, arg *LockLoginParams) (int64, error)
+	// 未使用の場合のみ使用済みにする (0 行の場合は同時に使用された)
+	MarkEmailVerificationTokenUsed(ctx context.Context, id string) (int64, error)
+	// 未使用の場合のみ使用済みにする (0 行の場合は同時に使用された)
+	MarkPasswordResetTokenUsed(ctx context.Context, id string) (int64, error)
+	// 未使用かつ未失効の場合のみ使用済みにする (0 行の場合は同時に使用された)
+	MarkRefreshTokenUsed(ctx context.Context, id string) (int64, error)
+	// 確認したメールアドレスが現在のものと一致する場合のみ確認済みにする
+	MarkUserEmailVerified(ctx context.Context, arg *MarkUserEmailVerifiedParams) (int64, error)
+	// 以下はプロジェクトの削除時に、削除するプロジェクトのタスクを整理する
+	// new_project_id に NULL を渡すとプロジェクトから外す
+	MoveTasksToProject(ctx context.Context, arg *MoveTasksToProjectParams) error
+	// ユーザーの削除時に、削除するユーザーのプロジェクトを引き継ぎ先に移す
+	ReassignProjectsOwnedBy(ctx context.Context, arg *ReassignProjectsOwnedByParams) error
+	// new_assignee_id に NULL を渡すと担当者なしにする。new_assignee_id のユーザーがメンバーでないワークスペースのタスクは変更しない
+	ReassignTasksAssignedTo(ctx context.Context, arg *ReassignTasksAssignedToParams) error
+	// 以下はユーザーの削除時に、削除するユーザーを参照しているタスクを整理する (version を進めて編集中のクライアントに競合を伝える)
+	// 個人のタスクと、new_user_id のユーザーがメンバーであるワークスペースのタスクの作成者を new_user_id のユーザーにする
+	// (それ以外のワークスペースのタスクは ReassignWorkspaceTasksCreatedByToOwner で引き継ぐ)
+	ReassignTasksCreatedBy(ctx context.Context, arg *ReassignTasksCreatedByParams) error
+	// ワークスペースのタスクの作成者を、そのワークスペースに残る owner のうち最も古くから参加しているユーザーにする
+	// (残る owner がいない場合は user_id が NULL になりエラーにするため、呼び出し前に確認する)
+	ReassignWorkspaceTasksCreatedByToOwner(ctx context.Context, arg *ReassignWorkspaceTasksCreatedByToOwnerParams) error
+	// sql/queries/login_throttles.sql
+	// 前回の失敗 (ロック中の場合はロックの解除) から一定時間が経過している場合は 1 からやり直す
+	// 加算後の回数を LAST_INSERT_ID(expr) で設定し、同じ文の結果 (LastInsertId) として返す (他の接続の加算が混ざらない)
+	RecordLoginFailure(ctx context.Context, arg *RecordLoginFailureParams) (sql.Result, error)
+	// new_parent_id に NULL を渡すと親なしにする
+	ReparentSubtasks(ctx context.Context, arg *ReparentSubtasksParams) error
+	// 未回答の招待のみ状態を変更する (0 行の場合は回答済みか取り消し済み)
+	RespondWorkspaceInvitation(ctx context.Context, arg *RespondWorkspaceInvitationParams) (int64, error)
+	// 同じメールアドレスへの未回答の招待を取り消す (招待し直すときに使う)
+	RevokePendingWorkspaceInvitations(ctx context.Context, arg *RevokePendingWorkspaceInvitationsParams) error
+	RevokePersonalAccessToken(ctx context.Context, arg *RevokePersonalAccessTokenParams) (int64, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	// sql/queries/token_revocations.sql
+	RevokeToken(ctx context.Context, arg *RevokeTokenParams) error
+	RevokeUserPersonalAccessTokens(ctx context.Context, userID string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+	// sql/queries/totp.sql
+	// 未確認の登録がある場合は新しいシークレットで置き換える
+	SaveTOTPCredential(ctx context.Context, arg *SaveTOTPCredentialParams) error
+	// caller_id のユーザーと同じワークスペースのメンバーのうち、名前が prefix で始まるか、メールアドレスが email と一致する
+	// 有効なユーザーを名前順に返す (prefix には末尾に % を付けた LIKE のパターンを渡す)
+	SearchUsers(ctx context.Context, arg *SearchUsersParams) ([]*User, error)
+	SetUserDisabledAt(ctx context.Context, arg *SetUserDisabledAtParams) error
+	SetUserTokensRevokedBefore(ctx context.Context, arg *SetUserTokensRevokedBeforeParams) error
+	// 完了として扱う状態が変わったタスクの is_completed を反転する
+	SyncTaskCompletionInProject(ctx context.Context, arg *SyncTaskCompletionInProjectParams) error
+	// ワークスペースから外れたメンバーを、そのワークスペースのタスクの担当者から外す
+	UnassignWorkspaceTasks(ctx context.Context, arg *UnassignWorkspaceTasksParams) error
+	UnlockLogin(ctx context.Context, throttleKey string) error
+	UpdateProject(ctx context.Context, arg *UpdateProjectParams) error
+	// workflow に NULL を渡すと既定のワークフローに戻す
+	UpdateProjectWorkflow(ctx context.Context, arg *UpdateProjectWorkflowParams) error
+	// UpdateTask / DeleteTask は読み込んだ時点の version と一致する場合のみ行を変更する (0 行なら競合)
+	UpdateTask(ctx context.Context, arg *UpdateTaskParams) (int64, error)
+	UpdateTaskRank(ctx context.Context, arg *UpdateTaskRankParams) error
 	UpdateUser(ctx context.Context, arg *UpdateUserParams) error
+	UpdateWorkspace(ctx context.Context, arg *UpdateWorkspaceParams) error
+	UpdateWorkspaceMemberRole(ctx context.Context, arg *UpdateWorkspaceMemberRoleParams) error
+	// 未使用の場合のみ使用済みにする (0 行の場合は存在しないか使用済み)
+	UseTOTPRecoveryCode(ctx context.Context, arg *UseTOTPRecoveryCodeParams) (int64, error)
+	// 最後に使用したコードより新しい場合のみ更新する (0 行の場合は同じコードが再使用された)
+	UseTOTPStep(ctx context.Context, arg *UseTOTPStepParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
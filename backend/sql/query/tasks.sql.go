@@ -8,21 +8,108 @@ package query
 import (
 	"context"
 	"database/sql"
+	"strings"
+	"time"
 )
 
+const countSubtaskProgress = `-- name: CountSubtaskProgress :many
+WITH RECURSIVE descendants AS (
+  SELECT tasks.parent_id AS root_id, tasks.id, tasks.is_completed FROM tasks WHERE tasks.parent_id IN (/*SLICE:ids*/?)
+  UNION ALL
+  SELECT d.root_id, t.id, t.is_completed FROM tasks t JOIN descendants d ON t.parent_id = d.id
+)
+SELECT root_id, CAST(COUNT(*) AS SIGNED) AS total, CAST(COALESCE(SUM(is_completed), 0) AS SIGNED) AS completed
+FROM descendants GROUP BY root_id
+`
+
+type CountSubtaskProgressRow struct {
+	RootID    sql.NullString `json:"root_id"`
+	Total     int64          `json:"total"`
+	Completed int64          `json:"completed"`
+}
+
+// ids のタスクごとの、子孫の数と完了している子孫の数 (子孫がないタスクは含まない)
+func (q *Queries) CountSubtaskProgress(ctx context.Context, ids []sql.NullString) ([]*CountSubtaskProgressRow, error) {
+	query := countSubtaskProgress
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.query(ctx, nil, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CountSubtaskProgressRow
+	for rows.Next() {
+		var i CountSubtaskProgressRow
+		if err := rows.Scan(&i.RootID, &i.Total, &i.Completed); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countTasksInProjectOutsideStatuses = `-- name: CountTasksInProjectOutsideStatuses :one
+
+SELECT COUNT(*) FROM tasks WHERE project_id = ? AND status NOT IN (/*SLICE:statuses*/?)
+`
+
+type CountTasksInProjectOutsideStatusesParams struct {
+	ProjectID sql.NullString `json:"project_id"`
+	Statuses  []string       `json:"statuses"`
+}
+
+// 以下はプロジェクトのワークフローの変更時に使用する
+// 新しいワークフローにない状態 (statuses に含まれない状態) のタスクの数
+func (q *Queries) CountTasksInProjectOutsideStatuses(ctx context.Context, arg *CountTasksInProjectOutsideStatusesParams) (int64, error) {
+	query := countTasksInProjectOutsideStatuses
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.ProjectID)
+	if len(arg.Statuses) > 0 {
+		for _, v := range arg.Statuses {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:statuses*/?", strings.Repeat(",?", len(arg.Statuses))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:statuses*/?", "NULL", 1)
+	}
+	row := q.queryRow(ctx, nil, query, queryParams...)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createTask = `-- name: CreateTask :exec
 
-INSERT INTO tasks (id, title, description, is_completed, user_id, assignee_id, priority, due_date)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO tasks (id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateTaskParams struct {
 	ID          string         `json:"id"`
 	Title       string         `json:"title"`
 	Description sql.NullString `json:"description"`
+	Status      string         `json:"status"`
 	IsCompleted bool           `json:"is_completed"`
 	UserID      string         `json:"user_id"`
+	WorkspaceID sql.NullString `json:"workspace_id"`
 	AssigneeID  sql.NullString `json:"assignee_id"`
+	ProjectID   sql.NullString `json:"project_id"`
+	ParentID    sql.NullString `json:"parent_id"`
+	BoardRank   string         `json:"board_rank"`
 	Priority    string         `json:"priority"`
 	DueDate     sql.NullTime   `json:"due_date"`
 }
@@ -33,26 +120,201 @@ func (q *Queries) CreateTask(ctx context.Context, arg *CreateTaskParams) error {
 		arg.ID,
 		arg.Title,
 		arg.Description,
+		arg.Status,
 		arg.IsCompleted,
 		arg.UserID,
+		arg.WorkspaceID,
 		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ParentID,
+		arg.BoardRank,
 		arg.Priority,
 		arg.DueDate,
 	)
 	return err
 }
 
-const deleteTask = `-- name: DeleteTask :exec
-DELETE FROM tasks WHERE id = ?
+const deleteTask = `-- name: DeleteTask :execrows
+DELETE FROM tasks WHERE id = ? AND version = ?
+`
+
+type DeleteTaskParams struct {
+	ID      string `json:"id"`
+	Version int64  `json:"version"`
+}
+
+func (q *Queries) DeleteTask(ctx context.Context, arg *DeleteTaskParams) (int64, error) {
+	result, err := q.exec(ctx, q.deleteTaskStmt, deleteTask, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTasksCreatedBy = `-- name: DeleteTasksCreatedBy :exec
+DELETE FROM tasks WHERE user_id = ? AND workspace_id IS NULL
+`
+
+// ワークスペースに属さない個人のタスクのみ削除する (ワークスペースのタスクは ReassignWorkspaceTasksCreatedByToOwner で引き継ぐ)
+func (q *Queries) DeleteTasksCreatedBy(ctx context.Context, userID string) error {
+	_, err := q.exec(ctx, q.deleteTasksCreatedByStmt, deleteTasksCreatedBy, userID)
+	return err
+}
+
+const deleteTasksInProject = `-- name: DeleteTasksInProject :exec
+DELETE FROM tasks WHERE project_id = ?
+`
+
+func (q *Queries) DeleteTasksInProject(ctx context.Context, projectID sql.NullString) error {
+	_, err := q.exec(ctx, q.deleteTasksInProjectStmt, deleteTasksInProject, projectID)
+	return err
+}
+
+const fallbackTaskStatusesInProject = `-- name: FallbackTaskStatusesInProject :exec
+UPDATE tasks SET status = IF(is_completed, ?, ?), version = version + 1
+WHERE project_id = ? AND status NOT IN (/*SLICE:statuses*/?)
 `
 
-func (q *Queries) DeleteTask(ctx context.Context, id string) error {
-	_, err := q.exec(ctx, q.deleteTaskStmt, deleteTask, id)
+type FallbackTaskStatusesInProjectParams struct {
+	DoneStatus    interface{}    `json:"done_status"`
+	InitialStatus interface{}    `json:"initial_status"`
+	ProjectID     sql.NullString `json:"project_id"`
+	Statuses      []string       `json:"statuses"`
+}
+
+// 移動先のワークフローにない状態 (statuses に含まれない状態) のタスクを、完了していたかどうかに応じて
+// done_status または initial_status にする (is_completed は変わらない)
+func (q *Queries) FallbackTaskStatusesInProject(ctx context.Context, arg *FallbackTaskStatusesInProjectParams) error {
+	query := fallbackTaskStatusesInProject
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.DoneStatus)
+	queryParams = append(queryParams, arg.InitialStatus)
+	queryParams = append(queryParams, arg.ProjectID)
+	if len(arg.Statuses) > 0 {
+		for _, v := range arg.Statuses {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:statuses*/?", strings.Repeat(",?", len(arg.Statuses))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:statuses*/?", "NULL", 1)
+	}
+	_, err := q.exec(ctx, nil, query, queryParams...)
 	return err
 }
 
+const getLastRankInColumn = `-- name: GetLastRankInColumn :one
+
+SELECT board_rank FROM tasks
+WHERE status = ? AND project_id <=> ? AND workspace_id <=> ?
+  AND (? IS NULL OR user_id = ?)
+  AND id <> ?
+ORDER BY board_rank DESC, id DESC
+LIMIT 1
+`
+
+type GetLastRankInColumnParams struct {
+	Status      string         `json:"status"`
+	ProjectID   sql.NullString `json:"project_id"`
+	WorkspaceID sql.NullString `json:"workspace_id"`
+	OwnerID     sql.NullString `json:"owner_id"`
+	ExcludeID   string         `json:"exclude_id"`
+}
+
+// 以下はボードの列 (状態が同じで、プロジェクト、プロジェクトなしの場合はワークスペースか作成者が同じタスク) の
+// 並び順を扱う。列に含まない側の project_id / workspace_id / owner_id には NULL を渡す (<=> で NULL 同士も一致させる)。
+// exclude_id のタスク (移動するタスク) は列に含めない。
+func (q *Queries) GetLastRankInColumn(ctx context.Context, arg *GetLastRankInColumnParams) (string, error) {
+	row := q.queryRow(ctx, q.getLastRankInColumnStmt, getLastRankInColumn,
+		arg.Status,
+		arg.ProjectID,
+		arg.WorkspaceID,
+		arg.OwnerID,
+		arg.OwnerID,
+		arg.ExcludeID,
+	)
+	var board_rank string
+	err := row.Scan(&board_rank)
+	return board_rank, err
+}
+
+const getRankAfterInColumn = `-- name: GetRankAfterInColumn :one
+SELECT board_rank FROM tasks
+WHERE status = ? AND project_id <=> ? AND workspace_id <=> ?
+  AND (? IS NULL OR user_id = ?)
+  AND id <> ?
+  AND (board_rank > ? OR (board_rank = ? AND id > ?))
+ORDER BY board_rank ASC, id ASC
+LIMIT 1
+`
+
+type GetRankAfterInColumnParams struct {
+	Status      string         `json:"status"`
+	ProjectID   sql.NullString `json:"project_id"`
+	WorkspaceID sql.NullString `json:"workspace_id"`
+	OwnerID     sql.NullString `json:"owner_id"`
+	ExcludeID   string         `json:"exclude_id"`
+	AnchorRank  string         `json:"anchor_rank"`
+	AnchorID    string         `json:"anchor_id"`
+}
+
+// (board_rank, id) の位置より後ろにある、最も近いタスクのキー
+func (q *Queries) GetRankAfterInColumn(ctx context.Context, arg *GetRankAfterInColumnParams) (string, error) {
+	row := q.queryRow(ctx, q.getRankAfterInColumnStmt, getRankAfterInColumn,
+		arg.Status,
+		arg.ProjectID,
+		arg.WorkspaceID,
+		arg.OwnerID,
+		arg.OwnerID,
+		arg.ExcludeID,
+		arg.AnchorRank,
+		arg.AnchorRank,
+		arg.AnchorID,
+	)
+	var board_rank string
+	err := row.Scan(&board_rank)
+	return board_rank, err
+}
+
+const getRankBeforeInColumn = `-- name: GetRankBeforeInColumn :one
+SELECT board_rank FROM tasks
+WHERE status = ? AND project_id <=> ? AND workspace_id <=> ?
+  AND (? IS NULL OR user_id = ?)
+  AND id <> ?
+  AND (board_rank < ? OR (board_rank = ? AND id < ?))
+ORDER BY board_rank DESC, id DESC
+LIMIT 1
+`
+
+type GetRankBeforeInColumnParams struct {
+	Status      string         `json:"status"`
+	ProjectID   sql.NullString `json:"project_id"`
+	WorkspaceID sql.NullString `json:"workspace_id"`
+	OwnerID     sql.NullString `json:"owner_id"`
+	ExcludeID   string         `json:"exclude_id"`
+	AnchorRank  string         `json:"anchor_rank"`
+	AnchorID    string         `json:"anchor_id"`
+}
+
+// (board_rank, id) の位置より前にある、最も近いタスクのキー
+func (q *Queries) GetRankBeforeInColumn(ctx context.Context, arg *GetRankBeforeInColumnParams) (string, error) {
+	row := q.queryRow(ctx, q.getRankBeforeInColumnStmt, getRankBeforeInColumn,
+		arg.Status,
+		arg.ProjectID,
+		arg.WorkspaceID,
+		arg.OwnerID,
+		arg.OwnerID,
+		arg.ExcludeID,
+		arg.AnchorRank,
+		arg.AnchorRank,
+		arg.AnchorID,
+	)
+	var board_rank string
+	err := row.Scan(&board_rank)
+	return board_rank, err
+}
+
 const getTaskByID = `-- name: GetTaskByID :one
-SELECT id, title, description, is_completed, user_id, assignee_id, priority, due_date, created_at, updated_at FROM tasks WHERE id = ? LIMIT 1
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetTaskByID(ctx context.Context, id string) (*Task, error) {
@@ -62,23 +324,64 @@ func (q *Queries) GetTaskByID(ctx context.Context, id string) (*Task, error) {
 		&i.ID,
 		&i.Title,
 		&i.Description,
+		&i.Status,
+		&i.IsCompleted,
+		&i.UserID,
+		&i.WorkspaceID,
+		&i.AssigneeID,
+		&i.ProjectID,
+		&i.ParentID,
+		&i.BoardRank,
+		&i.Priority,
+		&i.DueDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.PriorityRank,
+		&i.DueDateSort,
+	)
+	return &i, err
+}
+
+const getTaskByIDForUpdate = `-- name: GetTaskByIDForUpdate :one
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks WHERE id = ? LIMIT 1 FOR UPDATE
+`
+
+// 親の変更やサブタスクのあるタスクの削除の間、タスクの階層が変わらないよう行をロックする
+func (q *Queries) GetTaskByIDForUpdate(ctx context.Context, id string) (*Task, error) {
+	row := q.queryRow(ctx, q.getTaskByIDForUpdateStmt, getTaskByIDForUpdate, id)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Status,
 		&i.IsCompleted,
 		&i.UserID,
+		&i.WorkspaceID,
 		&i.AssigneeID,
+		&i.ProjectID,
+		&i.ParentID,
+		&i.BoardRank,
 		&i.Priority,
 		&i.DueDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PriorityRank,
+		&i.DueDateSort,
 	)
 	return &i, err
 }
 
-const listTasks = `-- name: ListTasks :many
-SELECT id, title, description, is_completed, user_id, assignee_id, priority, due_date, created_at, updated_at FROM tasks WHERE user_id = ? ORDER BY created_at DESC
+const listSubtasks = `-- name: ListSubtasks :many
+
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks WHERE parent_id = ? ORDER BY created_at ASC, id ASC
 `
 
-func (q *Queries) ListTasks(ctx context.Context, userID string) ([]*Task, error) {
-	rows, err := q.query(ctx, q.listTasksStmt, listTasks, userID)
+// 以下はサブタスク (parent_id による階層) を扱う。階層は MaxTaskDepth 段までのため再帰の回数は限られる
+func (q *Queries) ListSubtasks(ctx context.Context, parentID sql.NullString) ([]*Task, error) {
+	rows, err := q.query(ctx, q.listSubtasksStmt, listSubtasks, parentID)
 	if err != nil {
 		return nil, err
 	}
@@ -90,13 +393,21 @@ func (q *Queries) ListTasks(ctx context.Context, userID string) ([]*Task, error)
 			&i.ID,
 			&i.Title,
 			&i.Description,
+			&i.Status,
 			&i.IsCompleted,
 			&i.UserID,
+			&i.WorkspaceID,
 			&i.AssigneeID,
+			&i.ProjectID,
+			&i.ParentID,
+			&i.BoardRank,
 			&i.Priority,
 			&i.DueDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.PriorityRank,
+			&i.DueDateSort,
 		); err != nil {
 			return nil, err
 		}
@@ -111,30 +422,1320 @@ func (q *Queries) ListTasks(ctx context.Context, userID string) ([]*Task, error)
 	return items, nil
 }
 
-const updateTask = `-- name: UpdateTask :exec
-UPDATE tasks SET title = ?, description = ?, is_completed = ?, assignee_id = ?, priority = ?, due_date = ?
-WHERE id = ?
+const listSubtasksForUpdate = `-- name: ListSubtasksForUpdate :many
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks WHERE parent_id IN (/*SLICE:parent_ids*/?) ORDER BY created_at ASC, id ASC FOR UPDATE
 `
 
-type UpdateTaskParams struct {
-	Title       string         `json:"title"`
-	Description sql.NullString `json:"description"`
-	IsCompleted bool           `json:"is_completed"`
-	AssigneeID  sql.NullString `json:"assignee_id"`
-	Priority    string         `json:"priority"`
-	DueDate     sql.NullTime   `json:"due_date"`
-	ID          string         `json:"id"`
+// parent_ids のタスクの子をロックして返す (parent_id のインデックスもロックするため、子の追加も待たせる)
+func (q *Queries) ListSubtasksForUpdate(ctx context.Context, parentIds []sql.NullString) ([]*Task, error) {
+	query := listSubtasksForUpdate
+	var queryParams []interface{}
+	if len(parentIds) > 0 {
+		for _, v := range parentIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:parent_ids*/?", strings.Repeat(",?", len(parentIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:parent_ids*/?", "NULL", 1)
+	}
+	rows, err := q.query(ctx, nil, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.IsCompleted,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.AssigneeID,
+			&i.ProjectID,
+			&i.ParentID,
+			&i.BoardRank,
+			&i.Priority,
+			&i.DueDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PriorityRank,
+			&i.DueDateSort,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) UpdateTask(ctx context.Context, arg *UpdateTaskParams) error {
-	_, err := q.exec(ctx, q.updateTaskStmt, updateTask,
-		arg.Title,
-		arg.Description,
+const listTaskDescendants = `-- name: ListTaskDescendants :many
+WITH RECURSIVE descendants AS (
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks WHERE tasks.parent_id = ?
+  UNION ALL
+  SELECT t.id, t.title, t.description, t.status, t.is_completed, t.user_id, t.workspace_id, t.assignee_id, t.project_id, t.parent_id, t.board_rank, t.priority, t.due_date, t.created_at, t.updated_at, t.version, t.priority_rank, t.due_date_sort FROM tasks t JOIN descendants d ON t.parent_id = d.id
+)
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM descendants ORDER BY created_at ASC, id ASC
+`
+
+type ListTaskDescendantsRow struct {
+	ID           string         `json:"id"`
+	Title        string         `json:"title"`
+	Description  sql.NullString `json:"description"`
+	Status       string         `json:"status"`
+	IsCompleted  bool           `json:"is_completed"`
+	UserID       string         `json:"user_id"`
+	WorkspaceID  sql.NullString `json:"workspace_id"`
+	AssigneeID   sql.NullString `json:"assignee_id"`
+	ProjectID    sql.NullString `json:"project_id"`
+	ParentID     sql.NullString `json:"parent_id"`
+	BoardRank    string         `json:"board_rank"`
+	Priority     string         `json:"priority"`
+	DueDate      sql.NullTime   `json:"due_date"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	Version      int64          `json:"version"`
+	PriorityRank int8           `json:"priority_rank"`
+	DueDateSort  time.Time      `json:"due_date_sort"`
+}
+
+// root_id のタスクの子孫すべて (root_id のタスク自身は含まない)
+func (q *Queries) ListTaskDescendants(ctx context.Context, rootID sql.NullString) ([]*ListTaskDescendantsRow, error) {
+	rows, err := q.query(ctx, q.listTaskDescendantsStmt, listTaskDescendants, rootID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTaskDescendantsRow
+	for rows.Next() {
+		var i ListTaskDescendantsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.IsCompleted,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.AssigneeID,
+			&i.ProjectID,
+			&i.ParentID,
+			&i.BoardRank,
+			&i.Priority,
+			&i.DueDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PriorityRank,
+			&i.DueDateSort,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTaskIDsInColumn = `-- name: ListTaskIDsInColumn :many
+SELECT id FROM tasks
+WHERE status = ? AND project_id <=> ? AND workspace_id <=> ?
+  AND (? IS NULL OR user_id = ?)
+  AND id <> ?
+ORDER BY board_rank ASC, id ASC
+FOR UPDATE
+`
+
+type ListTaskIDsInColumnParams struct {
+	Status      string         `json:"status"`
+	ProjectID   sql.NullString `json:"project_id"`
+	WorkspaceID sql.NullString `json:"workspace_id"`
+	OwnerID     sql.NullString `json:"owner_id"`
+	ExcludeID   string         `json:"exclude_id"`
+}
+
+// キーを振り直すため、列のタスクを並び順にロックして返す
+func (q *Queries) ListTaskIDsInColumn(ctx context.Context, arg *ListTaskIDsInColumnParams) ([]string, error) {
+	rows, err := q.query(ctx, q.listTaskIDsInColumnStmt, listTaskIDsInColumn,
+		arg.Status,
+		arg.ProjectID,
+		arg.WorkspaceID,
+		arg.OwnerID,
+		arg.OwnerID,
+		arg.ExcludeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByCreatedAt = `-- name: ListTasksByCreatedAt :many
+
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.user_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.created_at < ?
+    OR (tasks.created_at = ? AND tasks.id < ?))
+ORDER BY tasks.created_at DESC, tasks.id DESC
+LIMIT ?) AS created
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.assignee_id = ? AND (? IS NULL OR tasks.user_id <> ?)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.created_at < ?
+    OR (tasks.created_at = ? AND tasks.id < ?))
+ORDER BY tasks.created_at DESC, tasks.id DESC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.workspace_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.created_at < ?
+    OR (tasks.created_at = ? AND tasks.id < ?))
+ORDER BY tasks.created_at DESC, tasks.id DESC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.created_at DESC, scoped.id DESC
+LIMIT ?
+`
+
+type ListTasksByCreatedAtParams struct {
+	CreatorID       sql.NullString `json:"creator_id"`
+	MemberID        string         `json:"member_id"`
+	IsCompleted     sql.NullBool   `json:"is_completed"`
+	Status          sql.NullString `json:"status"`
+	Priority        sql.NullString `json:"priority"`
+	AssigneeID      sql.NullString `json:"assignee_id"`
+	ProjectID       sql.NullString `json:"project_id"`
+	DueFrom         sql.NullTime   `json:"due_from"`
+	DueTo           sql.NullTime   `json:"due_to"`
+	CursorCreatedAt sql.NullTime   `json:"cursor_created_at"`
+	CursorID        string         `json:"cursor_id"`
+	Limit           int32          `json:"limit"`
+	AssignedTo      sql.NullString `json:"assigned_to"`
+	Limit_2         int32          `json:"limit_2"`
+	InWorkspace     sql.NullString `json:"in_workspace"`
+	Limit_3         int32          `json:"limit_3"`
+	Limit_4         int32          `json:"limit_4"`
+}
+
+// ListTasksBy* はキーセット方式で 1 ページ分のタスクを返す。
+// cursor_* には直前のページの最後のタスクの値を渡す (最初のページは NULL)。
+// 一覧の範囲 (作成者・担当者・ワークスペース) ごとに、範囲の列から始まるインデックスの順に 1 ページ分を読む副問い合わせを
+// UNION ALL でまとめ、外側で並べ直して 1 ページ分に絞る (OR で範囲をまとめるとインデックスで並べられず filesort になるため)。
+// LIMIT の ? (Limit, Limit_2, ...) にはすべて同じページの件数を渡す。
+// creator_id / assigned_to / in_workspace は一覧の範囲を表し、対象外のものには NULL を渡す (NULL との比較は常に偽になるため、
+// その副問い合わせは行を返さない)。作成者と担当者の両方を指定した場合、自分が作成して担当しているタスクは作成者の側だけで返す。
+// ワークスペースのタスクは member_id のユーザーがメンバーであるワークスペースのものだけを返す
+// (副問い合わせでは workspace_members と区別するため、列名に tasks. を付ける)。
+// 範囲・メンバーの確認・絞り込みの条件は 5 つのクエリで同じ行にし、カーソルの条件と ORDER BY だけを変える
+// (internal/adapter/repository/mysql/task_list_test.go で確認する。TEST_DB_DSN を指定すると同じタスクでの結果も確認する)。
+func (q *Queries) ListTasksByCreatedAt(ctx context.Context, arg *ListTasksByCreatedAtParams) ([]*Task, error) {
+	rows, err := q.query(ctx, q.listTasksByCreatedAtStmt, listTasksByCreatedAt,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
 		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
 		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+		arg.AssignedTo,
+		arg.CreatorID,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
 		arg.Priority,
-		arg.DueDate,
-		arg.ID,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit_2,
+		arg.InWorkspace,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit_3,
+		arg.Limit_4,
 	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.IsCompleted,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.AssigneeID,
+			&i.ProjectID,
+			&i.ParentID,
+			&i.BoardRank,
+			&i.Priority,
+			&i.DueDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PriorityRank,
+			&i.DueDateSort,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByDueDate = `-- name: ListTasksByDueDate :many
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.user_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.due_date_sort > ?
+    OR (tasks.due_date_sort = ? AND tasks.id > ?))
+ORDER BY tasks.due_date_sort ASC, tasks.id ASC
+LIMIT ?) AS created
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.assignee_id = ? AND (? IS NULL OR tasks.user_id <> ?)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.due_date_sort > ?
+    OR (tasks.due_date_sort = ? AND tasks.id > ?))
+ORDER BY tasks.due_date_sort ASC, tasks.id ASC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.workspace_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.due_date_sort > ?
+    OR (tasks.due_date_sort = ? AND tasks.id > ?))
+ORDER BY tasks.due_date_sort ASC, tasks.id ASC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.due_date_sort ASC, scoped.id ASC
+LIMIT ?
+`
+
+type ListTasksByDueDateParams struct {
+	CreatorID         sql.NullString `json:"creator_id"`
+	MemberID          string         `json:"member_id"`
+	IsCompleted       sql.NullBool   `json:"is_completed"`
+	Status            sql.NullString `json:"status"`
+	Priority          sql.NullString `json:"priority"`
+	AssigneeID        sql.NullString `json:"assignee_id"`
+	ProjectID         sql.NullString `json:"project_id"`
+	DueFrom           sql.NullTime   `json:"due_from"`
+	DueTo             sql.NullTime   `json:"due_to"`
+	CursorDueDateSort sql.NullTime   `json:"cursor_due_date_sort"`
+	CursorID          string         `json:"cursor_id"`
+	Limit             int32          `json:"limit"`
+	AssignedTo        sql.NullString `json:"assigned_to"`
+	Limit_2           int32          `json:"limit_2"`
+	InWorkspace       sql.NullString `json:"in_workspace"`
+	Limit_3           int32          `json:"limit_3"`
+	Limit_4           int32          `json:"limit_4"`
+}
+
+func (q *Queries) ListTasksByDueDate(ctx context.Context, arg *ListTasksByDueDateParams) ([]*Task, error) {
+	rows, err := q.query(ctx, q.listTasksByDueDateStmt, listTasksByDueDate,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorDueDateSort,
+		arg.CursorDueDateSort,
+		arg.CursorDueDateSort,
+		arg.CursorID,
+		arg.Limit,
+		arg.AssignedTo,
+		arg.CreatorID,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorDueDateSort,
+		arg.CursorDueDateSort,
+		arg.CursorDueDateSort,
+		arg.CursorID,
+		arg.Limit_2,
+		arg.InWorkspace,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorDueDateSort,
+		arg.CursorDueDateSort,
+		arg.CursorDueDateSort,
+		arg.CursorID,
+		arg.Limit_3,
+		arg.Limit_4,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.IsCompleted,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.AssigneeID,
+			&i.ProjectID,
+			&i.ParentID,
+			&i.BoardRank,
+			&i.Priority,
+			&i.DueDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PriorityRank,
+			&i.DueDateSort,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByPriority = `-- name: ListTasksByPriority :many
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.user_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.priority_rank < ?
+    OR (tasks.priority_rank = ? AND tasks.id < ?))
+ORDER BY tasks.priority_rank DESC, tasks.id DESC
+LIMIT ?) AS created
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.assignee_id = ? AND (? IS NULL OR tasks.user_id <> ?)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.priority_rank < ?
+    OR (tasks.priority_rank = ? AND tasks.id < ?))
+ORDER BY tasks.priority_rank DESC, tasks.id DESC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.workspace_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.priority_rank < ?
+    OR (tasks.priority_rank = ? AND tasks.id < ?))
+ORDER BY tasks.priority_rank DESC, tasks.id DESC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.priority_rank DESC, scoped.id DESC
+LIMIT ?
+`
+
+type ListTasksByPriorityParams struct {
+	CreatorID          sql.NullString `json:"creator_id"`
+	MemberID           string         `json:"member_id"`
+	IsCompleted        sql.NullBool   `json:"is_completed"`
+	Status             sql.NullString `json:"status"`
+	Priority           sql.NullString `json:"priority"`
+	AssigneeID         sql.NullString `json:"assignee_id"`
+	ProjectID          sql.NullString `json:"project_id"`
+	DueFrom            sql.NullTime   `json:"due_from"`
+	DueTo              sql.NullTime   `json:"due_to"`
+	CursorPriorityRank sql.NullInt16  `json:"cursor_priority_rank"`
+	CursorID           string         `json:"cursor_id"`
+	Limit              int32          `json:"limit"`
+	AssignedTo         sql.NullString `json:"assigned_to"`
+	Limit_2            int32          `json:"limit_2"`
+	InWorkspace        sql.NullString `json:"in_workspace"`
+	Limit_3            int32          `json:"limit_3"`
+	Limit_4            int32          `json:"limit_4"`
+}
+
+func (q *Queries) ListTasksByPriority(ctx context.Context, arg *ListTasksByPriorityParams) ([]*Task, error) {
+	rows, err := q.query(ctx, q.listTasksByPriorityStmt, listTasksByPriority,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorPriorityRank,
+		arg.CursorPriorityRank,
+		arg.CursorPriorityRank,
+		arg.CursorID,
+		arg.Limit,
+		arg.AssignedTo,
+		arg.CreatorID,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorPriorityRank,
+		arg.CursorPriorityRank,
+		arg.CursorPriorityRank,
+		arg.CursorID,
+		arg.Limit_2,
+		arg.InWorkspace,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorPriorityRank,
+		arg.CursorPriorityRank,
+		arg.CursorPriorityRank,
+		arg.CursorID,
+		arg.Limit_3,
+		arg.Limit_4,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.IsCompleted,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.AssigneeID,
+			&i.ProjectID,
+			&i.ParentID,
+			&i.BoardRank,
+			&i.Priority,
+			&i.DueDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PriorityRank,
+			&i.DueDateSort,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByRank = `-- name: ListTasksByRank :many
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.user_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.board_rank > ?
+    OR (tasks.board_rank = ? AND tasks.id > ?))
+ORDER BY tasks.board_rank ASC, tasks.id ASC
+LIMIT ?) AS created
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.assignee_id = ? AND (? IS NULL OR tasks.user_id <> ?)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.board_rank > ?
+    OR (tasks.board_rank = ? AND tasks.id > ?))
+ORDER BY tasks.board_rank ASC, tasks.id ASC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.workspace_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.board_rank > ?
+    OR (tasks.board_rank = ? AND tasks.id > ?))
+ORDER BY tasks.board_rank ASC, tasks.id ASC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.board_rank ASC, scoped.id ASC
+LIMIT ?
+`
+
+type ListTasksByRankParams struct {
+	CreatorID   sql.NullString `json:"creator_id"`
+	MemberID    string         `json:"member_id"`
+	IsCompleted sql.NullBool   `json:"is_completed"`
+	Status      sql.NullString `json:"status"`
+	Priority    sql.NullString `json:"priority"`
+	AssigneeID  sql.NullString `json:"assignee_id"`
+	ProjectID   sql.NullString `json:"project_id"`
+	DueFrom     sql.NullTime   `json:"due_from"`
+	DueTo       sql.NullTime   `json:"due_to"`
+	CursorRank  sql.NullString `json:"cursor_rank"`
+	CursorID    string         `json:"cursor_id"`
+	Limit       int32          `json:"limit"`
+	AssignedTo  sql.NullString `json:"assigned_to"`
+	Limit_2     int32          `json:"limit_2"`
+	InWorkspace sql.NullString `json:"in_workspace"`
+	Limit_3     int32          `json:"limit_3"`
+	Limit_4     int32          `json:"limit_4"`
+}
+
+func (q *Queries) ListTasksByRank(ctx context.Context, arg *ListTasksByRankParams) ([]*Task, error) {
+	rows, err := q.query(ctx, q.listTasksByRankStmt, listTasksByRank,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorRank,
+		arg.CursorRank,
+		arg.CursorRank,
+		arg.CursorID,
+		arg.Limit,
+		arg.AssignedTo,
+		arg.CreatorID,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorRank,
+		arg.CursorRank,
+		arg.CursorRank,
+		arg.CursorID,
+		arg.Limit_2,
+		arg.InWorkspace,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorRank,
+		arg.CursorRank,
+		arg.CursorRank,
+		arg.CursorID,
+		arg.Limit_3,
+		arg.Limit_4,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.IsCompleted,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.AssigneeID,
+			&i.ProjectID,
+			&i.ParentID,
+			&i.BoardRank,
+			&i.Priority,
+			&i.DueDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PriorityRank,
+			&i.DueDateSort,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByUpdatedAt = `-- name: ListTasksByUpdatedAt :many
+SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.user_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.updated_at < ?
+    OR (tasks.updated_at = ? AND tasks.id < ?))
+ORDER BY tasks.updated_at DESC, tasks.id DESC
+LIMIT ?) AS created
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.assignee_id = ? AND (? IS NULL OR tasks.user_id <> ?)
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.updated_at < ?
+    OR (tasks.updated_at = ? AND tasks.id < ?))
+ORDER BY tasks.updated_at DESC, tasks.id DESC
+LIMIT ?) AS assigned
+UNION ALL
+  SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM (SELECT id, title, description, status, is_completed, user_id, workspace_id, assignee_id, project_id, parent_id, board_rank, priority, due_date, created_at, updated_at, version, priority_rank, due_date_sort FROM tasks
+WHERE tasks.workspace_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+  AND (? IS NULL OR tasks.is_completed = ?)
+  AND (? IS NULL OR tasks.status = ?)
+  AND (? IS NULL OR tasks.priority = ?)
+  AND (? IS NULL OR tasks.assignee_id = ?)
+  AND (? IS NULL OR tasks.project_id = ?)
+  AND (? IS NULL OR tasks.due_date >= ?)
+  AND (? IS NULL OR tasks.due_date < ?)
+  AND (? IS NULL
+    OR tasks.updated_at < ?
+    OR (tasks.updated_at = ? AND tasks.id < ?))
+ORDER BY tasks.updated_at DESC, tasks.id DESC
+LIMIT ?) AS in_workspace
+) AS scoped
+ORDER BY scoped.updated_at DESC, scoped.id DESC
+LIMIT ?
+`
+
+type ListTasksByUpdatedAtParams struct {
+	CreatorID       sql.NullString `json:"creator_id"`
+	MemberID        string         `json:"member_id"`
+	IsCompleted     sql.NullBool   `json:"is_completed"`
+	Status          sql.NullString `json:"status"`
+	Priority        sql.NullString `json:"priority"`
+	AssigneeID      sql.NullString `json:"assignee_id"`
+	ProjectID       sql.NullString `json:"project_id"`
+	DueFrom         sql.NullTime   `json:"due_from"`
+	DueTo           sql.NullTime   `json:"due_to"`
+	CursorUpdatedAt sql.NullTime   `json:"cursor_updated_at"`
+	CursorID        string         `json:"cursor_id"`
+	Limit           int32          `json:"limit"`
+	AssignedTo      sql.NullString `json:"assigned_to"`
+	Limit_2         int32          `json:"limit_2"`
+	InWorkspace     sql.NullString `json:"in_workspace"`
+	Limit_3         int32          `json:"limit_3"`
+	Limit_4         int32          `json:"limit_4"`
+}
+
+func (q *Queries) ListTasksByUpdatedAt(ctx context.Context, arg *ListTasksByUpdatedAtParams) ([]*Task, error) {
+	rows, err := q.query(ctx, q.listTasksByUpdatedAtStmt, listTasksByUpdatedAt,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorUpdatedAt,
+		arg.CursorUpdatedAt,
+		arg.CursorUpdatedAt,
+		arg.CursorID,
+		arg.Limit,
+		arg.AssignedTo,
+		arg.CreatorID,
+		arg.CreatorID,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorUpdatedAt,
+		arg.CursorUpdatedAt,
+		arg.CursorUpdatedAt,
+		arg.CursorID,
+		arg.Limit_2,
+		arg.InWorkspace,
+		arg.MemberID,
+		arg.IsCompleted,
+		arg.IsCompleted,
+		arg.Status,
+		arg.Status,
+		arg.Priority,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.DueFrom,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.DueTo,
+		arg.CursorUpdatedAt,
+		arg.CursorUpdatedAt,
+		arg.CursorUpdatedAt,
+		arg.CursorID,
+		arg.Limit_3,
+		arg.Limit_4,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.IsCompleted,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.AssigneeID,
+			&i.ProjectID,
+			&i.ParentID,
+			&i.BoardRank,
+			&i.Priority,
+			&i.DueDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PriorityRank,
+			&i.DueDateSort,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveTasksToProject = `-- name: MoveTasksToProject :exec
+
+UPDATE tasks SET project_id = ?, version = version + 1 WHERE project_id = ?
+`
+
+type MoveTasksToProjectParams struct {
+	NewProjectID sql.NullString `json:"new_project_id"`
+	ProjectID    sql.NullString `json:"project_id"`
+}
+
+// 以下はプロジェクトの削除時に、削除するプロジェクトのタスクを整理する
+// new_project_id に NULL を渡すとプロジェクトから外す
+func (q *Queries) MoveTasksToProject(ctx context.Context, arg *MoveTasksToProjectParams) error {
+	_, err := q.exec(ctx, q.moveTasksToProjectStmt, moveTasksToProject, arg.NewProjectID, arg.ProjectID)
+	return err
+}
+
+const reassignTasksAssignedTo = `-- name: ReassignTasksAssignedTo :exec
+UPDATE tasks SET assignee_id = ?, version = tasks.version + 1
+WHERE tasks.assignee_id = ?
+  AND (? IS NULL OR tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+`
+
+type ReassignTasksAssignedToParams struct {
+	NewAssigneeID sql.NullString `json:"new_assignee_id"`
+	AssigneeID    sql.NullString `json:"assignee_id"`
+}
+
+// new_assignee_id に NULL を渡すと担当者なしにする。new_assignee_id のユーザーがメンバーでないワークスペースのタスクは変更しない
+func (q *Queries) ReassignTasksAssignedTo(ctx context.Context, arg *ReassignTasksAssignedToParams) error {
+	_, err := q.exec(ctx, q.reassignTasksAssignedToStmt, reassignTasksAssignedTo,
+		arg.NewAssigneeID,
+		arg.AssigneeID,
+		arg.NewAssigneeID,
+		arg.NewAssigneeID,
+	)
+	return err
+}
+
+const reassignTasksCreatedBy = `-- name: ReassignTasksCreatedBy :exec
+
+UPDATE tasks SET user_id = ?, version = tasks.version + 1
+WHERE tasks.user_id = ?
+  AND (tasks.workspace_id IS NULL OR EXISTS (
+    SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = tasks.workspace_id AND wm.user_id = ?))
+`
+
+type ReassignTasksCreatedByParams struct {
+	NewUserID string `json:"new_user_id"`
+	UserID    string `json:"user_id"`
+}
+
+// 以下はユーザーの削除時に、削除するユーザーを参照しているタスクを整理する (version を進めて編集中のクライアントに競合を伝える)
+// 個人のタスクと、new_user_id のユーザーがメンバーであるワークスペースのタスクの作成者を new_user_id のユーザーにする
+// (それ以外のワークスペースのタスクは ReassignWorkspaceTasksCreatedByToOwner で引き継ぐ)
+func (q *Queries) ReassignTasksCreatedBy(ctx context.Context, arg *ReassignTasksCreatedByParams) error {
+	_, err := q.exec(ctx, q.reassignTasksCreatedByStmt, reassignTasksCreatedBy, arg.NewUserID, arg.UserID, arg.NewUserID)
+	return err
+}
+
+const reassignWorkspaceTasksCreatedByToOwner = `-- name: ReassignWorkspaceTasksCreatedByToOwner :exec
+UPDATE tasks
+SET user_id = (
+        SELECT wm.user_id FROM workspace_members wm
+        WHERE wm.workspace_id = tasks.workspace_id AND wm.role = 'owner' AND wm.user_id <> ?
+        ORDER BY wm.created_at, wm.user_id
+        LIMIT 1
+    ),
+    version = tasks.version + 1
+WHERE tasks.user_id = ? AND tasks.workspace_id IS NOT NULL
+`
+
+type ReassignWorkspaceTasksCreatedByToOwnerParams struct {
+	UserID string `json:"user_id"`
+}
+
+// ワークスペースのタスクの作成者を、そのワークスペースに残る owner のうち最も古くから参加しているユーザーにする
+// (残る owner がいない場合は user_id が NULL になりエラーにするため、呼び出し前に確認する)
+func (q *Queries) ReassignWorkspaceTasksCreatedByToOwner(ctx context.Context, arg *ReassignWorkspaceTasksCreatedByToOwnerParams) error {
+	_, err := q.exec(ctx, q.reassignWorkspaceTasksCreatedByToOwnerStmt, reassignWorkspaceTasksCreatedByToOwner, arg.UserID, arg.UserID)
+	return err
+}
+
+const reparentSubtasks = `-- name: ReparentSubtasks :exec
+UPDATE tasks SET parent_id = ?, version = version + 1 WHERE parent_id = ?
+`
+
+type ReparentSubtasksParams struct {
+	NewParentID sql.NullString `json:"new_parent_id"`
+	ParentID    sql.NullString `json:"parent_id"`
+}
+
+// new_parent_id に NULL を渡すと親なしにする
+func (q *Queries) ReparentSubtasks(ctx context.Context, arg *ReparentSubtasksParams) error {
+	_, err := q.exec(ctx, q.reparentSubtasksStmt, reparentSubtasks, arg.NewParentID, arg.ParentID)
+	return err
+}
+
+const syncTaskCompletionInProject = `-- name: SyncTaskCompletionInProject :exec
+UPDATE tasks SET is_completed = NOT is_completed, version = version + 1
+WHERE project_id = ? AND is_completed <> (status IN (/*SLICE:done_statuses*/?))
+`
+
+type SyncTaskCompletionInProjectParams struct {
+	ProjectID    sql.NullString `json:"project_id"`
+	DoneStatuses []string       `json:"done_statuses"`
+}
+
+// 完了として扱う状態が変わったタスクの is_completed を反転する
+func (q *Queries) SyncTaskCompletionInProject(ctx context.Context, arg *SyncTaskCompletionInProjectParams) error {
+	query := syncTaskCompletionInProject
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.ProjectID)
+	if len(arg.DoneStatuses) > 0 {
+		for _, v := range arg.DoneStatuses {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:done_statuses*/?", strings.Repeat(",?", len(arg.DoneStatuses))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:done_statuses*/?", "NULL", 1)
+	}
+	_, err := q.exec(ctx, nil, query, queryParams...)
+	return err
+}
+
+const unassignWorkspaceTasks = `-- name: UnassignWorkspaceTasks :exec
+UPDATE tasks SET assignee_id = NULL, version = version + 1 WHERE workspace_id = ? AND assignee_id = ?
+`
+
+type UnassignWorkspaceTasksParams struct {
+	WorkspaceID sql.NullString `json:"workspace_id"`
+	AssigneeID  sql.NullString `json:"assignee_id"`
+}
+
+// ワークスペースから外れたメンバーを、そのワークスペースのタスクの担当者から外す
+func (q *Queries) UnassignWorkspaceTasks(ctx context.Context, arg *UnassignWorkspaceTasksParams) error {
+	_, err := q.exec(ctx, q.unassignWorkspaceTasksStmt, unassignWorkspaceTasks, arg.WorkspaceID, arg.AssigneeID)
+	return err
+}
+
+const updateTask = `-- name: UpdateTask :execrows
+
+UPDATE tasks SET title = ?, description = ?, status = ?, is_completed = ?, assignee_id = ?, project_id = ?, parent_id = ?, board_rank = ?, priority = ?, due_date = ?, version = version + 1
+WHERE id = ? AND version = ?
+`
+
+type UpdateTaskParams struct {
+	Title       string         `json:"title"`
+	Description sql.NullString `json:"description"`
+	Status      string         `json:"status"`
+	IsCompleted bool           `json:"is_completed"`
+	AssigneeID  sql.NullString `json:"assignee_id"`
+	ProjectID   sql.NullString `json:"project_id"`
+	ParentID    sql.NullString `json:"parent_id"`
+	BoardRank   string         `json:"board_rank"`
+	Priority    string         `json:"priority"`
+	DueDate     sql.NullTime   `json:"due_date"`
+	ID          string         `json:"id"`
+	Version     int64          `json:"version"`
+}
+
+// UpdateTask / DeleteTask は読み込んだ時点の version と一致する場合のみ行を変更する (0 行なら競合)
+func (q *Queries) UpdateTask(ctx context.Context, arg *UpdateTaskParams) (int64, error) {
+	result, err := q.exec(ctx, q.updateTaskStmt, updateTask,
+		arg.Title,
+		arg.Description,
+		arg.Status,
+		arg.IsCompleted,
+		arg.AssigneeID,
+		arg.ProjectID,
+		arg.ParentID,
+		arg.BoardRank,
+		arg.Priority,
+		arg.DueDate,
+		arg.ID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateTaskRank = `-- name: UpdateTaskRank :exec
+UPDATE tasks SET board_rank = ?, version = version + 1 WHERE id = ?
+`
+
+type UpdateTaskRankParams struct {
+	BoardRank string `json:"board_rank"`
+	ID        string `json:"id"`
+}
+
+func (q *Queries) UpdateTaskRank(ctx context.Context, arg *UpdateTaskRankParams) error {
+	_, err := q.exec(ctx, q.updateTaskRankStmt, updateTaskRank, arg.BoardRank, arg.ID)
 	return err
 }
@@ -0,0 +1,74 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: token_revocations.sql
+
+package query
+
+import (
+	"context"
+	"time"
+)
+
+const deleteExpiredRevokedTokens = `-- name: DeleteExpiredRevokedTokens :exec
+DELETE FROM revoked_tokens WHERE expires_at < ?
+`
+
+func (q *Queries) DeleteExpiredRevokedTokens(ctx context.Context, expiresAt time.Time) error {
+	_, err := q.exec(ctx, q.deleteExpiredRevokedTokensStmt, deleteExpiredRevokedTokens, expiresAt)
+	return err
+}
+
+const getUserTokensRevokedBefore = `-- name: GetUserTokensRevokedBefore :one
+SELECT revoked_before FROM user_token_revocations WHERE user_id = ? LIMIT 1
+`
+
+func (q *Queries) GetUserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	row := q.queryRow(ctx, q.getUserTokensRevokedBeforeStmt, getUserTokensRevokedBefore, userID)
+	var revoked_before time.Time
+	err := row.Scan(&revoked_before)
+	return revoked_before, err
+}
+
+const isTokenRevoked = `-- name: IsTokenRevoked :one
+SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE jti = ?) AS revoked
+`
+
+func (q *Queries) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	row := q.queryRow(ctx, q.isTokenRevokedStmt, isTokenRevoked, jti)
+	var revoked bool
+	err := row.Scan(&revoked)
+	return revoked, err
+}
+
+const revokeToken = `-- name: RevokeToken :exec
+
+INSERT IGNORE INTO revoked_tokens (jti, user_id, expires_at) VALUES (?, ?, ?)
+`
+
+type RevokeTokenParams struct {
+	Jti       string    `json:"jti"`
+	UserID    string    `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// sql/queries/token_revocations.sql
+func (q *Queries) RevokeToken(ctx context.Context, arg *RevokeTokenParams) error {
+	_, err := q.exec(ctx, q.revokeTokenStmt, revokeToken, arg.Jti, arg.UserID, arg.ExpiresAt)
+	return err
+}
+
+const setUserTokensRevokedBefore = `-- name: SetUserTokensRevokedBefore :exec
+INSERT INTO user_token_revocations (user_id, revoked_before) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE revoked_before = VALUES(revoked_before)
+`
+
+type SetUserTokensRevokedBeforeParams struct {
+	UserID        string    `json:"user_id"`
+	RevokedBefore time.Time `json:"revoked_before"`
+}
+
+func (q *Queries) SetUserTokensRevokedBefore(ctx context.Context, arg *SetUserTokensRevokedBeforeParams) error {
+	_, err := q.exec(ctx, q.setUserTokensRevokedBeforeStmt, setUserTokensRevokedBefore, arg.UserID, arg.RevokedBefore)
+	return err
+}
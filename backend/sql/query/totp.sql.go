@@ -0,0 +1,132 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: totp.sql
+
+package query
+
+import (
+	"context"
+)
+
+const confirmTOTPCredential = `-- name: ConfirmTOTPCredential :execrows
+UPDATE totp_credentials SET confirmed_at = CURRENT_TIMESTAMP, last_used_step = ? WHERE user_id = ? AND confirmed_at IS NULL
+`
+
+type ConfirmTOTPCredentialParams struct {
+	LastUsedStep int64  `json:"last_used_step"`
+	UserID       string `json:"user_id"`
+}
+
+// 未確認の場合のみ確認済みにする (0 行の場合は同時に確認された)
+func (q *Queries) ConfirmTOTPCredential(ctx context.Context, arg *ConfirmTOTPCredentialParams) (int64, error) {
+	result, err := q.exec(ctx, q.confirmTOTPCredentialStmt, confirmTOTPCredential, arg.LastUsedStep, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createTOTPRecoveryCode = `-- name: CreateTOTPRecoveryCode :exec
+INSERT INTO totp_recovery_codes (id, user_id, code_hash) VALUES (?, ?, ?)
+`
+
+type CreateTOTPRecoveryCodeParams struct {
+	ID       string `json:"id"`
+	UserID   string `json:"user_id"`
+	CodeHash string `json:"code_hash"`
+}
+
+func (q *Queries) CreateTOTPRecoveryCode(ctx context.Context, arg *CreateTOTPRecoveryCodeParams) error {
+	_, err := q.exec(ctx, q.createTOTPRecoveryCodeStmt, createTOTPRecoveryCode, arg.ID, arg.UserID, arg.CodeHash)
+	return err
+}
+
+const deleteTOTPCredential = `-- name: DeleteTOTPCredential :exec
+DELETE FROM totp_credentials WHERE user_id = ?
+`
+
+func (q *Queries) DeleteTOTPCredential(ctx context.Context, userID string) error {
+	_, err := q.exec(ctx, q.deleteTOTPCredentialStmt, deleteTOTPCredential, userID)
+	return err
+}
+
+const deleteUserTOTPRecoveryCodes = `-- name: DeleteUserTOTPRecoveryCodes :exec
+DELETE FROM totp_recovery_codes WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserTOTPRecoveryCodes(ctx context.Context, userID string) error {
+	_, err := q.exec(ctx, q.deleteUserTOTPRecoveryCodesStmt, deleteUserTOTPRecoveryCodes, userID)
+	return err
+}
+
+const getTOTPCredential = `-- name: GetTOTPCredential :one
+SELECT user_id, secret_ciphertext, confirmed_at, last_used_step, created_at FROM totp_credentials WHERE user_id = ? LIMIT 1
+`
+
+func (q *Queries) GetTOTPCredential(ctx context.Context, userID string) (*TotpCredential, error) {
+	row := q.queryRow(ctx, q.getTOTPCredentialStmt, getTOTPCredential, userID)
+	var i TotpCredential
+	err := row.Scan(
+		&i.UserID,
+		&i.SecretCiphertext,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const saveTOTPCredential = `-- name: SaveTOTPCredential :exec
+
+INSERT INTO totp_credentials (user_id, secret_ciphertext) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE secret_ciphertext = VALUES(secret_ciphertext), confirmed_at = NULL, last_used_step = 0, created_at = CURRENT_TIMESTAMP
+`
+
+type SaveTOTPCredentialParams struct {
+	UserID           string `json:"user_id"`
+	SecretCiphertext []byte `json:"secret_ciphertext"`
+}
+
+// sql/queries/totp.sql
+// 未確認の登録がある場合は新しいシークレットで置き換える
+func (q *Queries) SaveTOTPCredential(ctx context.Context, arg *SaveTOTPCredentialParams) error {
+	_, err := q.exec(ctx, q.saveTOTPCredentialStmt, saveTOTPCredential, arg.UserID, arg.SecretCiphertext)
+	return err
+}
+
+const useTOTPRecoveryCode = `-- name: UseTOTPRecoveryCode :execrows
+UPDATE totp_recovery_codes SET used_at = CURRENT_TIMESTAMP WHERE user_id = ? AND code_hash = ? AND used_at IS NULL
+`
+
+type UseTOTPRecoveryCodeParams struct {
+	UserID   string `json:"user_id"`
+	CodeHash string `json:"code_hash"`
+}
+
+// 未使用の場合のみ使用済みにする (0 行の場合は存在しないか使用済み)
+func (q *Queries) UseTOTPRecoveryCode(ctx context.Context, arg *UseTOTPRecoveryCodeParams) (int64, error) {
+	result, err := q.exec(ctx, q.useTOTPRecoveryCodeStmt, useTOTPRecoveryCode, arg.UserID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const useTOTPStep = `-- name: UseTOTPStep :execrows
+UPDATE totp_credentials SET last_used_step = ? WHERE user_id = ? AND last_used_step < ?
+`
+
+type UseTOTPStepParams struct {
+	Step   int64  `json:"step"`
+	UserID string `json:"user_id"`
+}
+
+// 最後に使用したコードより新しい場合のみ更新する (0 行の場合は同じコードが再使用された)
+func (q *Queries) UseTOTPStep(ctx context.Context, arg *UseTOTPStepParams) (int64, error) {
+	result, err := q.exec(ctx, q.useTOTPStepStmt, useTOTPStep, arg.Step, arg.UserID, arg.Step)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
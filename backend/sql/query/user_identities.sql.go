@@ -0,0 +1,55 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: user_identities.sql
+
+package query
+
+import (
+	"context"
+)
+
+const createUserIdentity = `-- name: CreateUserIdentity :exec
+
+INSERT INTO user_identities (id, user_id, issuer, subject) VALUES (?, ?, ?, ?)
+`
+
+type CreateUserIdentityParams struct {
+	ID      string `json:"id"`
+	UserID  string `json:"user_id"`
+	Issuer  string `json:"issuer"`
+	Subject string `json:"subject"`
+}
+
+// sql/queries/user_identities.sql
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg *CreateUserIdentityParams) error {
+	_, err := q.exec(ctx, q.createUserIdentityStmt, createUserIdentity,
+		arg.ID,
+		arg.UserID,
+		arg.Issuer,
+		arg.Subject,
+	)
+	return err
+}
+
+const getUserIdentity = `-- name: GetUserIdentity :one
+SELECT id, user_id, issuer, subject, created_at FROM user_identities WHERE issuer = ? AND subject = ? LIMIT 1
+`
+
+type GetUserIdentityParams struct {
+	Issuer  string `json:"issuer"`
+	Subject string `json:"subject"`
+}
+
+func (q *Queries) GetUserIdentity(ctx context.Context, arg *GetUserIdentityParams) (*UserIdentity, error) {
+	row := q.queryRow(ctx, q.getUserIdentityStmt, getUserIdentity, arg.Issuer, arg.Subject)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Issuer,
+		&i.Subject,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
@@ -7,11 +7,12 @@ package query
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createUser = `-- name: CreateUser :exec
 
-INSERT INTO users (id, name, email, password) VALUES (?, ?, ?, ?)
+INSERT INTO users (id, name, email, password, role) VALUES (?, ?, ?, ?, ?)
 `
 
 type CreateUserParams struct {
@@ -19,6 +20,7 @@ type CreateUserParams struct {
 	Name     string `json:"name"`
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	Role     string `json:"role"`
 }
 
 // sql/queries/users.sql
@@ -28,12 +30,22 @@ func (q *Queries) CreateUser(ctx context.Context, arg *CreateUserParams) error {
 		arg.Name,
 		arg.Email,
 		arg.Password,
+		arg.Role,
 	)
 	return err
 }
 
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users WHERE id = ?
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id string) error {
+	_, err := q.exec(ctx, q.deleteUserStmt, deleteUser, id)
+	return err
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, name, email, password, created_at, updated_at FROM users WHERE email = ? LIMIT 1
+SELECT id, name, email, password, created_at, updated_at, email_verified_at, role, disabled_at FROM users WHERE email = ? LIMIT 1
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (*User, error) {
@@ -46,12 +58,15 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (*User, erro
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.Role,
+		&i.DisabledAt,
 	)
 	return &i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, name, email, password, created_at, updated_at FROM users WHERE id = ? LIMIT 1
+SELECT id, name, email, password, created_at, updated_at, email_verified_at, role, disabled_at FROM users WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id string) (*User, error) {
@@ -64,19 +79,173 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (*User, error) {
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.Role,
+		&i.DisabledAt,
 	)
 	return &i, err
 }
 
+const listUsers = `-- name: ListUsers :many
+SELECT id, name, email, password, created_at, updated_at, email_verified_at, role, disabled_at FROM users
+WHERE (? IS NULL OR name LIKE ? OR email LIKE ?)
+  AND (? IS NULL
+    OR created_at < ?
+    OR (created_at = ? AND id < ?))
+ORDER BY created_at DESC, id DESC
+LIMIT ?
+`
+
+type ListUsersParams struct {
+	Pattern         sql.NullString `json:"pattern"`
+	CursorCreatedAt sql.NullTime   `json:"cursor_created_at"`
+	CursorID        string         `json:"cursor_id"`
+	Limit           int32          `json:"limit"`
+}
+
+// 作成日時の新しい順にキーセット方式で 1 ページ分のユーザーを返す (最初のページは cursor_created_at に NULL を渡す)。
+// pattern は名前またはメールアドレスの部分一致 (LIKE のパターン、絞り込まない場合は NULL)。
+func (q *Queries) ListUsers(ctx context.Context, arg *ListUsersParams) ([]*User, error) {
+	rows, err := q.query(ctx, q.listUsersStmt, listUsers,
+		arg.Pattern,
+		arg.Pattern,
+		arg.Pattern,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.Password,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.EmailVerifiedAt,
+			&i.Role,
+			&i.DisabledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markUserEmailVerified = `-- name: MarkUserEmailVerified :execrows
+UPDATE users SET email_verified_at = CURRENT_TIMESTAMP WHERE id = ? AND email = ?
+`
+
+type MarkUserEmailVerifiedParams struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+// 確認したメールアドレスが現在のものと一致する場合のみ確認済みにする
+func (q *Queries) MarkUserEmailVerified(ctx context.Context, arg *MarkUserEmailVerifiedParams) (int64, error) {
+	result, err := q.exec(ctx, q.markUserEmailVerifiedStmt, markUserEmailVerified, arg.ID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT DISTINCT u.id, u.name, u.email, u.password, u.created_at, u.updated_at, u.email_verified_at, u.role, u.disabled_at FROM users u
+JOIN workspace_members theirs ON theirs.user_id = u.id
+JOIN workspace_members mine ON mine.workspace_id = theirs.workspace_id AND mine.user_id = ?
+WHERE u.disabled_at IS NULL
+  AND (u.name LIKE ? OR u.email = ?)
+ORDER BY u.name, u.id
+LIMIT ?
+`
+
+type SearchUsersParams struct {
+	CallerID string `json:"caller_id"`
+	Prefix   string `json:"prefix"`
+	Email    string `json:"email"`
+	Limit    int32  `json:"limit"`
+}
+
+// caller_id のユーザーと同じワークスペースのメンバーのうち、名前が prefix で始まるか、メールアドレスが email と一致する
+// 有効なユーザーを名前順に返す (prefix には末尾に % を付けた LIKE のパターンを渡す)
+func (q *Queries) SearchUsers(ctx context.Context, arg *SearchUsersParams) ([]*User, error) {
+	rows, err := q.query(ctx, q.searchUsersStmt, searchUsers,
+		arg.CallerID,
+		arg.Prefix,
+		arg.Email,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.Password,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.EmailVerifiedAt,
+			&i.Role,
+			&i.DisabledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setUserDisabledAt = `-- name: SetUserDisabledAt :exec
+UPDATE users SET disabled_at = ? WHERE id = ?
+`
+
+type SetUserDisabledAtParams struct {
+	DisabledAt sql.NullTime `json:"disabled_at"`
+	ID         string       `json:"id"`
+}
+
+func (q *Queries) SetUserDisabledAt(ctx context.Context, arg *SetUserDisabledAtParams) error {
+	_, err := q.exec(ctx, q.setUserDisabledAtStmt, setUserDisabledAt, arg.DisabledAt, arg.ID)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :exec
-UPDATE users SET name = ?, email = ?, password = ? WHERE id = ?
+UPDATE users SET name = ?, email = ?, password = ?, email_verified_at = ? WHERE id = ?
 `
 
 type UpdateUserParams struct {
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
-	ID       string `json:"id"`
+	Name            string       `json:"name"`
+	Email           string       `json:"email"`
+	Password        string       `json:"password"`
+	EmailVerifiedAt sql.NullTime `json:"email_verified_at"`
+	ID              string       `json:"id"`
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg *UpdateUserParams) error {
@@ -84,6 +253,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg *UpdateUserParams) error {
 		arg.Name,
 		arg.Email,
 		arg.Password,
+		arg.EmailVerifiedAt,
 		arg.ID,
 	)
 	return err
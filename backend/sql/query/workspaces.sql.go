@@ -0,0 +1,468 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: workspaces.sql
+
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const addOwnerToWorkspacesOwnedBy = `-- name: AddOwnerToWorkspacesOwnedBy :exec
+INSERT INTO workspace_members (workspace_id, user_id, role)
+SELECT wm.workspace_id, ?, 'owner' FROM workspace_members wm
+WHERE wm.user_id = ? AND wm.role = 'owner'
+ON DUPLICATE KEY UPDATE role = 'owner'
+`
+
+type AddOwnerToWorkspacesOwnedByParams struct {
+	NewOwnerID string `json:"new_owner_id"`
+	OwnerID    string `json:"owner_id"`
+}
+
+// ユーザーの削除時に、ユーザーが所有者であるワークスペースに new_owner_id のユーザーを所有者として追加する (メンバーの場合は所有者に変更する)
+func (q *Queries) AddOwnerToWorkspacesOwnedBy(ctx context.Context, arg *AddOwnerToWorkspacesOwnedByParams) error {
+	_, err := q.exec(ctx, q.addOwnerToWorkspacesOwnedByStmt, addOwnerToWorkspacesOwnedBy, arg.NewOwnerID, arg.OwnerID)
+	return err
+}
+
+const countWorkspaceOwners = `-- name: CountWorkspaceOwners :one
+SELECT COUNT(*) FROM workspace_members WHERE workspace_id = ? AND role = 'owner'
+`
+
+func (q *Queries) CountWorkspaceOwners(ctx context.Context, workspaceID string) (int64, error) {
+	row := q.queryRow(ctx, q.countWorkspaceOwnersStmt, countWorkspaceOwners, workspaceID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createWorkspace = `-- name: CreateWorkspace :exec
+
+INSERT INTO workspaces (id, name) VALUES (?, ?)
+`
+
+type CreateWorkspaceParams struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// sql/queries/workspaces.sql
+func (q *Queries) CreateWorkspace(ctx context.Context, arg *CreateWorkspaceParams) error {
+	_, err := q.exec(ctx, q.createWorkspaceStmt, createWorkspace, arg.ID, arg.Name)
+	return err
+}
+
+const createWorkspaceInvitation = `-- name: CreateWorkspaceInvitation :exec
+INSERT INTO workspace_invitations (id, workspace_id, email, role, invited_by, expires_at) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateWorkspaceInvitationParams struct {
+	ID          string    `json:"id"`
+	WorkspaceID string    `json:"workspace_id"`
+	Email       string    `json:"email"`
+	Role        string    `json:"role"`
+	InvitedBy   string    `json:"invited_by"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateWorkspaceInvitation(ctx context.Context, arg *CreateWorkspaceInvitationParams) error {
+	_, err := q.exec(ctx, q.createWorkspaceInvitationStmt, createWorkspaceInvitation,
+		arg.ID,
+		arg.WorkspaceID,
+		arg.Email,
+		arg.Role,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createWorkspaceMember = `-- name: CreateWorkspaceMember :exec
+INSERT INTO workspace_members (workspace_id, user_id, role) VALUES (?, ?, ?)
+`
+
+type CreateWorkspaceMemberParams struct {
+	WorkspaceID string `json:"workspace_id"`
+	UserID      string `json:"user_id"`
+	Role        string `json:"role"`
+}
+
+func (q *Queries) CreateWorkspaceMember(ctx context.Context, arg *CreateWorkspaceMemberParams) error {
+	_, err := q.exec(ctx, q.createWorkspaceMemberStmt, createWorkspaceMember, arg.WorkspaceID, arg.UserID, arg.Role)
+	return err
+}
+
+const deleteWorkspace = `-- name: DeleteWorkspace :exec
+DELETE FROM workspaces WHERE id = ?
+`
+
+// メンバー・招待・タスクはワークスペースの削除に連動して削除される (ON DELETE CASCADE)
+func (q *Queries) DeleteWorkspace(ctx context.Context, id string) error {
+	_, err := q.exec(ctx, q.deleteWorkspaceStmt, deleteWorkspace, id)
+	return err
+}
+
+const deleteWorkspaceMember = `-- name: DeleteWorkspaceMember :exec
+DELETE FROM workspace_members WHERE workspace_id = ? AND user_id = ?
+`
+
+type DeleteWorkspaceMemberParams struct {
+	WorkspaceID string `json:"workspace_id"`
+	UserID      string `json:"user_id"`
+}
+
+func (q *Queries) DeleteWorkspaceMember(ctx context.Context, arg *DeleteWorkspaceMemberParams) error {
+	_, err := q.exec(ctx, q.deleteWorkspaceMemberStmt, deleteWorkspaceMember, arg.WorkspaceID, arg.UserID)
+	return err
+}
+
+const getWorkspaceByID = `-- name: GetWorkspaceByID :one
+SELECT id, name, created_at, updated_at FROM workspaces WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetWorkspaceByID(ctx context.Context, id string) (*Workspace, error) {
+	row := q.queryRow(ctx, q.getWorkspaceByIDStmt, getWorkspaceByID, id)
+	var i Workspace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const getWorkspaceInvitationByID = `-- name: GetWorkspaceInvitationByID :one
+SELECT id, workspace_id, email, role, invited_by, status, expires_at, responded_at, created_at FROM workspace_invitations WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetWorkspaceInvitationByID(ctx context.Context, id string) (*WorkspaceInvitation, error) {
+	row := q.queryRow(ctx, q.getWorkspaceInvitationByIDStmt, getWorkspaceInvitationByID, id)
+	var i WorkspaceInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Email,
+		&i.Role,
+		&i.InvitedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const getWorkspaceMember = `-- name: GetWorkspaceMember :one
+SELECT workspace_id, user_id, role, created_at, updated_at FROM workspace_members WHERE workspace_id = ? AND user_id = ? LIMIT 1
+`
+
+type GetWorkspaceMemberParams struct {
+	WorkspaceID string `json:"workspace_id"`
+	UserID      string `json:"user_id"`
+}
+
+func (q *Queries) GetWorkspaceMember(ctx context.Context, arg *GetWorkspaceMemberParams) (*WorkspaceMember, error) {
+	row := q.queryRow(ctx, q.getWorkspaceMemberStmt, getWorkspaceMember, arg.WorkspaceID, arg.UserID)
+	var i WorkspaceMember
+	err := row.Scan(
+		&i.WorkspaceID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const listPendingWorkspaceInvitations = `-- name: ListPendingWorkspaceInvitations :many
+SELECT id, workspace_id, email, role, invited_by, status, expires_at, responded_at, created_at FROM workspace_invitations
+WHERE workspace_id = ? AND status = 'pending' AND expires_at > CURRENT_TIMESTAMP
+ORDER BY created_at DESC, id DESC
+`
+
+// ワークスペースの有効期限内の未回答の招待を新しい順に返す
+func (q *Queries) ListPendingWorkspaceInvitations(ctx context.Context, workspaceID string) ([]*WorkspaceInvitation, error) {
+	rows, err := q.query(ctx, q.listPendingWorkspaceInvitationsStmt, listPendingWorkspaceInvitations, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WorkspaceInvitation
+	for rows.Next() {
+		var i WorkspaceInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Email,
+			&i.Role,
+			&i.InvitedBy,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.RespondedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingWorkspaceInvitationsByEmail = `-- name: ListPendingWorkspaceInvitationsByEmail :many
+SELECT i.id, i.workspace_id, i.email, i.role, i.invited_by, i.status, i.expires_at, i.responded_at, i.created_at, w.name AS workspace_name
+FROM workspace_invitations i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE i.email = ? AND i.status = 'pending' AND i.expires_at > CURRENT_TIMESTAMP
+ORDER BY i.created_at DESC, i.id DESC
+`
+
+type ListPendingWorkspaceInvitationsByEmailRow struct {
+	ID            string       `json:"id"`
+	WorkspaceID   string       `json:"workspace_id"`
+	Email         string       `json:"email"`
+	Role          string       `json:"role"`
+	InvitedBy     string       `json:"invited_by"`
+	Status        string       `json:"status"`
+	ExpiresAt     time.Time    `json:"expires_at"`
+	RespondedAt   sql.NullTime `json:"responded_at"`
+	CreatedAt     time.Time    `json:"created_at"`
+	WorkspaceName string       `json:"workspace_name"`
+}
+
+// メールアドレス宛ての有効期限内の未回答の招待を、ワークスペースの名前とともに新しい順に返す
+func (q *Queries) ListPendingWorkspaceInvitationsByEmail(ctx context.Context, email string) ([]*ListPendingWorkspaceInvitationsByEmailRow, error) {
+	rows, err := q.query(ctx, q.listPendingWorkspaceInvitationsByEmailStmt, listPendingWorkspaceInvitationsByEmail, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListPendingWorkspaceInvitationsByEmailRow
+	for rows.Next() {
+		var i ListPendingWorkspaceInvitationsByEmailRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Email,
+			&i.Role,
+			&i.InvitedBy,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.RespondedAt,
+			&i.CreatedAt,
+			&i.WorkspaceName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkspaceMembers = `-- name: ListWorkspaceMembers :many
+SELECT wm.workspace_id, wm.user_id, wm.role, wm.created_at, wm.updated_at, u.name, u.email
+FROM workspace_members wm
+JOIN users u ON u.id = wm.user_id
+WHERE wm.workspace_id = ?
+ORDER BY wm.created_at, wm.user_id
+`
+
+type ListWorkspaceMembersRow struct {
+	WorkspaceID string    `json:"workspace_id"`
+	UserID      string    `json:"user_id"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+}
+
+// メンバーを参加した順に、ユーザーの名前とメールアドレスとともに返す
+func (q *Queries) ListWorkspaceMembers(ctx context.Context, workspaceID string) ([]*ListWorkspaceMembersRow, error) {
+	rows, err := q.query(ctx, q.listWorkspaceMembersStmt, listWorkspaceMembers, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkspaceMembersRow
+	for rows.Next() {
+		var i ListWorkspaceMembersRow
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.UserID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkspacesByMember = `-- name: ListWorkspacesByMember :many
+SELECT w.id, w.name, w.created_at, w.updated_at, wm.role
+FROM workspaces w
+JOIN workspace_members wm ON wm.workspace_id = w.id
+WHERE wm.user_id = ?
+ORDER BY w.name, w.id
+`
+
+type ListWorkspacesByMemberRow struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Role      string    `json:"role"`
+}
+
+// ユーザーがメンバーであるワークスペースを、ユーザーの役割とともに名前順に返す
+func (q *Queries) ListWorkspacesByMember(ctx context.Context, userID string) ([]*ListWorkspacesByMemberRow, error) {
+	rows, err := q.query(ctx, q.listWorkspacesByMemberStmt, listWorkspacesByMember, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkspacesByMemberRow
+	for rows.Next() {
+		var i ListWorkspacesByMemberRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkspacesSolelyOwnedBy = `-- name: ListWorkspacesSolelyOwnedBy :many
+SELECT wm.workspace_id FROM workspace_members wm
+WHERE wm.user_id = ? AND wm.role = 'owner'
+  AND NOT EXISTS (
+    SELECT 1 FROM workspace_members o
+    WHERE o.workspace_id = wm.workspace_id AND o.role = 'owner' AND o.user_id <> wm.user_id
+  )
+`
+
+// ユーザーが唯一の所有者であるワークスペースの ID を返す (ユーザーの削除前の確認に使う)
+func (q *Queries) ListWorkspacesSolelyOwnedBy(ctx context.Context, userID string) ([]string, error) {
+	rows, err := q.query(ctx, q.listWorkspacesSolelyOwnedByStmt, listWorkspacesSolelyOwnedBy, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var workspace_id string
+		if err := rows.Scan(&workspace_id); err != nil {
+			return nil, err
+		}
+		items = append(items, workspace_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const respondWorkspaceInvitation = `-- name: RespondWorkspaceInvitation :execrows
+UPDATE workspace_invitations SET status = ?, responded_at = CURRENT_TIMESTAMP WHERE id = ? AND status = 'pending'
+`
+
+type RespondWorkspaceInvitationParams struct {
+	Status string `json:"status"`
+	ID     string `json:"id"`
+}
+
+// 未回答の招待のみ状態を変更する (0 行の場合は回答済みか取り消し済み)
+func (q *Queries) RespondWorkspaceInvitation(ctx context.Context, arg *RespondWorkspaceInvitationParams) (int64, error) {
+	result, err := q.exec(ctx, q.respondWorkspaceInvitationStmt, respondWorkspaceInvitation, arg.Status, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokePendingWorkspaceInvitations = `-- name: RevokePendingWorkspaceInvitations :exec
+UPDATE workspace_invitations SET status = 'revoked', responded_at = CURRENT_TIMESTAMP
+WHERE workspace_id = ? AND email = ? AND status = 'pending'
+`
+
+type RevokePendingWorkspaceInvitationsParams struct {
+	WorkspaceID string `json:"workspace_id"`
+	Email       string `json:"email"`
+}
+
+// 同じメールアドレスへの未回答の招待を取り消す (招待し直すときに使う)
+func (q *Queries) RevokePendingWorkspaceInvitations(ctx context.Context, arg *RevokePendingWorkspaceInvitationsParams) error {
+	_, err := q.exec(ctx, q.revokePendingWorkspaceInvitationsStmt, revokePendingWorkspaceInvitations, arg.WorkspaceID, arg.Email)
+	return err
+}
+
+const updateWorkspace = `-- name: UpdateWorkspace :exec
+UPDATE workspaces SET name = ? WHERE id = ?
+`
+
+type UpdateWorkspaceParams struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+func (q *Queries) UpdateWorkspace(ctx context.Context, arg *UpdateWorkspaceParams) error {
+	_, err := q.exec(ctx, q.updateWorkspaceStmt, updateWorkspace, arg.Name, arg.ID)
+	return err
+}
+
+const updateWorkspaceMemberRole = `-- name: UpdateWorkspaceMemberRole :exec
+UPDATE workspace_members SET role = ? WHERE workspace_id = ? AND user_id = ?
+`
+
+type UpdateWorkspaceMemberRoleParams struct {
+	Role        string `json:"role"`
+	WorkspaceID string `json:"workspace_id"`
+	UserID      string `json:"user_id"`
+}
+
+func (q *Queries) UpdateWorkspaceMemberRole(ctx context.Context, arg *UpdateWorkspaceMemberRoleParams) error {
+	_, err := q.exec(ctx, q.updateWorkspaceMemberRoleStmt, updateWorkspaceMemberRole, arg.Role, arg.WorkspaceID, arg.UserID)
+	return err
+}
@@ -11,20 +11,226 @@ CREATE TABLE IF NOT EXISTS users (
     email VARCHAR(255) NOT NULL UNIQUE,
     password VARCHAR(255) NOT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    email_verified_at TIMESTAMP NULL, -- メールアドレスの確認日時 (未確認の場合は NULL)
+    role VARCHAR(16) NOT NULL DEFAULT 'member', -- 'admin' または 'member'
+    disabled_at TIMESTAMP NULL, -- 管理者が無効にした日時 (有効な場合は NULL)
+    INDEX idx_users_created_at (created_at, id),
+    INDEX idx_users_name (name, id)
+);
+
+CREATE TABLE IF NOT EXISTS workspaces (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
 );
 
+CREATE TABLE IF NOT EXISTS workspace_members (
+    workspace_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    role VARCHAR(16) NOT NULL, -- 'owner', 'admin', 'member' または 'viewer'
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (workspace_id, user_id),
+    FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_workspace_members_user_id (user_id)
+);
+
+CREATE TABLE IF NOT EXISTS workspace_invitations (
+    id VARCHAR(36) PRIMARY KEY,
+    workspace_id VARCHAR(36) NOT NULL,
+    email VARCHAR(255) NOT NULL, -- 招待したメールアドレス (このメールアドレスのユーザーのみが承諾・辞退できる)
+    role VARCHAR(16) NOT NULL, -- 承諾したときの役割 ('owner' 以外)
+    invited_by VARCHAR(36) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending', -- 'pending', 'accepted', 'declined' または 'revoked'
+    expires_at TIMESTAMP NOT NULL,
+    responded_at TIMESTAMP NULL, -- 承諾・辞退・取り消しの日時
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_workspace_invitations_workspace_id (workspace_id, status),
+    INDEX idx_workspace_invitations_email (email, status)
+);
+
+CREATE TABLE IF NOT EXISTS projects (
+    id VARCHAR(36) PRIMARY KEY,
+    owner_id VARCHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    archived_at TIMESTAMP NULL, -- アーカイブした日時 (アーカイブしていない場合は NULL)
+    workflow JSON NULL, -- タスクの状態と遷移の定義 (NULL の場合は既定のワークフロー)
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_projects_owner_created_at (owner_id, created_at, id)
+);
+
 CREATE TABLE IF NOT EXISTS tasks (
     id VARCHAR(36) PRIMARY KEY,
     title VARCHAR(255) NOT NULL,
     description TEXT,
-    is_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(32) NOT NULL DEFAULT 'todo', -- ワークフローの状態のキー
+    is_completed BOOLEAN NOT NULL DEFAULT FALSE, -- status がワークフローで完了として扱う状態かどうか (アプリケーションで status から導出する)
     user_id VARCHAR(36) NOT NULL,
+    workspace_id VARCHAR(36), -- タスクを所有するワークスペース (個人のタスクの場合は NULL)
     assignee_id VARCHAR(36),
+    project_id VARCHAR(36), -- 所属するプロジェクト (なしの場合は NULL)
+    parent_id VARCHAR(36), -- 親のタスク (サブタスクでない場合は NULL)
+    board_rank VARCHAR(32) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '', -- ボードの列の中の並び順のキー (辞書順)
     priority VARCHAR(10) NOT NULL,  -- high, medium, low を想定
     due_date DATE,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    version BIGINT NOT NULL DEFAULT 1, -- 楽観的排他制御用 (更新のたびに +1)
+    -- 一覧のキーセットページネーション用の生成列
+    priority_rank TINYINT AS (CASE priority WHEN 'high' THEN 3 WHEN 'medium' THEN 2 WHEN 'low' THEN 1 ELSE 0 END) STORED NOT NULL,
+    due_date_sort DATE AS (COALESCE(due_date, '9999-12-31')) STORED NOT NULL,
     FOREIGN KEY (user_id) REFERENCES users(id),
-    FOREIGN KEY (assignee_id) REFERENCES users(id)
+    FOREIGN KEY (assignee_id) REFERENCES users(id),
+    CONSTRAINT fk_tasks_workspace FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    CONSTRAINT fk_tasks_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE SET NULL,
+    -- 親を削除するとサブタスクも削除される (DeleteTask では付け替えるか削除するかを明示的に選ぶ)
+    CONSTRAINT fk_tasks_parent FOREIGN KEY (parent_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    INDEX idx_tasks_user_created_at (user_id, created_at, id),
+    INDEX idx_tasks_user_updated_at (user_id, updated_at, id),
+    INDEX idx_tasks_user_due_date (user_id, due_date_sort, id),
+    INDEX idx_tasks_user_priority (user_id, priority_rank, id),
+    INDEX idx_tasks_user_rank (user_id, board_rank, id),
+    INDEX idx_tasks_assignee_created_at (assignee_id, created_at, id),
+    INDEX idx_tasks_assignee_updated_at (assignee_id, updated_at, id),
+    INDEX idx_tasks_assignee_due_date (assignee_id, due_date_sort, id),
+    INDEX idx_tasks_assignee_priority (assignee_id, priority_rank, id),
+    INDEX idx_tasks_assignee_rank (assignee_id, board_rank, id),
+    INDEX idx_tasks_workspace_created_at (workspace_id, created_at, id),
+    INDEX idx_tasks_workspace_updated_at (workspace_id, updated_at, id),
+    INDEX idx_tasks_workspace_due_date (workspace_id, due_date_sort, id),
+    INDEX idx_tasks_workspace_priority (workspace_id, priority_rank, id),
+    INDEX idx_tasks_workspace_rank (workspace_id, board_rank, id),
+    INDEX idx_tasks_project_status (project_id, status, board_rank, id),
+    INDEX idx_tasks_parent_created_at (parent_id, created_at, id)
+);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    family_id VARCHAR(36) NOT NULL, -- ログインごとに発行し、ローテーション後も引き継ぐ
+    token_hash CHAR(64) NOT NULL UNIQUE, -- トークン本体の SHA-256 (16 進数)
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL, -- ローテーション済みの場合に設定
+    revoked_at TIMESTAMP NULL, -- ログアウトまたは再利用の検知で失効した場合に設定
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_refresh_tokens_family_id (family_id)
+);
+
+-- 失効させたアクセストークン (jti)。expires_at を過ぎた行は削除してよい
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_revoked_tokens_expires_at (expires_at)
+);
+
+-- ユーザーごとの失効基準時刻。これより前に発行されたアクセストークンはすべて無効
+CREATE TABLE IF NOT EXISTS user_token_revocations (
+    user_id VARCHAR(36) PRIMARY KEY,
+    revoked_before TIMESTAMP(6) NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    token_prefix VARCHAR(16) NOT NULL, -- 一覧で識別するためのトークンの先頭部分
+    token_hash CHAR(64) NOT NULL UNIQUE, -- トークン本体の SHA-256 (16 進数)
+    scopes VARCHAR(255) NOT NULL, -- スペース区切り (例: "tasks:read tasks:write")
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_personal_access_tokens_user_created_at (user_id, created_at)
+);
+
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE, -- トークン本体の SHA-256 (16 進数)
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL, -- 使用済み (または新しいトークンの発行・パスワードの再設定で無効化) の場合に設定
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS email_verification_tokens (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    email VARCHAR(255) NOT NULL, -- 確認するメールアドレス (発行後に変更された場合は使用できない)
+    token_hash CHAR(64) NOT NULL UNIQUE, -- トークン本体の SHA-256 (16 進数)
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL, -- 使用済み (または新しいトークンの発行で無効化) の場合に設定
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS totp_credentials (
+    user_id VARCHAR(36) PRIMARY KEY,
+    secret_ciphertext VARBINARY(255) NOT NULL, -- AES-256-GCM で暗号化したシークレット (先頭に nonce を付加)
+    confirmed_at TIMESTAMP NULL, -- 最初のコードで確認するまでは NULL (ログインでは使用しない)
+    last_used_step BIGINT NOT NULL DEFAULT 0, -- 最後に使用したコードのタイムステップ (同じコードの再使用を防ぐ)
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS totp_recovery_codes (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    code_hash CHAR(64) NOT NULL, -- リカバリーコードの SHA-256 (16 進数)
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE KEY uq_totp_recovery_codes_user_code (user_id, code_hash)
+);
+
+CREATE TABLE IF NOT EXISTS login_throttles (
+    throttle_key VARCHAR(320) PRIMARY KEY, -- "account:<メールアドレス>" または "ip:<クライアント IP>"
+    failures INT NOT NULL, -- 続けて失敗した回数
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP NULL, -- この時刻まではパスワードを確認せずに拒否する
+    INDEX idx_login_throttles_last_failure_at (last_failure_at)
+);
+
+CREATE TABLE IF NOT EXISTS login_events (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    result VARCHAR(32) NOT NULL, -- "success", "invalid_password", "invalid_second_factor", "locked_out" または "disabled"
+    ip_address VARCHAR(45) NOT NULL,
+    user_agent VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_login_events_user_created_at (user_id, created_at)
+);
+
+CREATE TABLE IF NOT EXISTS user_identities (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    issuer VARCHAR(255) NOT NULL, -- ID プロバイダーの issuer
+    subject VARCHAR(255) NOT NULL, -- ID プロバイダーでのユーザーの識別子 (sub)
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE KEY uq_user_identities_issuer_subject (issuer, subject)
+);
+
+CREATE TABLE IF NOT EXISTS oidc_auth_requests (
+    state_hash CHAR(64) PRIMARY KEY, -- state の SHA-256 (16 進数)
+    nonce VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL, -- PKCE のコード検証子 (認可コードの交換時に送信する)
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_oidc_auth_requests_expires_at (expires_at)
 );
\ No newline at end of file